@@ -0,0 +1,150 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/apache/openwhisk-wskdeploy/deployers"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+	"github.com/apache/openwhisk-wskdeploy/wskprint"
+	"github.com/spf13/cobra"
+)
+
+// depsCmd groups the commands managing the dependencies of the project
+var depsCmd = &cobra.Command{
+	Use:        wski18n.CMD_DEPS,
+	SuggestFor: []string{"dependencies"},
+	Short:      wski18n.T(wski18n.ID_CMD_DESC_SHORT_DEPS),
+	Long:       wski18n.T(wski18n.ID_CMD_DESC_LONG_DEPS),
+}
+
+// depsUpdateCmd represents the deps update command
+var depsUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: wski18n.T(wski18n.ID_CMD_DESC_SHORT_DEPS_UPDATE),
+	Long:  wski18n.T(wski18n.ID_CMD_DESC_LONG_DEPS_UPDATE),
+	RunE:  DepsUpdateCmdImp,
+}
+
+func DepsUpdateCmdImp(cmd *cobra.Command, args []string) error {
+	return DepsUpdate(cmd)
+}
+
+// DepsUpdate resolves the remote dependencies of the manifest again and rewrites wskdeploy.lock
+func DepsUpdate(cmd *cobra.Command) error {
+	deployer, err := newDepsDeployer()
+	if err != nil {
+		return err
+	}
+
+	if err := deployer.UpdateLockfile(); err != nil {
+		return err
+	}
+
+	wskprint.PrintlnOpenWhiskSuccess(wski18n.T(wski18n.ID_MSG_LOCKFILE_UPDATED_X_path_X,
+		map[string]interface{}{wski18n.KEY_PATH: deployer.Lockfile.Path}))
+	return nil
+}
+
+// depsTreeCmd represents the deps tree command
+var depsTreeCmd = &cobra.Command{
+	Use:   "tree",
+	Short: wski18n.T(wski18n.ID_CMD_DESC_SHORT_DEPS_TREE),
+	Long:  wski18n.T(wski18n.ID_CMD_DESC_LONG_DEPS_TREE),
+	RunE:  DepsTreeCmdImp,
+}
+
+func DepsTreeCmdImp(cmd *cobra.Command, args []string) error {
+	return DepsTree(cmd)
+}
+
+// DepsTree resolves the dependencies of the manifest, as on deployment, and prints them
+func DepsTree(cmd *cobra.Command) error {
+	deployer, err := newDepsDeployer()
+	if err != nil {
+		return err
+	}
+
+	graph, err := deployer.ResolveDependencies()
+	if err != nil {
+		return err
+	}
+
+	if tree := graph.String(); len(tree) != 0 {
+		wskprint.PrintlnOpenWhiskOutput(tree)
+	}
+	return nil
+}
+
+// depsVendorCmd represents the deps vendor command
+var depsVendorCmd = &cobra.Command{
+	Use:   "vendor",
+	Short: wski18n.T(wski18n.ID_CMD_DESC_SHORT_DEPS_VENDOR),
+	Long:  wski18n.T(wski18n.ID_CMD_DESC_LONG_DEPS_VENDOR),
+	RunE:  DepsVendorCmdImp,
+}
+
+func DepsVendorCmdImp(cmd *cobra.Command, args []string) error {
+	return DepsVendor(cmd)
+}
+
+// DepsVendor copies the resolved remote dependencies of the manifest to the vendor folder
+func DepsVendor(cmd *cobra.Command) error {
+	deployer, err := newDepsDeployer()
+	if err != nil {
+		return err
+	}
+
+	if err := deployer.VendorDependencies(); err != nil {
+		return err
+	}
+
+	wskprint.PrintlnOpenWhiskSuccess(wski18n.T(wski18n.ID_MSG_DEPENDENCIES_VENDORED_X_path_X,
+		map[string]interface{}{wski18n.KEY_PATH: deployer.Lockfile.VendorDir}))
+	return nil
+}
+
+// newDepsDeployer returns a deployer of the manifest of the project path
+func newDepsDeployer() (*deployers.ServiceDeployer, error) {
+	project_Path := strings.TrimSpace(utils.Flags.ProjectPath)
+	if len(project_Path) == 0 {
+		project_Path = utils.DEFAULT_PROJECT_PATH
+	}
+	projectPath, _ := filepath.Abs(project_Path)
+
+	if utils.Flags.ManifestPath == "" {
+		if err, _ := loadDefaultManifestFileFromProjectPath(wski18n.CMD_DEPS, projectPath, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	var deployer = deployers.NewServiceDeployer()
+	deployer.ProjectPath = projectPath
+	deployer.ManifestPath = utils.Flags.ManifestPath
+	return deployer, nil
+}
+
+func init() {
+	depsCmd.AddCommand(depsUpdateCmd)
+	depsCmd.AddCommand(depsTreeCmd)
+	depsCmd.AddCommand(depsVendorCmd)
+	RootCmd.AddCommand(depsCmd)
+}
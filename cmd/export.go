@@ -45,7 +45,6 @@ var exportCmd = &cobra.Command{
 }
 
 var config *whisk.Config
-var wskpropsPath string
 var client *whisk.Client
 
 func ExportAction(actionName string, packageName string, maniyaml *parsers.YAML, targetManifest string, projectName string) error {
@@ -461,8 +460,15 @@ func exportProject(projectName string, targetManifest string) error {
 
 func ExportCmdImp(cmd *cobra.Command, args []string) error {
 
-	config, _ = deployers.NewWhiskConfig(wskpropsPath, utils.Flags.DeploymentPath, utils.Flags.ManifestPath)
-	client, _ = deployers.CreateNewClient(config)
+	var err error
+	config, err = deployers.NewWhiskConfig(utils.Flags.CfgFile, utils.Flags.DeploymentPath, utils.Flags.ManifestPath)
+	if err != nil {
+		return err
+	}
+	client, err = deployers.CreateNewClient(config)
+	if err != nil {
+		return err
+	}
 
 	// Init supported runtimes and action files extensions maps
 	setSupportedRuntimes(config.Host)
@@ -0,0 +1,58 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportCmdImp_ReadsConfigFile(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "wskdeploy-export")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	wskprops := filepath.Join(dir, ".wskprops")
+	content := "APIHOST=" + server.URL + "\nAUTH=user:pass\nNAMESPACE=export\n"
+	assert.Nil(t, ioutil.WriteFile(wskprops, []byte(content), 0644))
+
+	flags := utils.Flags
+	defer func() { utils.Flags = flags }()
+	utils.Flags.CfgFile = wskprops
+	utils.Flags.ApiHost, utils.Flags.Auth, utils.Flags.Namespace = "", "", ""
+	utils.Flags.ProjectName = "export"
+	utils.Flags.ManifestPath = filepath.Join(dir, "manifest.yaml")
+	utils.Flags.DeploymentPath = ""
+
+	err = ExportCmdImp(nil, nil)
+	assert.NotNil(t, err, "Exporting a project missing on the server should fail.")
+	assert.Equal(t, "user:pass", config.AuthToken, "Export should read the credentials of the --config file.")
+	assert.Equal(t, "export", config.Namespace)
+}
@@ -18,10 +18,12 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/apache/openwhisk-client-go/whisk"
 	"github.com/apache/openwhisk-wskdeploy/dependencies"
@@ -39,18 +41,96 @@ var stderr = ""
 // Whisk Deploy has root command: wskdeploy
 // wskdeploy is being created using Cobra Library
 var RootCmd = &cobra.Command{
-	Use:           "wskdeploy",
-	SilenceErrors: true,
-	SilenceUsage:  true,
-	Short:         wski18n.T(wski18n.ID_CMD_DESC_SHORT_ROOT),
-	Long:          wski18n.T(wski18n.ID_CMD_DESC_LONG_ROOT),
-	RunE:          RootCmdImp,
+	Use:               "wskdeploy",
+	SilenceErrors:     true,
+	SilenceUsage:      true,
+	Short:             wski18n.T(wski18n.ID_CMD_DESC_SHORT_ROOT),
+	Long:              wski18n.T(wski18n.ID_CMD_DESC_LONG_ROOT),
+	PersistentPreRunE: setOutputFormat,
+	RunE:              RootCmdImp,
 }
 
 func RootCmdImp(cmd *cobra.Command, args []string) error {
 	return Deploy(cmd)
 }
 
+// setOutputFormat checks the --output flag, once a json or yaml output is selected
+// the human readable messages are printed on stderr
+func setOutputFormat(cmd *cobra.Command, args []string) error {
+	format := strings.ToLower(utils.Flags.Output)
+	if len(format) != 0 {
+		supported := false
+		for _, f := range wskprint.OUTPUT_FORMATS {
+			supported = supported || f == format
+		}
+		if !supported {
+			return wskderrors.NewCommandError(LONG_CMD+FLAG_OUTPUT,
+				wski18n.T(wski18n.ID_ERR_OUTPUT_FORMAT_UNKNOWN_X_format_X_formats_X,
+					map[string]interface{}{
+						wski18n.KEY_FORMAT:  utils.Flags.Output,
+						wski18n.KEY_FORMATS: strings.Join(wskprint.OUTPUT_FORMATS, ", ")}))
+		}
+	}
+	wskprint.SetOutputFormat(format)
+	return nil
+}
+
+// setEntitySelectors parses the --only and --exclude entity selectors
+func setEntitySelectors(deployer *deployers.ServiceDeployer) error {
+	var err error
+	if deployer.Only, err = parseEntitySelectors(FLAG_ONLY, utils.Flags.Only); err != nil {
+		return err
+	}
+	deployer.Exclude, err = parseEntitySelectors(FLAG_EXCLUDE, utils.Flags.Exclude)
+	return err
+}
+
+func parseEntitySelectors(flag string, values []string) ([]deployers.EntitySelector, error) {
+	var selectors []deployers.EntitySelector
+	for _, value := range values {
+		selector, ok := deployers.ParseEntitySelector(value)
+		if !ok {
+			return nil, wskderrors.NewCommandError(LONG_CMD+flag,
+				wski18n.T(wski18n.ID_ERR_ENTITY_SELECTOR_INVALID_X_selector_X_kinds_X,
+					map[string]interface{}{
+						wski18n.KEY_SELECTOR: value,
+						wski18n.KEY_KINDS:    strings.Join(deployers.SELECTOR_KINDS, ", ")}))
+		}
+		selectors = append(selectors, selector)
+	}
+	return selectors, nil
+}
+
+// values of the retry flags, only set in utils.Flags when given on the command line so that
+// an explicit zero, e.g. --retry-delay 0, takes precedence over .wskprops
+var (
+	retryAttempts int
+	retryDelay    time.Duration
+	retryMaxDelay time.Duration
+)
+
+// setRetryPolicy reads the retry policy of the server calls from the command line or .wskprops
+func setRetryPolicy(cmd *cobra.Command, deployer *deployers.ServiceDeployer) error {
+	setRetryFlags(cmd.Flags().Changed)
+	var err error
+	deployer.Retry, err = deployers.NewRetryPolicy(utils.Flags.CfgFile)
+	return err
+}
+
+// setRetryFlags sets the retry flags given on the command line in utils.Flags
+func setRetryFlags(changed func(name string) bool) {
+	utils.Flags.RetryAttempts, utils.Flags.RetryDelay, utils.Flags.RetryMaxDelay = nil, nil, nil
+	if changed(FLAG_RETRY_ATTEMPTS) {
+		utils.Flags.RetryAttempts = &retryAttempts
+	}
+	if changed(FLAG_RETRY_DELAY) {
+		utils.Flags.RetryDelay = &retryDelay
+	}
+	if changed(FLAG_RETRY_MAX_DELAY) {
+		utils.Flags.RetryMaxDelay = &retryMaxDelay
+	}
+}
+
 // Execute adds all child commands to the root command sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
@@ -59,13 +139,31 @@ func Execute() {
 	os.Args, utils.Flags.Param, err = parseArgsForParams(os.Args)
 	if err != nil {
 		wskprint.PrintOpenWhiskError(err.Error())
-		os.Exit(-1)
+		os.Exit(wskderrors.ExitCode(err))
 	}
 
 	if err = RootCmd.Execute(); err != nil {
+		printErrorResult(err)
 		wskprint.PrintOpenWhiskFromError(err)
-		os.Exit(-1)
+		os.Exit(wskderrors.ExitCode(err))
+	}
+}
+
+// errorResult is the document printed with --output json|yaml by a command which failed
+// before printing its own result, e.g. when the manifest file could not be parsed
+type errorResult struct {
+	Status string                    `json:"status"`
+	Error  *wskderrors.ErrorDocument `json:"error"`
+}
+
+func printErrorResult(err error) {
+	if !wskprint.IsStructuredOutput() || wskprint.IsDocumentPrinted() {
+		return
 	}
+	wskprint.PrintOpenWhiskDocument(errorResult{
+		Status: deployers.STATUS_FAILED,
+		Error:  wskderrors.NewErrorDocument(err),
+	})
 }
 
 func init() {
@@ -80,7 +178,8 @@ func init() {
 	RootCmd.PersistentFlags().StringVar(&utils.Flags.CfgFile, FLAG_CONFIG, "", wski18n.T(wski18n.ID_CMD_FLAG_CONFIG))
 	RootCmd.PersistentFlags().StringVarP(&utils.Flags.ProjectPath, FLAG_PROJECT, FLAG_PROJECT_SHORT, ".", wski18n.T(wski18n.ID_CMD_FLAG_PROJECT))
 	RootCmd.PersistentFlags().StringVarP(&utils.Flags.ManifestPath, FLAG_MANIFEST, FLAG_MANIFEST_SHORT, "", wski18n.T(wski18n.ID_CMD_FLAG_MANIFEST))
-	RootCmd.PersistentFlags().StringVarP(&utils.Flags.DeploymentPath, FLAG_DEPLOYMENT, FLAG_DEPLOYMENT_SHORT, "", wski18n.T(wski18n.ID_CMD_FLAG_DEPLOYMENT))
+	RootCmd.PersistentFlags().VarP(&deploymentFlag{}, FLAG_DEPLOYMENT, FLAG_DEPLOYMENT_SHORT, wski18n.T(wski18n.ID_CMD_FLAG_DEPLOYMENT))
+	RootCmd.PersistentFlags().StringVar(&utils.Flags.Env, FLAG_ENV, "", wski18n.T(wski18n.ID_CMD_FLAG_ENV))
 	RootCmd.PersistentFlags().BoolVarP(&utils.Flags.Strict, FLAG_STRICT, FLAG_STRICT_SHORT, false, wski18n.T(wski18n.ID_CMD_FLAG_STRICT))
 	RootCmd.PersistentFlags().BoolVarP(&utils.Flags.Preview, FLAG_PREVIEW, "", false, wski18n.T(wski18n.ID_CMD_FLAG_PREVIEW))
 	RootCmd.PersistentFlags().BoolVarP(&utils.Flags.Verbose, FLAG_VERBOSE, FLAG_VERBOSE_SHORT, false, wski18n.T(wski18n.ID_CMD_FLAG_VERBOSE))
@@ -95,6 +194,19 @@ func init() {
 	RootCmd.PersistentFlags().BoolVarP(&utils.Flags.Trace, FLAG_TRACE, FLAG_TRACE_SHORT, false, wski18n.T(wski18n.ID_CMD_FLAG_TRACE))
 	RootCmd.PersistentFlags().StringSliceVarP(&utils.Flags.Param, FLAG_PARAM, "", []string{}, wski18n.T(wski18n.ID_CMD_FLAG_PARAM))
 	RootCmd.PersistentFlags().StringVarP(&utils.Flags.ParamFile, FLAG_PARAMFILE, FLAG_PARAMFILE_SHORT, "", wski18n.T(wski18n.ID_CMD_FLAG_PARAM_FILE))
+	RootCmd.PersistentFlags().IntVarP(&utils.Flags.Parallelism, FLAG_PARALLELISM, "", deployers.DEFAULT_PARALLELISM, wski18n.T(wski18n.ID_CMD_FLAG_PARALLELISM))
+	RootCmd.PersistentFlags().BoolVarP(&utils.Flags.NoRollback, FLAG_NO_ROLLBACK, "", false, wski18n.T(wski18n.ID_CMD_FLAG_NO_ROLLBACK))
+	RootCmd.PersistentFlags().StringVarP(&utils.Flags.Output, FLAG_OUTPUT, FLAG_OUTPUT_SHORT, "", wski18n.T(wski18n.ID_CMD_FLAG_OUTPUT))
+	RootCmd.PersistentFlags().StringSliceVar(&utils.Flags.Only, FLAG_ONLY, []string{}, wski18n.T(wski18n.ID_CMD_FLAG_ONLY))
+	RootCmd.PersistentFlags().StringSliceVar(&utils.Flags.Exclude, FLAG_EXCLUDE, []string{}, wski18n.T(wski18n.ID_CMD_FLAG_EXCLUDE))
+	RootCmd.PersistentFlags().IntVar(&retryAttempts, FLAG_RETRY_ATTEMPTS, 0, wski18n.T(wski18n.ID_CMD_FLAG_RETRY_ATTEMPTS))
+	RootCmd.PersistentFlags().DurationVar(&retryDelay, FLAG_RETRY_DELAY, 0, wski18n.T(wski18n.ID_CMD_FLAG_RETRY_DELAY))
+	RootCmd.PersistentFlags().DurationVar(&retryMaxDelay, FLAG_RETRY_MAX_DELAY, 0, wski18n.T(wski18n.ID_CMD_FLAG_RETRY_MAX_DELAY))
+	RootCmd.PersistentFlags().StringVar(&utils.Flags.SecretsDir, FLAG_SECRETS_DIR, "", wski18n.T(wski18n.ID_CMD_FLAG_SECRETS_DIR))
+	RootCmd.PersistentFlags().StringVar(&utils.Flags.SecretsFile, FLAG_SECRETS_FILE, "", wski18n.T(wski18n.ID_CMD_FLAG_SECRETS_FILE))
+	RootCmd.PersistentFlags().StringVar(&utils.Flags.SecretsIdentity, FLAG_SECRETS_IDENTITY, "", wski18n.T(wski18n.ID_CMD_FLAG_SECRETS_IDENTITY))
+	RootCmd.PersistentFlags().BoolVar(&utils.Flags.FrozenLockfile, FLAG_FROZEN_LOCKFILE, false, wski18n.T(wski18n.ID_CMD_FLAG_FROZEN_LOCKFILE))
+	RootCmd.PersistentFlags().StringVar(&utils.Flags.CacheDir, FLAG_CACHE_DIR, "", wski18n.T(wski18n.ID_CMD_FLAG_CACHE_DIR))
 	RootCmd.PersistentFlags().MarkHidden(FLAG_TRACE)
 }
 
@@ -136,11 +248,15 @@ func setSupportedRuntimes(apiHost string) error {
 	if err != nil {
 		return err
 	}
+	setRuntimes(op)
+	return nil
+}
+
+func setRuntimes(op runtimes.OpenWhiskInfo) {
 	runtimes.SupportedRunTimes = runtimes.ConvertToMap(op)
 	runtimes.DefaultRunTimes = runtimes.DefaultRuntimes(op)
 	runtimes.FileExtensionRuntimeKindMap = runtimes.FileExtensionRuntimes(op)
 	runtimes.FileRuntimeExtensionsMap = runtimes.FileRuntimeExtensions(op)
-	return nil
 }
 
 func displayCommandUsingFilenameMessage(command string, filetype string, path string) {
@@ -183,6 +299,63 @@ func loadDefaultDeploymentFileFromProjectPath(command string, projectPath string
 	return nil
 }
 
+// loadDeploymentEnvFile merges deployment.<env>.yaml over the deployment file when --env is given,
+// the environment file is looked up next to the deployment file or in the project path
+func loadDeploymentEnvFile(command string, projectPath string) error {
+
+	if len(utils.Flags.Env) == 0 {
+		return nil
+	}
+	dir := projectPath
+	if len(utils.Flags.DeploymentPath) != 0 {
+		dir = filepath.Dir(utils.Flags.DeploymentPath)
+	}
+	envPath := path.Join(dir, fmt.Sprintf(utils.DeploymentEnvFileNameYaml, utils.Flags.Env))
+	if _, err := os.Stat(envPath); err != nil {
+		ymlPath := path.Join(dir, fmt.Sprintf(utils.DeploymentEnvFileNameYml, utils.Flags.Env))
+		if _, err := os.Stat(ymlPath); err != nil {
+			errMessage := wski18n.T(wski18n.ID_ERR_DEPLOYMENT_ENV_FILE_NOT_FOUND_X_env_X_path_X,
+				map[string]interface{}{
+					wski18n.KEY_ENV:  utils.Flags.Env,
+					wski18n.KEY_PATH: dir})
+			return wskderrors.NewFileReadError(envPath, errMessage)
+		}
+		envPath = ymlPath
+	}
+	if len(utils.Flags.DeploymentPath) == 0 {
+		utils.Flags.DeploymentPath = envPath
+	} else {
+		utils.Flags.DeploymentOverlays = append(utils.Flags.DeploymentOverlays, envPath)
+	}
+	displayCommandUsingFilenameMessage(command, wski18n.DEPLOYMENT_FILE, envPath)
+	return nil
+}
+
+// deploymentFlag is the value of the repeatable --deployment flag, the first file given
+// is the deployment file and every further file is merged over it
+type deploymentFlag struct {
+	changed bool
+}
+
+func (f *deploymentFlag) String() string {
+	return strings.Join(append([]string{utils.Flags.DeploymentPath}, utils.Flags.DeploymentOverlays...), ",")
+}
+
+func (f *deploymentFlag) Set(value string) error {
+	if !f.changed {
+		f.changed = true
+		utils.Flags.DeploymentPath = value
+		utils.Flags.DeploymentOverlays = nil
+	} else {
+		utils.Flags.DeploymentOverlays = append(utils.Flags.DeploymentOverlays, value)
+	}
+	return nil
+}
+
+func (f *deploymentFlag) Type() string {
+	return "stringArray"
+}
+
 func Deploy(cmd *cobra.Command) error {
 
 	// Convey flags for verbose and trace to Go client
@@ -215,6 +388,9 @@ func Deploy(cmd *cobra.Command) error {
 			return err
 		}
 	}
+	if err := loadDeploymentEnvFile(wski18n.CMD_DEPLOY, projectPath); err != nil {
+		return err
+	}
 
 	if utils.MayExists(utils.Flags.ManifestPath) {
 
@@ -224,8 +400,18 @@ func Deploy(cmd *cobra.Command) error {
 		deployer.ProjectPath = projectPath
 		deployer.ManifestPath = utils.Flags.ManifestPath
 		deployer.DeploymentPath = utils.Flags.DeploymentPath
+		deployer.DeploymentOverlays = utils.Flags.DeploymentOverlays
 		deployer.Preview = utils.Flags.Preview
 		deployer.Report = utils.Flags.Report
+		deployer.Plan = utils.Flags.Plan
+		deployer.Parallelism = utils.Flags.Parallelism
+		deployer.NoRollback = utils.Flags.NoRollback
+		if err := setEntitySelectors(deployer); err != nil {
+			return err
+		}
+		if err := setRetryPolicy(cmd, deployer); err != nil {
+			return err
+		}
 
 		// master record of any dependency that has been downloaded
 		deployer.DependencyMaster = make(map[string]dependencies.DependencyRecord)
@@ -285,6 +471,12 @@ func Undeploy(cmd *cobra.Command) error {
 	if len(utils.Flags.ProjectName) != 0 {
 		var deployer = deployers.NewServiceDeployer()
 		deployer.Preview = utils.Flags.Preview
+		if err := setEntitySelectors(deployer); err != nil {
+			return err
+		}
+		if err := setRetryPolicy(cmd, deployer); err != nil {
+			return err
+		}
 
 		clientConfig, error := deployers.NewWhiskConfig(utils.Flags.CfgFile, "", "")
 		if error != nil {
@@ -334,6 +526,9 @@ func Undeploy(cmd *cobra.Command) error {
 			return err
 		}
 	}
+	if err := loadDeploymentEnvFile(wski18n.CMD_UNDEPLOY, projectPath); err != nil {
+		return err
+	}
 
 	if utils.FileExists(utils.Flags.ManifestPath) {
 
@@ -341,7 +536,14 @@ func Undeploy(cmd *cobra.Command) error {
 		deployer.ProjectPath = utils.Flags.ProjectPath
 		deployer.ManifestPath = utils.Flags.ManifestPath
 		deployer.DeploymentPath = utils.Flags.DeploymentPath
+		deployer.DeploymentOverlays = utils.Flags.DeploymentOverlays
 		deployer.Preview = utils.Flags.Preview
+		if err := setEntitySelectors(deployer); err != nil {
+			return err
+		}
+		if err := setRetryPolicy(cmd, deployer); err != nil {
+			return err
+		}
 
 		clientConfig, error := deployers.NewWhiskConfig(utils.Flags.CfgFile, utils.Flags.DeploymentPath, utils.Flags.ManifestPath)
 		if error != nil {
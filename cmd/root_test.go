@@ -22,6 +22,7 @@ package cmd
 import (
 	"bytes"
 	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wskprint"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"os"
@@ -149,3 +150,38 @@ func TestRootCommand(t *testing.T) {
 	checkValidAuthInfo(t, expected_auth_flags)
 	checkValidInputInfo(t, expected_input)
 }
+
+func TestSetOutputFormat(t *testing.T) {
+	defer func() {
+		utils.Flags.Output = ""
+		wskprint.SetOutputFormat("")
+	}()
+
+	utils.Flags.Output = "JSON"
+	assert.Nil(t, setOutputFormat(RootCmd, nil))
+	assert.True(t, wskprint.IsStructuredOutput())
+
+	utils.Flags.Output = "xml"
+	assert.NotNil(t, setOutputFormat(RootCmd, nil), "Only json and yaml output formats are supported.")
+
+	utils.Flags.Output = ""
+	assert.Nil(t, setOutputFormat(RootCmd, nil))
+	assert.False(t, wskprint.IsStructuredOutput())
+}
+
+func TestSetRetryFlags(t *testing.T) {
+	defer func() { utils.Flags.RetryAttempts, utils.Flags.RetryDelay, utils.Flags.RetryMaxDelay = nil, nil, nil }()
+
+	command := &cobra.Command{}
+	command.Flags().IntVar(&retryAttempts, FLAG_RETRY_ATTEMPTS, 0, "")
+	command.Flags().DurationVar(&retryDelay, FLAG_RETRY_DELAY, 0, "")
+	command.Flags().DurationVar(&retryMaxDelay, FLAG_RETRY_MAX_DELAY, 0, "")
+	assert.Nil(t, command.Flags().Parse([]string{"--" + FLAG_RETRY_DELAY, "0"}))
+
+	setRetryFlags(command.Flags().Changed)
+	assert.Nil(t, utils.Flags.RetryAttempts, "A flag which is not given is not set.")
+	assert.Nil(t, utils.Flags.RetryMaxDelay, "A flag which is not given is not set.")
+	if assert.NotNil(t, utils.Flags.RetryDelay, "An explicit zero flag is set.") {
+		assert.Equal(t, int64(0), int64(*utils.Flags.RetryDelay))
+	}
+}
@@ -0,0 +1,96 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/deployers"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+	"github.com/spf13/cobra"
+)
+
+// statusCmd represents the status command
+var statusCmd = &cobra.Command{
+	Use:        "status",
+	SuggestFor: []string{"drift"},
+	Short:      wski18n.T(wski18n.ID_CMD_DESC_SHORT_STATUS),
+	Long:       wski18n.T(wski18n.ID_CMD_DESC_LONG_STATUS),
+	RunE:       StatusCmdImp,
+}
+
+func StatusCmdImp(cmd *cobra.Command, args []string) error {
+	return Status(cmd)
+}
+
+// Status compares the deployment state recorded under the project path with the namespace
+func Status(cmd *cobra.Command) error {
+
+	// Convey flags for verbose and trace to Go client
+	whisk.SetVerbose(utils.Flags.Verbose)
+	whisk.SetDebug(utils.Flags.Trace)
+
+	project_Path := strings.TrimSpace(utils.Flags.ProjectPath)
+	if len(project_Path) == 0 {
+		project_Path = utils.DEFAULT_PROJECT_PATH
+	}
+	projectPath, _ := filepath.Abs(project_Path)
+
+	// manifest and deployment files are optional here, they are only read for credentials
+	if utils.Flags.ManifestPath == "" {
+		loadDefaultManifestFileFromProjectPath(wski18n.CMD_STATUS, projectPath, nil)
+	}
+	if utils.Flags.DeploymentPath == "" {
+		loadDefaultDeploymentFileFromProjectPath(wski18n.CMD_STATUS, projectPath)
+	}
+	if err := loadDeploymentEnvFile(wski18n.CMD_STATUS, projectPath); err != nil {
+		return err
+	}
+
+	var deployer = deployers.NewServiceDeployer()
+	deployer.ProjectPath = projectPath
+	deployer.Parallelism = utils.Flags.Parallelism
+	if err := setRetryPolicy(cmd, deployer); err != nil {
+		return err
+	}
+
+	clientConfig, error := deployers.NewWhiskConfig(
+		utils.Flags.CfgFile,
+		utils.Flags.DeploymentPath,
+		utils.Flags.ManifestPath)
+	if error != nil {
+		return error
+	}
+
+	whiskClient, error := deployers.CreateNewClient(clientConfig)
+	if error != nil {
+		return error
+	}
+
+	deployer.Client = whiskClient
+	deployer.ClientConfig = clientConfig
+
+	return deployer.Status()
+}
+
+func init() {
+	RootCmd.AddCommand(statusCmd)
+}
@@ -35,6 +35,7 @@ const (
 	FLAG_MANIFEST_SHORT   = "m"
 	FLAG_DEPLOYMENT       = "deployment"
 	FLAG_DEPLOYMENT_SHORT = "d"
+	FLAG_ENV              = "env"
 	FLAG_STRICT           = "strict"
 	FLAG_STRICT_SHORT     = "s"
 	FLAG_PREVIEW          = "preview"
@@ -57,6 +58,20 @@ const (
 	FLAG_PARAM            = "param"
 	FLAG_PARAMFILE        = "param-file"
 	FLAG_PARAMFILE_SHORT  = "P"
+	FLAG_PARALLELISM      = "parallelism"
+	FLAG_NO_ROLLBACK      = "no-rollback"
+	FLAG_OUTPUT           = "output"
+	FLAG_OUTPUT_SHORT     = "o"
+	FLAG_ONLY             = "only"
+	FLAG_EXCLUDE          = "exclude"
+	FLAG_RETRY_ATTEMPTS   = "retry-attempts"
+	FLAG_RETRY_DELAY      = "retry-delay"
+	FLAG_RETRY_MAX_DELAY  = "retry-max-delay"
+	FLAG_SECRETS_DIR      = "secrets-dir"
+	FLAG_SECRETS_FILE     = "secrets-file"
+	FLAG_SECRETS_IDENTITY = "secrets-identity"
+	FLAG_FROZEN_LOCKFILE  = "frozen-lockfile"
+	FLAG_CACHE_DIR        = "cache-dir"
 	SHORT_CMD             = "-"
 	LONG_CMD              = SHORT_CMD + SHORT_CMD
 )
@@ -0,0 +1,102 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/dependencies"
+	"github.com/apache/openwhisk-wskdeploy/deployers"
+	"github.com/apache/openwhisk-wskdeploy/runtimes"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+	"github.com/apache/openwhisk-wskdeploy/wskprint"
+	"github.com/spf13/cobra"
+)
+
+// validateCmd represents the validate command
+var validateCmd = &cobra.Command{
+	Use:        "validate",
+	SuggestFor: []string{"check", "lint"},
+	Short:      wski18n.T(wski18n.ID_CMD_DESC_SHORT_VALIDATE),
+	Long:       wski18n.T(wski18n.ID_CMD_DESC_LONG_VALIDATE),
+	RunE:       ValidateCmdImp,
+}
+
+func ValidateCmdImp(cmd *cobra.Command, args []string) error {
+	return Validate(cmd)
+}
+
+// Validate checks the manifest and deployment files without credentials or network access
+func Validate(cmd *cobra.Command) error {
+
+	utils.Flags.Offline = true
+
+	project_Path := strings.TrimSpace(utils.Flags.ProjectPath)
+	if len(project_Path) == 0 {
+		project_Path = utils.DEFAULT_PROJECT_PATH
+	}
+	projectPath, _ := filepath.Abs(project_Path)
+
+	// unlike deploy, a missing manifest file is an error so that validate can be used as a hook
+	if utils.Flags.ManifestPath == "" {
+		if err, _ := loadDefaultManifestFileFromProjectPath(wski18n.CMD_VALIDATE, projectPath, nil); err != nil {
+			return err
+		}
+	}
+	if utils.Flags.DeploymentPath == "" {
+		if err := loadDefaultDeploymentFileFromProjectPath(wski18n.CMD_VALIDATE, projectPath); err != nil {
+			return err
+		}
+	}
+	if err := loadDeploymentEnvFile(wski18n.CMD_VALIDATE, projectPath); err != nil {
+		return err
+	}
+
+	// the runtimes supported by the OpenWhisk server are not known offline
+	op, err := runtimes.ParseLocalOpenWhisk()
+	if err != nil {
+		return err
+	}
+	setRuntimes(op)
+
+	var deployer = deployers.NewServiceDeployer()
+	deployer.ProjectPath = projectPath
+	deployer.ManifestPath = utils.Flags.ManifestPath
+	deployer.DeploymentPath = utils.Flags.DeploymentPath
+	deployer.DeploymentOverlays = utils.Flags.DeploymentOverlays
+	deployer.DependencyMaster = make(map[string]dependencies.DependencyRecord)
+	deployer.ClientConfig = &whisk.Config{Namespace: whisk.DEFAULT_NAMESPACE}
+	if len(utils.Flags.Namespace) != 0 {
+		deployer.ClientConfig.Namespace = utils.Flags.Namespace
+	}
+
+	if err := deployer.Validate(); err != nil {
+		return err
+	}
+
+	wskprint.PrintlnOpenWhiskSuccess(wski18n.T(wski18n.ID_MSG_VALIDATION_SUCCEEDED_X_path_X,
+		map[string]interface{}{wski18n.KEY_PATH: deployer.ManifestPath}))
+	return nil
+}
+
+func init() {
+	RootCmd.AddCommand(validateCmd)
+}
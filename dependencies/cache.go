@@ -0,0 +1,149 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dependencies
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+const (
+	CACHE_DIR_ENV = "WSKDEPLOY_CACHE_DIR" // overrides the default location of the cache
+	CACHE_DIR     = ".wskdeploy/cache"    // default location of the cache, under the home directory
+	VENDOR_DIR    = "vendor"              // folder of the vendored dependencies, under the project path
+)
+
+// Cache keeps the files of every commit of a remote dependency downloaded so far, under
+// <Dir>/<sha256 of the repository>/<commit>, so that they are only downloaded once
+type Cache struct {
+	Dir string
+}
+
+func NewCache(dir string) *Cache {
+	return &Cache{Dir: dir}
+}
+
+// DefaultCacheDir returns the location of the cache when none is configured, from
+// WSKDEPLOY_CACHE_DIR or under the given home directory
+func DefaultCacheDir(home string) string {
+	if dir := os.Getenv(CACHE_DIR_ENV); len(dir) != 0 {
+		return dir
+	}
+	return filepath.Join(home, filepath.FromSlash(CACHE_DIR))
+}
+
+// Path returns the folder holding the files of the repository at the commit
+func (cache *Cache) Path(repo string, commit string) string {
+	return filepath.Join(cache.Dir, cacheKey(repo), commit)
+}
+
+// Get copies the files of the repository at the commit to dest, and tells whether
+// they were cached
+func (cache *Cache) Get(repo string, commit string, dest string) (bool, error) {
+	entry := cache.Path(repo, commit)
+	if info, err := os.Stat(entry); err != nil || !info.IsDir() {
+		return false, nil
+	}
+	return true, replaceTree(entry, dest)
+}
+
+// Put copies the files of the repository at the commit from src into the cache
+func (cache *Cache) Put(repo string, commit string, src string) error {
+	entry := cache.Path(repo, commit)
+	if err := os.MkdirAll(filepath.Dir(entry), os.ModePerm); err != nil {
+		return err
+	}
+	// copy to a temporary folder first, a concurrent Get never sees a partial entry
+	tmp, err := ioutil.TempDir(filepath.Dir(entry), commit+".")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	if err := copyTree(src, tmp); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(entry); err != nil {
+		return err
+	}
+	return os.Rename(tmp, entry)
+}
+
+// Remove evicts the files of the repository at the commit, e.g. when they were modified
+func (cache *Cache) Remove(repo string, commit string) error {
+	return os.RemoveAll(cache.Path(repo, commit))
+}
+
+// cacheKey hashes the repository without its credentials
+func cacheKey(repo string) string {
+	if u, err := url.Parse(repo); err == nil && u.User != nil {
+		u.User = nil
+		repo = u.String()
+	}
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(repo)))
+}
+
+// replaceTree replaces dest with a copy of src
+func replaceTree(src string, dest string) error {
+	if err := os.RemoveAll(dest); err != nil {
+		return err
+	}
+	return copyTree(src, dest)
+}
+
+// copyTree copies the folders and regular files under src to dest
+func copyTree(src string, dest string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dest, rel)
+		switch {
+		case info.IsDir():
+			return os.MkdirAll(target, info.Mode()|0700)
+		case info.Mode().IsRegular():
+			return copyFile(path, target, info.Mode())
+		}
+		return nil
+	})
+}
+
+func copyFile(src string, dest string, mode os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
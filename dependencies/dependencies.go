@@ -18,9 +18,11 @@
 package dependencies
 
 import (
+	"regexp"
 	"strings"
 
 	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/semver"
 )
 
 const (
@@ -28,16 +30,38 @@ const (
 	WHISK_SYSTEM = "whisk.system"
 )
 
+// Where the package of a dependency comes from
+const (
+	SOURCE_BINDING = "binding" // a package of the namespace or of another one, e.g. /whisk.system/cloudant
+	SOURCE_GITHUB  = "github"  // the zipball of a GitHub repository, e.g. github.com/org/repo/folder
+	SOURCE_GIT     = "git"     // a clone of any git remote, e.g. git+https://host/org/repo.git//folder
+	SOURCE_LOCAL   = "local"   // a folder of the project, relative to the manifest, e.g. ../shared
+)
+
+// prefixes of the locations cloned with git, the git+ prefix is removed before cloning
+var gitSchemes = []string{"git://", "git+https://", "git+http://", "git+ssh://", "git+file://", "ssh://", "file://"}
+
+const (
+	GIT_PREFIX     = "git+"
+	GIT_SUFFIX     = ".git"
+	GIT_SUBFOLDER  = "//" // separates the repository from the folder of the package in git locations
+	SCHEME_DIVIDER = "://"
+)
+
+// an scp-like git location, e.g. git@host:org/repo.git
+var gitScpLocation = regexp.MustCompile(`^[\w.-]+@[\w.-]+:[^/]`)
+
 type DependencyRecord struct {
-	ProjectPath string //root of the source codes of dependent projects, e.g. src_project_path/Packages
-	Packagename string //name of the package
-	Location    string //location
-	Version     string //version
-	Parameters  whisk.KeyValueArr
-	Annotations whisk.KeyValueArr
-	IsBinding   bool
-	BaseRepo    string
-	SubFolder   string
+	ProjectPath string            `json:"projectPath"` //root of the source codes of dependent projects, e.g. src_project_path/Packages
+	Packagename string            `json:"packageName"` //name of the package
+	Location    string            `json:"location"`    //location
+	Version     string            `json:"version"`     //version
+	Parameters  whisk.KeyValueArr `json:"parameters"`
+	Annotations whisk.KeyValueArr `json:"annotations"`
+	IsBinding   bool              `json:"isBinding"`
+	BaseRepo    string            `json:"baseRepo"` // repository to download, or folder of a local dependency
+	SubFolder   string            `json:"subFolder"`
+	Source      string            `json:"source"`
 }
 
 func NewDependencyRecord(projectPath string,
@@ -56,19 +80,76 @@ func NewDependencyRecord(projectPath string,
 	record.Annotations = annotations
 	record.IsBinding = isBinding
 	//split url to BaseUrl and SubFolder
-	if !record.IsBinding {
+	switch {
+	case record.IsBinding:
+		record.Source = SOURCE_BINDING
+	case LocationIsLocal(location):
+		record.Source = SOURCE_LOCAL
+		record.BaseRepo = location
+	case LocationIsGit(location):
+		record.Source = SOURCE_GIT
+		record.BaseRepo, record.SubFolder = splitGitLocation(location)
+	default:
+		record.Source = SOURCE_GITHUB
+		// <scheme>://<host>/<org>/<repo>[/<folder>...]
 		paths := strings.Split(location, "/")
-		record.BaseRepo = strings.Join([]string{paths[0], paths[1], paths[2], paths[3], paths[4]}, "/")
-		if len(paths) > 5 {
+		record.BaseRepo = location
+		if len(paths) >= 5 {
+			record.BaseRepo = strings.Join(paths[:5], "/")
 			record.SubFolder = strings.TrimPrefix(record.Location, record.BaseRepo)
-		} else {
-			record.SubFolder = ""
 		}
 	}
 
 	return record
 }
 
+// IsRemote tells whether the package of the dependency is downloaded from a repository
+func (record DependencyRecord) IsRemote() bool {
+	return record.Source == SOURCE_GITHUB || record.Source == SOURCE_GIT
+}
+
+// splitGitLocation splits a git location into the repository to clone and the
+// folder of the package in the repository, e.g. git+https://host/repo.git//folder
+func splitGitLocation(location string) (string, string) {
+	repo := strings.TrimPrefix(location, GIT_PREFIX)
+	start := 0
+	if i := strings.Index(repo, SCHEME_DIVIDER); i >= 0 {
+		start = i + len(SCHEME_DIVIDER)
+	}
+	if i := strings.Index(repo[start:], GIT_SUBFOLDER); i >= 0 {
+		return repo[:start+i], repo[start+i+1:]
+	}
+	return repo, ""
+}
+
+// Reader downloads the package of a remote dependency into the folder returned by Path
+type Reader interface {
+	// Tags returns the names of the tags of the repository
+	Tags() ([]string, error)
+	// ResolveCommit returns the commit a branch, tag or commit of the repository currently designates
+	ResolveCommit(ref string) (string, error)
+	// Download replaces the folder of the dependency with the files of the given commit
+	Download(commit string) error
+	Path() string
+}
+
+// characters of versions which are not kept in the name of the folder of a dependency
+var unsafeFolderChars = regexp.MustCompile(`[^\w.-]`)
+
+// DependencyFolder returns the folder a dependency is downloaded to under the project path of
+// its record, e.g. utils-master, or utils-_1.4.0 for the range ^1.4.0
+func DependencyFolder(name string, version string) string {
+	return name + "-" + unsafeFolderChars.ReplaceAllString(version, "_")
+}
+
+// NewReader returns the Reader of a remote dependency labeled name
+func NewReader(name string, record DependencyRecord) Reader {
+	if record.Source == SOURCE_GIT {
+		return NewGitCloneReader(name, record)
+	}
+	return NewGitReader(name, record)
+}
+
 func LocationIsBinding(location string) bool {
 	if strings.HasPrefix(location, "/"+WHISK_SYSTEM) || strings.HasPrefix(location, "/") {
 		return true
@@ -77,6 +158,34 @@ func LocationIsBinding(location string) bool {
 	return false
 }
 
+// LocationIsLocal tells whether the location is a folder relative to the manifest, e.g. ./packages/utils
+func LocationIsLocal(location string) bool {
+	for _, prefix := range []string{".", ".."} {
+		if location == prefix || strings.HasPrefix(location, prefix+"/") || strings.HasPrefix(location, prefix+"\\") {
+			return true
+		}
+	}
+	return false
+}
+
+// LocationIsGit tells whether the location is a git remote to clone: a git, ssh or file
+// URL, a git+ prefixed URL, an scp-like location or any URL of a .git repository
+func LocationIsGit(location string) bool {
+	if LocationIsLocal(location) {
+		return false
+	}
+	for _, scheme := range gitSchemes {
+		if strings.HasPrefix(location, scheme) {
+			return true
+		}
+	}
+	if gitScpLocation.MatchString(location) {
+		return true
+	}
+	repo, _ := splitGitLocation(location)
+	return strings.HasSuffix(repo, GIT_SUFFIX)
+}
+
 func removeProtocol(location string) string {
 	if paths := strings.SplitAfterN(location, "://", 2); len(paths) == 2 {
 		return paths[1]
@@ -93,8 +202,20 @@ func CompareDependencyRecords(d1 DependencyRecord, d2 DependencyRecord) bool {
 	if (d1.Location == d2.Location) && (d1.Version == d2.Version) {
 		return true
 	}
-	if (d1.BaseRepo == d2.BaseRepo) && (d1.SubFolder == d2.SubFolder) && (d1.Version == d2.Version) {
+	// bindings have no repository
+	if len(d1.BaseRepo) != 0 && (d1.BaseRepo == d2.BaseRepo) && (d1.SubFolder == d2.SubFolder) && (d1.Version == d2.Version) {
 		return true
 	}
 	return false
 }
+
+// CompatibleDependencyRecords tells whether the same package can satisfy both records: they are
+// the same or they request version ranges of the same repository, which the dependency resolver
+// narrows down to a tag satisfying both
+func CompatibleDependencyRecords(d1 DependencyRecord, d2 DependencyRecord) bool {
+	if CompareDependencyRecords(d1, d2) {
+		return true
+	}
+	return d1.IsRemote() && d2.IsRemote() && d1.Location == d2.Location &&
+		semver.IsRange(d1.Version) && semver.IsRange(d2.Version)
+}
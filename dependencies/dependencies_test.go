@@ -1,3 +1,4 @@
+//go:build unit
 // +build unit
 
 /*
@@ -72,3 +73,91 @@ func TestLocationIsBinding_InvalidNamespace(t *testing.T) {
 	assert.False(t, LocationIsBinding("namespace/package"), "Allows namespace/package")
 	assert.False(t, LocationIsBinding("namespace"), "Allows just namespace")
 }
+
+func TestLocationIsLocal(t *testing.T) {
+	assert.True(t, LocationIsLocal("."), "Does not allow the folder of the manifest")
+	assert.True(t, LocationIsLocal("./packages/utils"), "Does not allow ./packages/utils")
+	assert.True(t, LocationIsLocal("../utils"), "Does not allow ../utils")
+	assert.True(t, LocationIsLocal("..\\utils"), "Does not allow ..\\utils")
+	assert.False(t, LocationIsLocal(".utils"), "Allows a hidden folder without a ./ prefix")
+	assert.False(t, LocationIsLocal("github.com/my-org/my-project"), "Allows github")
+	assert.False(t, LocationIsLocal("/whisk.system/cloudant"), "Allows package binding")
+}
+
+func TestLocationIsGit(t *testing.T) {
+	assert.True(t, LocationIsGit("git+https://git.com/my-org/my-project"), "Does not allow git+https")
+	assert.True(t, LocationIsGit("git+ssh://git@git.com/my-org/my-project.git"), "Does not allow git+ssh")
+	assert.True(t, LocationIsGit("ssh://git@git.com/my-org/my-project"), "Does not allow ssh")
+	assert.True(t, LocationIsGit("git://git.com/my-org/my-project"), "Does not allow git")
+	assert.True(t, LocationIsGit("file:///srv/git/my-project.git"), "Does not allow file")
+	assert.True(t, LocationIsGit("git@git.com:my-org/my-project.git"), "Does not allow scp-like locations")
+	assert.True(t, LocationIsGit("https://git.com/my-org/my-project.git"), "Does not allow https repositories ending with .git")
+	assert.False(t, LocationIsGit("https://git.com/my-org/my-project"), "Allows https without .git")
+	assert.False(t, LocationIsGit("github.com/my-org/my-project"), "Allows github")
+	assert.False(t, LocationIsGit("username:password@github.com/my-org/my-project"), "Allows username/password and github")
+	assert.False(t, LocationIsGit("./my-project.git"), "Allows local folders")
+}
+
+func TestNewDependencyRecord_Git(t *testing.T) {
+	record := NewDependencyRecord("Packages", "pkg", "git+https://git.com/my-org/my-project.git//packages/utils", "v1.0", nil, nil, false)
+	assert.Equal(t, SOURCE_GIT, record.Source)
+	assert.Equal(t, "https://git.com/my-org/my-project.git", record.BaseRepo)
+	assert.Equal(t, "/packages/utils", record.SubFolder)
+	assert.True(t, record.IsRemote())
+	assert.IsType(t, &GitCloneReader{}, NewReader("utils", record))
+
+	record = NewDependencyRecord("Packages", "pkg", "git@git.com:my-org/my-project.git", "HEAD", nil, nil, false)
+	assert.Equal(t, "git@git.com:my-org/my-project.git", record.BaseRepo)
+	assert.Empty(t, record.SubFolder)
+
+	record = NewDependencyRecord("Packages", "pkg", "file:///srv/git/my-project.git//utils", "HEAD", nil, nil, false)
+	assert.Equal(t, "file:///srv/git/my-project.git", record.BaseRepo)
+	assert.Equal(t, "/utils", record.SubFolder)
+}
+
+func TestNewDependencyRecord_Sources(t *testing.T) {
+	record := NewDependencyRecord("Packages", "pkg", "./utils", "master", nil, nil, false)
+	assert.Equal(t, SOURCE_LOCAL, record.Source)
+	assert.False(t, record.IsRemote())
+
+	record = NewDependencyRecord("Packages", "pkg", "/whisk.system/cloudant", "master", nil, nil, true)
+	assert.Equal(t, SOURCE_BINDING, record.Source)
+	assert.False(t, record.IsRemote())
+
+	record = NewDependencyRecord("Packages", "pkg", "https://github.com/my-org/my-project/packages/utils", "master", nil, nil, false)
+	assert.Equal(t, SOURCE_GITHUB, record.Source)
+	assert.Equal(t, "https://github.com/my-org/my-project", record.BaseRepo)
+	assert.Equal(t, "/packages/utils", record.SubFolder)
+	assert.IsType(t, &GitReader{}, NewReader("utils", record))
+}
+
+func TestNewDependencyRecord_ShortLocation(t *testing.T) {
+	// a location missing the repository must not panic
+	record := NewDependencyRecord("Packages", "pkg", "https://github.com/my-org", "master", nil, nil, false)
+	assert.Equal(t, "https://github.com/my-org", record.BaseRepo)
+	assert.Empty(t, record.SubFolder)
+}
+
+func TestCompareDependencyRecords(t *testing.T) {
+	github := NewDependencyRecord("Packages", "pkg", "https://github.com/my-org/my-project/utils", "master", nil, nil, false)
+	other := NewDependencyRecord("Packages", "other", "https://github.com/my-org/my-project/utils", "master", nil, nil, false)
+	assert.True(t, CompareDependencyRecords(github, other), "Does not match the same location and version")
+	other.Version = "v1.0"
+	assert.False(t, CompareDependencyRecords(github, other), "Matches another version")
+
+	cloudant := NewDependencyRecord("Packages", "pkg", "/whisk.system/cloudant", "master", nil, nil, true)
+	binding := NewDependencyRecord("Packages", "pkg", "/whisk.system/other", "master", nil, nil, true)
+	assert.False(t, CompareDependencyRecords(cloudant, binding), "Matches bindings to different packages")
+}
+
+func TestCompatibleDependencyRecords(t *testing.T) {
+	caret := NewDependencyRecord("Packages", "pkg", "https://github.com/my-org/my-project/utils", "^1.0.0", nil, nil, false)
+	tilde := NewDependencyRecord("Packages", "other", "https://github.com/my-org/my-project/utils", "~1.2.0", nil, nil, false)
+	assert.True(t, CompatibleDependencyRecords(caret, tilde), "Does not accept two ranges of the same repository")
+	assert.True(t, CompatibleDependencyRecords(caret, caret), "Does not accept the same record")
+
+	tag := NewDependencyRecord("Packages", "other", "https://github.com/my-org/my-project/utils", "v1.2.0", nil, nil, false)
+	assert.False(t, CompatibleDependencyRecords(caret, tag), "Accepts a range and a fixed version")
+	other := NewDependencyRecord("Packages", "other", "https://github.com/my-org/other-project/utils", "~1.2.0", nil, nil, false)
+	assert.False(t, CompatibleDependencyRecords(caret, other), "Accepts ranges of different repositories")
+}
@@ -0,0 +1,174 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dependencies
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+)
+
+const (
+	GIT_COMMAND = "git"
+	GIT_DIR     = ".git"
+	GIT_HEADS   = "refs/heads/"
+	GIT_TAGS    = "refs/tags/"
+)
+
+// GitCloneReader downloads a dependency from any git remote with the git command,
+// the version of the dependency is a branch, a tag or a (short) commit
+type GitCloneReader struct {
+	Name        string // the name of the dependency
+	Url         string // the git remote, e.g. https://host/org/repo.git or file:///srv/git/repo.git
+	Version     string
+	ProjectPath string // The root folder of all dependency packages, e.g. src_project_path/Packages
+}
+
+func NewGitCloneReader(name string, record DependencyRecord) *GitCloneReader {
+	return &GitCloneReader{
+		Name:        name,
+		Url:         record.BaseRepo,
+		Version:     record.Version,
+		ProjectPath: record.ProjectPath,
+	}
+}
+
+// Path returns the folder the dependency is checked out to
+func (reader *GitCloneReader) Path() string {
+	return filepath.Join(reader.ProjectPath, DependencyFolder(reader.Name, reader.Version))
+}
+
+// ResolveCommit looks the reference up among the branches and tags of the remote, and
+// resolves it in a clone of the remote otherwise, e.g. for an abbreviated commit
+func (reader *GitCloneReader) ResolveCommit(ref string) (string, error) {
+	if commitSHA.MatchString(ref) {
+		return ref, nil
+	}
+
+	// the peeled pattern lists the commit of an annotated tag
+	refs, err := reader.git("", "ls-remote", reader.Url, ref, ref+"^{}")
+	if err == nil {
+		commit := ""
+		for _, line := range strings.Split(refs, "\n") {
+			fields := strings.Fields(line)
+			if len(fields) != 2 {
+				continue
+			}
+			// a branch or a lightweight tag, unless an annotated tag was found already
+			switch fields[1] {
+			case GIT_HEADS + ref, GIT_TAGS + ref, ref:
+				if len(commit) == 0 {
+					commit = fields[0]
+				}
+			case GIT_TAGS + ref + "^{}":
+				commit = fields[0]
+			}
+		}
+		if len(commit) > 0 {
+			return commit, nil
+		}
+
+		var dir string
+		if dir, err = reader.clone(); err == nil {
+			defer os.RemoveAll(dir)
+			commit, err = reader.git(dir, "rev-parse", "--verify", "--quiet", ref+"^{commit}")
+			if err == nil {
+				return strings.TrimSpace(commit), nil
+			}
+		}
+	}
+	return "", wskderrors.NewDependencyError(reader.Name, wski18n.T(wski18n.ID_ERR_DEPENDENCY_RESOLVE_X_version_X_err_X,
+		map[string]interface{}{wski18n.KEY_VERSION: ref, wski18n.KEY_ERR: err.Error()}))
+}
+
+// Tags lists the tags of the remote
+func (reader *GitCloneReader) Tags() ([]string, error) {
+	refs, err := reader.git("", "ls-remote", "--tags", "--refs", reader.Url)
+	if err != nil {
+		return nil, wskderrors.NewDependencyError(reader.Name, wski18n.T(wski18n.ID_ERR_DEPENDENCY_RESOLVE_X_version_X_err_X,
+			map[string]interface{}{wski18n.KEY_VERSION: reader.Version, wski18n.KEY_ERR: err.Error()}))
+	}
+	tags := []string{}
+	for _, line := range strings.Split(refs, "\n") {
+		if fields := strings.Fields(line); len(fields) == 2 && strings.HasPrefix(fields[1], GIT_TAGS) {
+			tags = append(tags, strings.TrimPrefix(fields[1], GIT_TAGS))
+		}
+	}
+	return tags, nil
+}
+
+// Download clones the remote and checks the given commit out, only keeping its files
+func (reader *GitCloneReader) Download(commit string) error {
+	dir, err := reader.clone()
+	if err != nil {
+		return wskderrors.NewDependencyError(reader.Name, wski18n.T(wski18n.ID_ERR_DEPENDENCY_DOWNLOAD_X_url_X_err_X,
+			map[string]interface{}{wski18n.KEY_URL: reader.Url, wski18n.KEY_ERR: err.Error()}))
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err := reader.git(dir, "checkout", "--quiet", "--detach", commit); err != nil {
+		return wskderrors.NewDependencyError(reader.Name, wski18n.T(wski18n.ID_ERR_DEPENDENCY_RESOLVE_X_version_X_err_X,
+			map[string]interface{}{wski18n.KEY_VERSION: commit, wski18n.KEY_ERR: err.Error()}))
+	}
+	if err := os.RemoveAll(filepath.Join(dir, GIT_DIR)); err != nil {
+		return err
+	}
+
+	//if the folder exists, remove it at first, it may hold another commit
+	depPath := reader.Path()
+	if err := os.RemoveAll(depPath); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(depPath), os.ModePerm); err != nil {
+		return err
+	}
+	return os.Rename(dir, depPath)
+}
+
+// clone clones the remote, without checking any commit out, into a new folder under ProjectPath
+func (reader *GitCloneReader) clone() (string, error) {
+	if err := os.MkdirAll(reader.ProjectPath, os.ModePerm); err != nil {
+		return "", err
+	}
+	dir, err := ioutil.TempDir(reader.ProjectPath, reader.Name+".git.")
+	if err != nil {
+		return "", err
+	}
+	if _, err := reader.git("", "clone", "--quiet", "--no-checkout", reader.Url, dir); err != nil {
+		os.RemoveAll(dir)
+		return "", err
+	}
+	return dir, nil
+}
+
+// git runs a git command in dir, never prompting for credentials, and returns its output
+func (reader *GitCloneReader) git(dir string, args ...string) (string, error) {
+	command := exec.Command(GIT_COMMAND, args...)
+	command.Dir = dir
+	command.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	output, err := command.CombinedOutput()
+	if err != nil {
+		return "", wskderrors.NewCommandError(strings.Join(command.Args, " "), strings.TrimSpace(string(output)))
+	}
+	return string(output), nil
+}
@@ -0,0 +1,135 @@
+//go:build unit
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dependencies
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/stretchr/testify/assert"
+)
+
+// newGitRepository creates a repository with two commits of a manifest, the first one
+// tagged v1.0 (annotated) and the second one on branch master, and returns its location
+// with the commits
+func newGitRepository(t *testing.T, dir string) (string, []string) {
+	if _, err := exec.LookPath(GIT_COMMAND); err != nil {
+		t.Skip("git is not installed")
+	}
+	repo := filepath.Join(dir, "repo")
+	run := func(args ...string) string {
+		command := exec.Command(GIT_COMMAND, args...)
+		command.Dir = repo
+		command.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+		output, err := command.CombinedOutput()
+		assert.NoError(t, err, string(output))
+		return strings.TrimSpace(string(output))
+	}
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(repo, "utils"), os.ModePerm))
+	run("init", "--quiet")
+	run("checkout", "--quiet", "-b", "master")
+	commits := []string{}
+	for _, content := range []string{"v1", "v2"} {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(repo, "utils", "manifest.yaml"), []byte(content), 0644))
+		run("add", "-A")
+		run("commit", "--quiet", "-m", content)
+		commits = append(commits, run("rev-parse", "HEAD"))
+		if content == "v1" {
+			run("tag", "-a", "v1.0", "-m", "v1.0")
+		}
+	}
+	return "file://" + filepath.ToSlash(repo), commits
+}
+
+func TestGitCloneReader_ResolveCommit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gitclone")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	location, commits := newGitRepository(t, dir)
+
+	versions := map[string]string{
+		"master":       commits[1],
+		"HEAD":         commits[1],
+		"v1.0":         commits[0],
+		commits[0][:7]: commits[0],
+		commits[0]:     commits[0],
+	}
+	for version, commit := range versions {
+		record := NewDependencyRecord(filepath.Join(dir, "Packages"), "pkg", location, version, nil, nil, false)
+		resolved, err := NewReader("utils", record).ResolveCommit(version)
+		assert.NoError(t, err, version)
+		assert.Equal(t, commit, resolved, version)
+	}
+
+	record := NewDependencyRecord(filepath.Join(dir, "Packages"), "pkg", location, "unknown", nil, nil, false)
+	_, err = NewReader("utils", record).ResolveCommit("unknown")
+	assert.IsType(t, &wskderrors.DependencyError{}, err)
+}
+
+func TestGitCloneReader_Tags(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gitclone")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	location, commits := newGitRepository(t, dir)
+
+	record := NewDependencyRecord(filepath.Join(dir, "Packages"), "pkg", location, "^1.0", nil, nil, false)
+	tags, err := NewReader("utils", record).Tags()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"v1.0"}, tags)
+
+	// the range resolves to the commit of the annotated tag, not to the tag object
+	lock := NewLockfile(filepath.Join(dir, LOCKFILE))
+	assert.NoError(t, lock.Fetch("utils", record))
+	assert.Equal(t, "v1.0", lock.Dependencies["utils"].Tag)
+	assert.Equal(t, commits[0], lock.Dependencies["utils"].Commit)
+}
+
+func TestGitCloneReader_Download(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gitclone")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	location, commits := newGitRepository(t, dir)
+
+	record := NewDependencyRecord(filepath.Join(dir, "Packages"), "pkg", "git+"+location+"//utils", "master", nil, nil, false)
+	assert.Equal(t, SOURCE_GIT, record.Source)
+	reader := NewReader("utils", record)
+	for i, content := range []string{"v1", "v2"} {
+		assert.NoError(t, reader.Download(commits[i]))
+		manifest, err := ioutil.ReadFile(filepath.Join(reader.Path(), record.SubFolder, "manifest.yaml"))
+		assert.NoError(t, err)
+		assert.Equal(t, content, string(manifest))
+		_, err = os.Stat(filepath.Join(reader.Path(), GIT_DIR))
+		assert.True(t, os.IsNotExist(err), "the git metadata must not be kept")
+	}
+
+	// nothing is left behind next to the dependency
+	entries, err := ioutil.ReadDir(record.ProjectPath)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(entries))
+}
@@ -19,23 +19,43 @@ package dependencies
 
 import (
 	"archive/zip"
+	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"io/ioutil"
+
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+)
+
+const (
+	GITHUB_HOST        = "github.com"
+	GITHUB_API_HOST    = "api.github.com"
+	GITHUB_API_PATH    = "/api/v3" // REST API of GitHub Enterprise hosts
+	GITHUB_MEDIA_SHA   = "application/vnd.github.sha"
+	GITHUB_MEDIA_JSON  = "application/vnd.github.v3+json"
+	GITHUB_PAGE_SIZE   = 100
+	HTTP_HEADER_ACCEPT = "Accept"
 )
 
+// a full commit SHA, versions which already are one need not be resolved
+var commitSHA = regexp.MustCompile("^[0-9a-f]{40}$")
+
 type GitReader struct {
 	Name string // the name of the dependency
 	Url  string // pkg repo location, e.g. github.com/user/repo
 	//BaseRepo    string	// base url of the git repo, e.g. github.com/user/repo
 	//SubFolder   string	// subfolder of the package under BaseUrl
 	Version     string
+	Commit      string // commit to download instead of Version, e.g. the one locked in wskdeploy.lock
 	ProjectPath string // The root folder of all dependency packages, e.g. src_project_path/Packages
 	packageName string
 }
@@ -53,10 +73,105 @@ func NewGitReader(projectName string, record DependencyRecord) *GitReader {
 
 }
 
+// Path returns the folder the dependency is extracted to
+func (reader *GitReader) Path() string {
+	return filepath.Join(reader.ProjectPath, DependencyFolder(reader.Name, reader.Version))
+}
+
+// ResolveCommit asks the GitHub REST API for the SHA of the commit a branch, tag or
+// commit of the repository currently designates
+func (reader *GitReader) ResolveCommit(ref string) (string, error) {
+	if commitSHA.MatchString(ref) {
+		return ref, nil
+	}
+
+	body, err := reader.api("/commits/"+url.PathEscape(ref), GITHUB_MEDIA_SHA)
+	commit := strings.TrimSpace(string(body))
+	if err == nil && !commitSHA.MatchString(commit) {
+		err = wskderrors.NewCommandError(reader.Url, commit)
+	}
+	if err != nil {
+		return "", wskderrors.NewDependencyError(reader.Name, wski18n.T(wski18n.ID_ERR_DEPENDENCY_RESOLVE_X_version_X_err_X,
+			map[string]interface{}{wski18n.KEY_VERSION: ref, wski18n.KEY_ERR: err.Error()}))
+	}
+	return commit, nil
+}
+
+// Tags asks the GitHub REST API for the tags of the repository, one page at a time
+func (reader *GitReader) Tags() ([]string, error) {
+	tags := []string{}
+	for page := 1; ; page++ {
+		body, err := reader.api(fmt.Sprintf("/tags?per_page=%d&page=%d", GITHUB_PAGE_SIZE, page), GITHUB_MEDIA_JSON)
+		var names []struct {
+			Name string `json:"name"`
+		}
+		if err == nil {
+			err = json.Unmarshal(body, &names)
+		}
+		if err != nil {
+			return nil, wskderrors.NewDependencyError(reader.Name, wski18n.T(wski18n.ID_ERR_DEPENDENCY_RESOLVE_X_version_X_err_X,
+				map[string]interface{}{wski18n.KEY_VERSION: reader.Version, wski18n.KEY_ERR: err.Error()}))
+		}
+		for _, tag := range names {
+			tags = append(tags, tag.Name)
+		}
+		if len(names) < GITHUB_PAGE_SIZE {
+			return tags, nil
+		}
+	}
+}
+
+// api sends a GET request for the given path under the repository to the GitHub REST
+// API, with the credentials of the location of the dependency, and returns the body
+func (reader *GitReader) api(path string, media string) ([]byte, error) {
+	repo, err := url.Parse(reader.Url)
+	if err != nil {
+		return nil, err
+	}
+	api := repo.Scheme + "://" + repo.Host + GITHUB_API_PATH
+	if repo.Host == GITHUB_HOST {
+		api = repo.Scheme + "://" + GITHUB_API_HOST
+	}
+
+	request, err := http.NewRequest(http.MethodGet, api+"/repos"+repo.Path+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set(HTTP_HEADER_ACCEPT, media)
+	if repo.User != nil {
+		password, _ := repo.User.Password()
+		request.SetBasicAuth(repo.User.Username(), password)
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, wskderrors.NewCommandError(request.URL.String(), response.Status)
+	}
+	return body, nil
+}
+
+// Download downloads and extracts the zipball of the given commit
+func (reader *GitReader) Download(commit string) error {
+	reader.Commit = commit
+	return reader.CloneDependency()
+}
+
 func (reader *GitReader) CloneDependency() error {
 
-	zipFilePrefix := reader.Name + "." + reader.Version + ".zip."
-	zipFilePath := reader.Url + "/zipball" + "/" + reader.Version
+	ref := reader.Version
+	if len(reader.Commit) > 0 {
+		ref = reader.Commit
+	}
+	zipFilePrefix := DependencyFolder(reader.Name, reader.Version) + ".zip."
+	zipFilePath := reader.Url + "/zipball" + "/" + ref
 
 	projectPath := reader.ProjectPath
 	os.MkdirAll(projectPath, os.ModePerm)
@@ -73,6 +188,10 @@ func (reader *GitReader) CloneDependency() error {
 		return err
 	}
 	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return wskderrors.NewDependencyError(reader.Name, wski18n.T(wski18n.ID_ERR_DEPENDENCY_DOWNLOAD_X_url_X_err_X,
+			map[string]interface{}{wski18n.KEY_URL: zipFilePath, wski18n.KEY_ERR: response.Status}))
+	}
 
 	data, err := ioutil.ReadAll(response.Body)
 	if err != nil {
@@ -88,6 +207,7 @@ func (reader *GitReader) CloneDependency() error {
 	if err != nil {
 		return err
 	}
+	defer zipReader.Close()
 
 	u, err := url.Parse(reader.Url)
 	team, _ := path.Split(u.Path)
@@ -121,13 +241,14 @@ func (reader *GitReader) CloneDependency() error {
 	}
 
 	rootDir := filepath.Join(projectPath, zipReader.File[0].Name)
-	depPath := filepath.Join(projectPath, reader.Name+"-"+reader.Version)
+	depPath := reader.Path()
 
-	//if the folder exists, remove it at first
+	//if the folder exists, remove it at first, it may hold another commit
 	if _, err := os.Stat(depPath); err == nil {
-		os.Remove(depPath)
+		if err := os.RemoveAll(depPath); err != nil {
+			return err
+		}
 	}
 
-	os.Rename(rootDir, depPath)
-	return nil
+	return os.Rename(rootDir, depPath)
 }
@@ -0,0 +1,317 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dependencies
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/apache/openwhisk-wskdeploy/semver"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+	"github.com/apache/openwhisk-wskdeploy/wskprint"
+)
+
+// Location and format of the lockfile, relative to the project path
+const (
+	LOCKFILE         = "wskdeploy.lock"
+	LOCKFILE_VERSION = 1
+	CHECKSUM_PREFIX  = "sha256:"
+)
+
+// LockedDependency pins a remote dependency to the commit its version resolved to
+type LockedDependency struct {
+	Location string `json:"location"`      // location of the dependency in the manifest
+	Version  string `json:"version"`       // version of the dependency in the manifest, e.g. master
+	Tag      string `json:"tag,omitempty"` // highest tag satisfying the version, if it is a range, e.g. ^1.4.0
+	Commit   string `json:"commit"`        // commit SHA the version resolved to
+	Checksum string `json:"checksum"`      // checksum of the extracted tree, see TreeChecksum
+}
+
+// Lockfile is the content of wskdeploy.lock, keyed by dependency label
+type Lockfile struct {
+	Version      int                         `json:"version"`
+	Dependencies map[string]LockedDependency `json:"dependencies"`
+
+	Path      string `json:"-"`
+	Frozen    bool   `json:"-"` // fail instead of changing the lockfile
+	Verbose   bool   `json:"-"`
+	Cache     *Cache `json:"-"` // downloaded commits, none if nil
+	VendorDir string `json:"-"` // vendored copies of the locked dependencies, by label
+	mutex     sync.Mutex
+	changed   bool
+}
+
+// LockfilePath returns the location of the lockfile of the project under projectPath
+func LockfilePath(projectPath string) string {
+	return filepath.Join(projectPath, LOCKFILE)
+}
+
+// NewLockfile returns an empty lockfile saved to path
+func NewLockfile(path string) *Lockfile {
+	return &Lockfile{
+		Version:      LOCKFILE_VERSION,
+		Dependencies: make(map[string]LockedDependency),
+		Path:         path,
+	}
+}
+
+// ReadLockfile loads the lockfile of the project under projectPath, a missing
+// lockfile is an empty one
+func ReadLockfile(projectPath string) (*Lockfile, error) {
+	lock := NewLockfile(LockfilePath(projectPath))
+	content, err := ioutil.ReadFile(lock.Path)
+	if os.IsNotExist(err) {
+		return lock, nil
+	}
+	if err == nil {
+		err = json.Unmarshal(content, lock)
+	}
+	if err != nil {
+		return nil, wskderrors.NewFileReadError(lock.Path, err.Error())
+	}
+	if lock.Dependencies == nil {
+		lock.Dependencies = make(map[string]LockedDependency)
+	}
+	return lock, nil
+}
+
+// Changed tells whether the lockfile was modified since it was read
+func (lock *Lockfile) Changed() bool {
+	lock.mutex.Lock()
+	defer lock.mutex.Unlock()
+	return lock.changed
+}
+
+// Write saves the lockfile to its Path
+func (lock *Lockfile) Write() error {
+	lock.mutex.Lock()
+	defer lock.mutex.Unlock()
+
+	content, err := json.MarshalIndent(lock, "", "  ")
+	if err == nil {
+		err = ioutil.WriteFile(lock.Path, append(content, '\n'), 0644)
+	}
+	if err != nil {
+		errMessage := wski18n.T(wski18n.ID_ERR_LOCKFILE_WRITE_X_path_X_err_X,
+			map[string]interface{}{wski18n.KEY_PATH: lock.Path, wski18n.KEY_ERR: err.Error()})
+		return wskderrors.NewFileReadError(lock.Path, errMessage)
+	}
+	lock.changed = false
+	return nil
+}
+
+// lookup returns the entry of the dependency if it was locked with the same location and
+// version, or, for a version range, at a tag which satisfies the range. A dependency requested
+// at several ranges is locked at a tag satisfying all of them, see semver.Intersect.
+func (lock *Lockfile) lookup(name string, record DependencyRecord) (LockedDependency, bool) {
+	lock.mutex.Lock()
+	defer lock.mutex.Unlock()
+	entry, ok := lock.Dependencies[name]
+	if !ok || entry.Location != record.Location {
+		return entry, false
+	}
+	return entry, entry.Version == record.Version || semver.Satisfies(entry.Tag, record.Version)
+}
+
+// Locked returns the entry of the dependency labeled name, if any
+func (lock *Lockfile) Locked(name string) (LockedDependency, bool) {
+	lock.mutex.Lock()
+	defer lock.mutex.Unlock()
+	entry, ok := lock.Dependencies[name]
+	return entry, ok
+}
+
+func (lock *Lockfile) update(name string, entry LockedDependency) {
+	lock.mutex.Lock()
+	defer lock.mutex.Unlock()
+	lock.Dependencies[name] = entry
+	lock.changed = true
+}
+
+// Fetch downloads the remote dependency into its project path at the commit recorded
+// in the lockfile. A dependency which is not locked yet, or whose location or version
+// changed, unless its locked tag satisfies the new range, has its version resolved to a commit first, through the highest tag which
+// satisfies the version if it is a semantic version range, e.g. ^1.4.0. Unless the
+// lockfile is Frozen, the commit and the checksum of the downloaded tree are recorded.
+// Local dependencies are part of the project and are neither downloaded nor locked.
+// Unless they are vendored or cached, dependencies are downloaded from their repository.
+func (lock *Lockfile) Fetch(name string, record DependencyRecord) error {
+	if !record.IsRemote() {
+		return nil
+	}
+	reader := NewReader(name, record)
+
+	entry, locked := lock.lookup(name, record)
+	if !locked {
+		if lock.Frozen {
+			return wskderrors.NewDependencyError(name, wski18n.T(wski18n.ID_ERR_DEPENDENCY_NOT_LOCKED_X_path_X,
+				map[string]interface{}{wski18n.KEY_PATH: lock.Path}))
+		}
+		entry = LockedDependency{Location: record.Location, Version: record.Version}
+		ref := record.Version
+		if semver.IsRange(record.Version) {
+			tag, err := resolveTag(name, record.Version, reader)
+			if err != nil {
+				return err
+			}
+			entry.Tag, ref = tag, tag
+		}
+		commit, err := reader.ResolveCommit(ref)
+		if err != nil {
+			return err
+		}
+		entry.Commit = commit
+	}
+
+	if err := lock.download(name, record, reader, entry, locked); err != nil {
+		return err
+	}
+	checksum, err := TreeChecksum(reader.Path())
+	if err != nil {
+		return wskderrors.NewDependencyError(name, err.Error())
+	}
+
+	switch {
+	case !locked:
+		wskprint.PrintlnOpenWhiskVerbose(lock.Verbose, wski18n.T(wski18n.ID_MSG_DEPENDENCY_LOCKED_X_dependency_X_commit_X,
+			map[string]interface{}{wski18n.KEY_DEPENDENCY: name, wski18n.KEY_COMMIT: entry.Commit}))
+	case entry.Checksum == checksum:
+		return nil
+	case lock.Frozen:
+		return wskderrors.NewDependencyError(name, wski18n.T(wski18n.ID_ERR_DEPENDENCY_CHECKSUM_X_commit_X_expected_X_actual_X,
+			map[string]interface{}{
+				wski18n.KEY_COMMIT:   entry.Commit,
+				wski18n.KEY_EXPECTED: entry.Checksum,
+				wski18n.KEY_ACTUAL:   checksum}))
+	default:
+		wskprint.PrintOpenWhiskWarning(wski18n.T(wski18n.ID_WARN_DEPENDENCY_CHECKSUM_X_dependency_X_commit_X,
+			map[string]interface{}{wski18n.KEY_DEPENDENCY: name, wski18n.KEY_COMMIT: entry.Commit}))
+	}
+	entry.Checksum = checksum
+	lock.update(name, entry)
+	return nil
+}
+
+// resolveTag returns the highest tag of the repository which satisfies the version range
+func resolveTag(name string, version string, reader Reader) (string, error) {
+	versionRange, err := semver.ParseRange(version)
+	if err != nil {
+		return "", wskderrors.NewDependencyError(name, wski18n.T(wski18n.ID_ERR_DEPENDENCY_RESOLVE_X_version_X_err_X,
+			map[string]interface{}{wski18n.KEY_VERSION: version, wski18n.KEY_ERR: err.Error()}))
+	}
+	tags, err := reader.Tags()
+	if err != nil {
+		return "", err
+	}
+	tag, ok := versionRange.MaxSatisfying(tags)
+	if !ok {
+		return "", wskderrors.NewDependencyError(name, wski18n.T(wski18n.ID_ERR_DEPENDENCY_NO_TAG_X_version_X,
+			map[string]interface{}{wski18n.KEY_VERSION: version}))
+	}
+	return tag, nil
+}
+
+// download copies the files of the dependency at the commit of entry to the path of the
+// reader, from the vendor folder, the cache or the repository, in this order. Vendored and
+// cached files which no longer match the checksum of a locked dependency are ignored.
+func (lock *Lockfile) download(name string, record DependencyRecord, reader Reader, entry LockedDependency, locked bool) error {
+	if locked && len(lock.VendorDir) != 0 {
+		vendored := filepath.Join(lock.VendorDir, name)
+		if _, err := os.Stat(vendored); err == nil {
+			if checksum, err := TreeChecksum(vendored); err == nil && checksum == entry.Checksum {
+				return replaceTree(vendored, reader.Path())
+			}
+			wskprint.PrintOpenWhiskWarning(wski18n.T(wski18n.ID_WARN_DEPENDENCY_VENDORED_X_dependency_X_path_X,
+				map[string]interface{}{wski18n.KEY_DEPENDENCY: name, wski18n.KEY_PATH: vendored}))
+		}
+	}
+
+	if lock.Cache != nil {
+		cached, err := lock.Cache.Get(record.BaseRepo, entry.Commit, reader.Path())
+		if err != nil {
+			return err
+		}
+		if cached {
+			checksum, err := TreeChecksum(reader.Path())
+			if err == nil && (!locked || checksum == entry.Checksum) {
+				wskprint.PrintlnOpenWhiskVerbose(lock.Verbose, wski18n.T(wski18n.ID_MSG_DEPENDENCY_CACHED_X_dependency_X_commit_X,
+					map[string]interface{}{wski18n.KEY_DEPENDENCY: name, wski18n.KEY_COMMIT: entry.Commit}))
+				return nil
+			}
+			if err := lock.Cache.Remove(record.BaseRepo, entry.Commit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := reader.Download(entry.Commit); err != nil {
+		return err
+	}
+	if lock.Cache != nil {
+		return lock.Cache.Put(record.BaseRepo, entry.Commit, reader.Path())
+	}
+	return nil
+}
+
+// Vendor copies the files of the remote dependency, once fetched, to the vendor folder
+func (lock *Lockfile) Vendor(name string, record DependencyRecord) error {
+	if !record.IsRemote() || len(lock.VendorDir) == 0 {
+		return nil
+	}
+	if err := os.MkdirAll(lock.VendorDir, os.ModePerm); err != nil {
+		return err
+	}
+	return replaceTree(NewReader(name, record).Path(), filepath.Join(lock.VendorDir, name))
+}
+
+// TreeChecksum hashes the relative path and the content of every file under root, in
+// lexical order, so that it only changes when the files of the tree change
+func TreeChecksum(root string) (string, error) {
+	summary := sha256.New()
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		content := sha256.New()
+		if _, err := io.Copy(content, file); err != nil {
+			return err
+		}
+		fmt.Fprintf(summary, "%x  %s\n", content.Sum(nil), filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s%x", CHECKSUM_PREFIX, summary.Sum(nil)), nil
+}
@@ -0,0 +1,297 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dependencies
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	COMMIT_1 = "1111111111111111111111111111111111111111"
+	COMMIT_2 = "2222222222222222222222222222222222222222"
+)
+
+// fakeGitHub serves the commit of the master branch and of the tags of owner/repo, the
+// tags a page at a time, and a zipball of every commit holding a manifest with the commit
+// as content
+type fakeGitHub struct {
+	*httptest.Server
+	master     string
+	tags       []string
+	downloaded []string
+}
+
+func newFakeGitHub() *fakeGitHub {
+	github := &fakeGitHub{master: COMMIT_1}
+	github.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == GITHUB_API_PATH+"/repos/owner/repo/commits/master":
+			w.Write([]byte(github.master))
+		case strings.HasPrefix(r.URL.Path, GITHUB_API_PATH+"/repos/owner/repo/commits/"):
+			tag := strings.TrimPrefix(r.URL.Path, GITHUB_API_PATH+"/repos/owner/repo/commits/")
+			w.Write([]byte(tagCommit(tag)))
+		case r.URL.Path == GITHUB_API_PATH+"/repos/owner/repo/tags":
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+			names := []map[string]string{}
+			for i := (page - 1) * perPage; i < page*perPage && i < len(github.tags); i++ {
+				names = append(names, map[string]string{"name": github.tags[i]})
+			}
+			json.NewEncoder(w).Encode(names)
+		case strings.HasPrefix(r.URL.Path, "/owner/repo/zipball/"):
+			commit := strings.TrimPrefix(r.URL.Path, "/owner/repo/zipball/")
+			github.downloaded = append(github.downloaded, commit)
+			archive := zip.NewWriter(w)
+			archive.Create("owner-repo-" + commit[:7] + "/")
+			file, _ := archive.Create("owner-repo-" + commit[:7] + "/manifest.yaml")
+			file.Write([]byte(commit))
+			archive.Close()
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	return github
+}
+
+// tagCommit returns the fake commit of a tag of fakeGitHub
+func tagCommit(tag string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(tag)))[:40]
+}
+
+func newTestRecord(t *testing.T, github *fakeGitHub) DependencyRecord {
+	dir, err := ioutil.TempDir("", "lockfile")
+	assert.NoError(t, err)
+	return NewDependencyRecord(filepath.Join(dir, "Packages"), "pkg", github.URL+"/owner/repo", "master", nil, nil, false)
+}
+
+func readManifest(t *testing.T, record DependencyRecord) string {
+	content, err := ioutil.ReadFile(filepath.Join(record.ProjectPath, "dep-master", "manifest.yaml"))
+	assert.NoError(t, err)
+	return string(content)
+}
+
+func TestLockfile_Fetch(t *testing.T) {
+	github := newFakeGitHub()
+	defer github.Close()
+	record := newTestRecord(t, github)
+	defer os.RemoveAll(filepath.Dir(record.ProjectPath))
+
+	// the branch is resolved and locked on first use
+	lock := NewLockfile(filepath.Join(filepath.Dir(record.ProjectPath), LOCKFILE))
+	assert.NoError(t, lock.Fetch("dep", record))
+	assert.True(t, lock.Changed())
+	entry := lock.Dependencies["dep"]
+	assert.Equal(t, COMMIT_1, entry.Commit)
+	assert.Equal(t, record.Location, entry.Location)
+	assert.Equal(t, "master", entry.Version)
+	assert.True(t, strings.HasPrefix(entry.Checksum, CHECKSUM_PREFIX))
+	assert.Equal(t, COMMIT_1, readManifest(t, record))
+	assert.NoError(t, lock.Write())
+
+	// the locked commit is deployed even though the branch moved
+	github.master = COMMIT_2
+	lock, err := ReadLockfile(filepath.Dir(record.ProjectPath))
+	assert.NoError(t, err)
+	assert.NoError(t, lock.Fetch("dep", record))
+	assert.False(t, lock.Changed())
+	assert.Equal(t, []string{COMMIT_1, COMMIT_1}, github.downloaded)
+	assert.Equal(t, COMMIT_1, readManifest(t, record))
+
+	// changing the version of the dependency resolves it again
+	record.Version = COMMIT_2
+	assert.NoError(t, lock.Fetch("dep", record))
+	assert.Equal(t, COMMIT_2, lock.Dependencies["dep"].Commit)
+	assert.True(t, lock.Changed())
+}
+
+func TestLockfile_Frozen(t *testing.T) {
+	github := newFakeGitHub()
+	defer github.Close()
+	record := newTestRecord(t, github)
+	defer os.RemoveAll(filepath.Dir(record.ProjectPath))
+
+	lock := NewLockfile(filepath.Join(filepath.Dir(record.ProjectPath), LOCKFILE))
+	lock.Frozen = true
+	err := lock.Fetch("dep", record)
+	assert.IsType(t, &wskderrors.DependencyError{}, err)
+	assert.Equal(t, wskderrors.EXIT_CODE_DEPENDENCY_FAILED, wskderrors.ExitCode(err))
+	assert.Empty(t, github.downloaded, "an unlocked dependency must not be downloaded")
+
+	// a checksum mismatch fails a frozen lockfile, and updates others
+	lock.Dependencies["dep"] = LockedDependency{Location: record.Location, Version: "master", Commit: COMMIT_1, Checksum: CHECKSUM_PREFIX + "0"}
+	err = lock.Fetch("dep", record)
+	assert.IsType(t, &wskderrors.DependencyError{}, err)
+	assert.Contains(t, err.Error(), COMMIT_1)
+	assert.False(t, lock.Changed())
+
+	lock.Frozen = false
+	assert.NoError(t, lock.Fetch("dep", record))
+	assert.True(t, lock.Changed())
+	assert.NotEqual(t, CHECKSUM_PREFIX+"0", lock.Dependencies["dep"].Checksum)
+}
+
+func TestLockfile_Cache(t *testing.T) {
+	github := newFakeGitHub()
+	defer github.Close()
+	record := newTestRecord(t, github)
+	defer os.RemoveAll(filepath.Dir(record.ProjectPath))
+
+	lock := NewLockfile(filepath.Join(filepath.Dir(record.ProjectPath), LOCKFILE))
+	lock.Cache = NewCache(filepath.Join(filepath.Dir(record.ProjectPath), "cache"))
+	assert.NoError(t, lock.Fetch("dep", record))
+	assert.NoError(t, os.RemoveAll(record.ProjectPath))
+
+	// a locked commit is read from the cache
+	assert.NoError(t, lock.Fetch("dep", record))
+	assert.Equal(t, []string{COMMIT_1}, github.downloaded)
+	assert.Equal(t, COMMIT_1, readManifest(t, record))
+
+	// a modified cache entry is downloaded again
+	manifest := filepath.Join(lock.Cache.Path(record.BaseRepo, COMMIT_1), "manifest.yaml")
+	assert.NoError(t, ioutil.WriteFile(manifest, []byte("modified"), 0644))
+	assert.NoError(t, lock.Fetch("dep", record))
+	assert.Equal(t, []string{COMMIT_1, COMMIT_1}, github.downloaded)
+	assert.Equal(t, COMMIT_1, readManifest(t, record))
+	content, err := ioutil.ReadFile(manifest)
+	assert.NoError(t, err)
+	assert.Equal(t, COMMIT_1, string(content), "the cache entry must be replaced")
+}
+
+func TestLockfile_Vendor(t *testing.T) {
+	github := newFakeGitHub()
+	defer github.Close()
+	record := newTestRecord(t, github)
+	defer os.RemoveAll(filepath.Dir(record.ProjectPath))
+
+	lock := NewLockfile(filepath.Join(filepath.Dir(record.ProjectPath), LOCKFILE))
+	lock.VendorDir = filepath.Join(filepath.Dir(record.ProjectPath), VENDOR_DIR)
+	assert.NoError(t, lock.Fetch("dep", record))
+	assert.NoError(t, lock.Vendor("dep", record))
+	assert.NoError(t, os.RemoveAll(record.ProjectPath))
+
+	// a vendored dependency matching the lockfile is not downloaded
+	github.Close()
+	assert.NoError(t, lock.Fetch("dep", record))
+	assert.Equal(t, []string{COMMIT_1}, github.downloaded)
+	assert.Equal(t, COMMIT_1, readManifest(t, record))
+
+	// a modified vendored dependency is ignored
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(lock.VendorDir, "dep", "manifest.yaml"), []byte("modified"), 0644))
+	assert.Error(t, lock.Fetch("dep", record), "the repository is no longer reachable")
+	assert.Equal(t, []string{COMMIT_1}, github.downloaded)
+}
+
+func TestReadLockfile_Missing(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lockfile")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	lock, err := ReadLockfile(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, LOCKFILE_VERSION, lock.Version)
+	assert.Empty(t, lock.Dependencies)
+	assert.Equal(t, filepath.Join(dir, LOCKFILE), lock.Path)
+	_, err = os.Stat(lock.Path)
+	assert.True(t, os.IsNotExist(err), "reading a missing lockfile must not create it")
+}
+
+func TestTreeChecksum(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checksum")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "src"), os.ModePerm))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "manifest.yaml"), []byte("packages:"), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "src", "hello.js"), []byte("function main() {}"), 0644))
+
+	checksum, err := TreeChecksum(dir)
+	assert.NoError(t, err)
+	again, err := TreeChecksum(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, checksum, again)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "src", "hello.js"), []byte("function main() { return {} }"), 0644))
+	changed, err := TreeChecksum(dir)
+	assert.NoError(t, err)
+	assert.NotEqual(t, checksum, changed)
+
+	// renaming a file changes the checksum too
+	assert.NoError(t, os.Rename(filepath.Join(dir, "src", "hello.js"), filepath.Join(dir, "src", "main.js")))
+	renamed, err := TreeChecksum(dir)
+	assert.NoError(t, err)
+	assert.NotEqual(t, changed, renamed)
+}
+
+func TestLockfile_FetchVersionRange(t *testing.T) {
+	github := newFakeGitHub()
+	defer github.Close()
+	// more tags than a page of the REST API
+	for i := 0; i < GITHUB_PAGE_SIZE+20; i++ {
+		github.tags = append(github.tags, fmt.Sprintf("v0.0.%d", i))
+	}
+	github.tags = append(github.tags, "v1.4.0", "v1.4.2", "v1.5.0", "v2.0.0-beta.1", "v2.0.0", "latest")
+	record := newTestRecord(t, github)
+	defer os.RemoveAll(filepath.Dir(record.ProjectPath))
+
+	versions := map[string]string{
+		"^1.4.0":  "v1.5.0",
+		"~1.4":    "v1.4.2",
+		">=1.0.0": "v2.0.0",
+		"~0.0.5":  "v0.0.119",
+	}
+	for version, tag := range versions {
+		record.Version = version
+		lock := NewLockfile(filepath.Join(filepath.Dir(record.ProjectPath), LOCKFILE))
+		assert.NoError(t, lock.Fetch("dep", record), version)
+		entry := lock.Dependencies["dep"]
+		assert.Equal(t, tag, entry.Tag, version)
+		assert.Equal(t, tagCommit(tag), entry.Commit, version)
+		assert.Equal(t, version, entry.Version)
+
+		content, err := ioutil.ReadFile(filepath.Join(record.ProjectPath, DependencyFolder("dep", version), "manifest.yaml"))
+		assert.NoError(t, err)
+		assert.Equal(t, tagCommit(tag), string(content), version)
+	}
+
+	// a branch is not a range and is not resolved through the tags
+	record.Version = "master"
+	lock := NewLockfile(filepath.Join(filepath.Dir(record.ProjectPath), LOCKFILE))
+	assert.NoError(t, lock.Fetch("dep", record))
+	assert.Empty(t, lock.Dependencies["dep"].Tag)
+
+	record.Version = "^3.0.0"
+	err := lock.Fetch("dep", record)
+	assert.IsType(t, &wskderrors.DependencyError{}, err)
+	assert.Contains(t, err.Error(), "^3.0.0")
+}
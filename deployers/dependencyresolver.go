@@ -0,0 +1,286 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/dependencies"
+	"github.com/apache/openwhisk-wskdeploy/parsers"
+	"github.com/apache/openwhisk-wskdeploy/semver"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+	"github.com/apache/openwhisk-wskdeploy/wskprint"
+)
+
+const (
+	DEPENDENCY_CYCLE_SEPARATOR = " -> "
+	DEPENDENCY_TREE_BRANCH     = "├── "
+	DEPENDENCY_TREE_LAST       = "└── "
+	DEPENDENCY_TREE_INDENT     = "│   "
+	DEPENDENCY_TREE_SPACE      = "    "
+)
+
+// DependencyNode is a dependency of the project, the same node stands for every
+// package requesting the dependency
+type DependencyNode struct {
+	Name         string // label of the dependency
+	Record       dependencies.DependencyRecord
+	Tag          string            // tag a version range of a remote dependency resolved to
+	Commit       string            // commit of a remote dependency, from the lockfile
+	RequiredBy   []string          // manifests requesting the dependency
+	Dependencies []*DependencyNode // dependencies of the manifest of the dependency, by label
+}
+
+// DependencyGraph holds every dependency of the project, direct or transitive, once
+type DependencyGraph struct {
+	Roots    []*DependencyNode // dependencies of the manifest of the project, by label
+	Nodes    map[string]*DependencyNode
+	mutex    sync.Mutex
+	deployed map[string]bool
+}
+
+func NewDependencyGraph() *DependencyGraph {
+	return &DependencyGraph{
+		Nodes:    make(map[string]*DependencyNode),
+		deployed: make(map[string]bool),
+	}
+}
+
+// markDeployed records that the dependency labeled name is deployed, and tells whether it
+// was not before; a dependency requested by several packages is deployed once
+func (graph *DependencyGraph) markDeployed(name string) bool {
+	if graph == nil {
+		return true
+	}
+	graph.mutex.Lock()
+	defer graph.mutex.Unlock()
+	if graph.deployed[name] {
+		return false
+	}
+	graph.deployed[name] = true
+	return true
+}
+
+// record returns the record the dependency labeled name was fetched with, which may
+// request another range than the one of a package, see intersect
+func (graph *DependencyGraph) record(name string, record dependencies.DependencyRecord) dependencies.DependencyRecord {
+	if graph == nil {
+		return record
+	}
+	if node, exists := graph.Nodes[name]; exists {
+		return node.Record
+	}
+	return record
+}
+
+// String prints the graph as a tree, a dependency requested by several packages
+// appears under each of them
+func (graph *DependencyGraph) String() string {
+	var lines []string
+	var printNodes func(nodes []*DependencyNode, prefix string)
+	printNodes = func(nodes []*DependencyNode, prefix string) {
+		for i, node := range nodes {
+			branch, indent := DEPENDENCY_TREE_BRANCH, DEPENDENCY_TREE_INDENT
+			if i == len(nodes)-1 {
+				branch, indent = DEPENDENCY_TREE_LAST, DEPENDENCY_TREE_SPACE
+			}
+			lines = append(lines, prefix+branch+node.describe())
+			printNodes(node.Dependencies, prefix+indent)
+		}
+	}
+	printNodes(graph.Roots, "")
+	return strings.Join(lines, "\n")
+}
+
+// describe returns the label, the location and the version of the dependency
+func (node *DependencyNode) describe() string {
+	description := node.Name + " " + requestedVersion(node.Record)
+	switch {
+	case len(node.Tag) != 0:
+		description += " (" + node.Tag + " " + node.Commit + ")"
+	case len(node.Commit) != 0:
+		description += " (" + node.Commit + ")"
+	}
+	return description
+}
+
+// requestedVersion returns the location of the dependency, with the version of a remote one
+func requestedVersion(record dependencies.DependencyRecord) string {
+	if record.IsRemote() {
+		return record.Location + "@" + record.Version
+	}
+	return record.Location
+}
+
+// dependencyResolver walks the dependencies of the project depth first
+type dependencyResolver struct {
+	lock  *dependencies.Lockfile
+	graph *DependencyGraph
+	path  []string // labels of the dependencies being resolved, from the project down
+}
+
+// ResolveDependencies fetches every dependency of the manifest, and of the manifests of
+// these dependencies, at the commit of the lockfile. It fails when the dependencies form
+// a cycle, or when a dependency is requested at incompatible versions. The dependencies
+// which were not locked yet are only recorded in the lockfile by WriteLockfile.
+func (deployer *ServiceDeployer) ResolveDependencies() (*DependencyGraph, error) {
+	lock, err := deployer.lockfile()
+	if err != nil {
+		return nil, err
+	}
+	graph, err := resolveDependencies(lock, deployer.ProjectPath, deployer.ManifestPath)
+	if err != nil {
+		return nil, err
+	}
+	deployer.DependencyGraph = graph
+	return graph, nil
+}
+
+func resolveDependencies(lock *dependencies.Lockfile, projectPath string, manifestPath string) (*DependencyGraph, error) {
+	resolver := &dependencyResolver{lock: lock, graph: NewDependencyGraph()}
+	roots, err := resolver.resolve(projectPath, manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	resolver.graph.Roots = roots
+	return resolver.graph, nil
+}
+
+// resolve returns the dependencies of the manifest at manifestPath, by label
+func (resolver *dependencyResolver) resolve(projectPath string, manifestPath string) ([]*DependencyNode, error) {
+	manifestParser := parsers.NewYAMLParser()
+	manifest, err := manifestParser.ParseManifest(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	deps, err := manifestParser.ComposeDependenciesFromAllPackages(manifest, projectPath, manifestPath, whisk.KeyValue{}, nil)
+	if err != nil {
+		return nil, wskderrors.NewYAMLFileFormatError(manifestPath, err)
+	}
+
+	// the same label in two packages of the manifest is a single dependency
+	nodes := []*DependencyNode{}
+	seen := make(map[string]bool)
+	for _, name := range utils.SortedKeys(deps) {
+		// name is <packagename>:<dependencylabel>
+		depName := strings.Split(name, ":")[1]
+		node, err := resolver.visit(depName, deps[name], manifestPath)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[depName] {
+			seen[depName] = true
+			nodes = append(nodes, node)
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+	return nodes, nil
+}
+
+// visit returns the node of the dependency labeled depName requested by the manifest at
+// manifestPath, fetching the dependency and resolving its own dependencies on first visit
+func (resolver *dependencyResolver) visit(depName string, record dependencies.DependencyRecord, manifestPath string) (*DependencyNode, error) {
+	for i, label := range resolver.path {
+		if label == depName {
+			cycle := append(append([]string{}, resolver.path[i:]...), depName)
+			return nil, wskderrors.NewDependencyError(depName, wski18n.T(wski18n.ID_ERR_DEPENDENCY_CYCLE_X_path_X,
+				map[string]interface{}{wski18n.KEY_PATH: strings.Join(cycle, DEPENDENCY_CYCLE_SEPARATOR)}))
+		}
+	}
+
+	if node, exists := resolver.graph.Nodes[depName]; exists {
+		if !dependencies.CompareDependencyRecords(node.Record, record) {
+			if err := resolver.intersect(node, record, manifestPath); err != nil {
+				return nil, err
+			}
+		}
+		for _, path := range node.RequiredBy {
+			if path == manifestPath {
+				return node, nil
+			}
+		}
+		node.RequiredBy = append(node.RequiredBy, manifestPath)
+		return node, nil
+	}
+
+	node := &DependencyNode{Name: depName, Record: record, RequiredBy: []string{manifestPath}}
+	resolver.graph.Nodes[depName] = node
+	if record.IsBinding {
+		return node, nil
+	}
+	if err := resolver.fetch(node); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// intersect narrows the node to the versions of its repository which also satisfy record,
+// the request of the manifest at manifestPath. Both must request a range of the same
+// repository, e.g. ^1.0.0 and ~1.2.0, the node is fetched again at the highest tag which
+// satisfies both unless its tag already does.
+func (resolver *dependencyResolver) intersect(node *DependencyNode, record dependencies.DependencyRecord, manifestPath string) error {
+	if !dependencies.CompatibleDependencyRecords(node.Record, record) {
+		return wskderrors.NewDependencyError(node.Name, wski18n.T(wski18n.ID_ERR_DEPENDENCY_CONFLICT_X_expected_X_mpath_X_actual_X_path_X,
+			map[string]interface{}{
+				wski18n.KEY_EXPECTED:      requestedVersion(node.Record),
+				wski18n.KEY_MANIFEST_PATH: node.RequiredBy[0],
+				wski18n.KEY_ACTUAL:        requestedVersion(record),
+				wski18n.KEY_PATH:          manifestPath}))
+	}
+	if semver.Satisfies(node.Tag, record.Version) {
+		return nil
+	}
+	node.Record.Version = semver.Intersect(node.Record.Version, record.Version)
+	return resolver.fetch(node)
+}
+
+// fetch downloads the dependency of the node at the commit of the lockfile and resolves
+// the dependencies of its manifest
+func (resolver *dependencyResolver) fetch(node *DependencyNode) error {
+	record := node.Record
+	if err := resolver.lock.Fetch(node.Name, record); err != nil {
+		return err
+	}
+	if entry, locked := resolver.lock.Locked(node.Name); locked && record.IsRemote() {
+		node.Tag, node.Commit = entry.Tag, entry.Commit
+		if len(node.Tag) != 0 {
+			wskprint.PrintlnOpenWhiskInfo(wski18n.T(wski18n.ID_MSG_DEPENDENCY_RESOLVED_X_dependency_X_version_X_tag_X,
+				map[string]interface{}{
+					wski18n.KEY_DEPENDENCY: node.Name,
+					wski18n.KEY_VERSION:    record.Version,
+					wski18n.KEY_TAG:        node.Tag}))
+		}
+	}
+
+	depPath := dependencyProjectPath(node.Name, record)
+	if depManifestPath := utils.GetManifestFilePath(depPath); utils.FileExists(depManifestPath) {
+		resolver.path = append(resolver.path, node.Name)
+		children, err := resolver.resolve(depPath, depManifestPath)
+		resolver.path = resolver.path[:len(resolver.path)-1]
+		if err != nil {
+			return err
+		}
+		node.Dependencies = children
+	}
+	return nil
+}
@@ -0,0 +1,296 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/dependencies"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/stretchr/testify/assert"
+)
+
+// newResolverProject writes the given manifests, keyed by folder relative to the project,
+// and returns a deployer of the manifest at the root of the project
+func newResolverProject(t *testing.T, manifests map[string]string) (*ServiceDeployer, func()) {
+	dir, err := ioutil.TempDir("", "resolver")
+	assert.NoError(t, err)
+	for folder, manifest := range manifests {
+		assert.NoError(t, os.MkdirAll(filepath.Join(dir, folder), os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, folder, utils.ManifestFileNameYaml), []byte(manifest), 0644))
+	}
+	deployer := NewServiceDeployer()
+	deployer.ProjectPath = dir
+	deployer.ManifestPath = filepath.Join(dir, utils.ManifestFileNameYaml)
+	return deployer, func() { os.RemoveAll(dir) }
+}
+
+func TestServiceDeployer_ResolveDependencies(t *testing.T) {
+	deployer, cleanup := newResolverProject(t, map[string]string{
+		".": `packages:
+  root:
+    dependencies:
+      a:
+        location: ./a
+      cloudant:
+        location: /whisk.system/cloudant
+  second:
+    dependencies:
+      a:
+        location: ./a
+      b:
+        location: ./b
+`,
+		"a": `packages:
+  a:
+    dependencies:
+      b:
+        location: ../b
+`,
+		"b": `packages:
+  b:
+`,
+	})
+	defer cleanup()
+
+	graph, err := deployer.ResolveDependencies()
+	assert.NoError(t, err)
+	assert.Equal(t, graph, deployer.DependencyGraph)
+	assert.Equal(t, 3, len(graph.Nodes), "every dependency is resolved once")
+	assert.Equal(t, 3, len(graph.Roots))
+	assert.Equal(t, graph.Nodes["b"], graph.Nodes["a"].Dependencies[0], "a dependency requested twice is a single node")
+	assert.Equal(t, 2, len(graph.Nodes["b"].RequiredBy))
+	assert.Equal(t, 1, len(graph.Nodes["a"].RequiredBy), "packages of the same manifest are a single request")
+	assert.Equal(t, `├── a ./a
+│   └── b ../b
+├── b ../b
+└── cloudant /whisk.system/cloudant`, graph.String())
+
+	assert.True(t, graph.markDeployed("a"))
+	assert.False(t, graph.markDeployed("a"), "a dependency is deployed once")
+}
+
+func TestServiceDeployer_ResolveDependencies_VersionRange(t *testing.T) {
+	restore := withFakeGitHub(map[string]string{"owner/repo": "packages:\n  repo:\n"})
+	defer restore()
+	deployer, cleanup := newResolverProject(t, map[string]string{
+		".": `packages:
+  root:
+    dependencies:
+      repo:
+        location: http://` + LOCK_TEST_HOST + `/owner/repo
+        version: ^1.0.0
+`,
+	})
+	defer cleanup()
+
+	graph, err := deployer.ResolveDependencies()
+	assert.NoError(t, err)
+	node := graph.Nodes["repo"]
+	assert.Equal(t, LOCK_TEST_TAG, node.Tag)
+	assert.Equal(t, LOCK_TEST_COMMIT, node.Commit)
+	assert.Equal(t, "└── repo http://"+LOCK_TEST_HOST+"/owner/repo@^1.0.0 ("+LOCK_TEST_TAG+" "+LOCK_TEST_COMMIT+")", graph.String())
+
+	// the deploy output records the tag the range resolved to
+	result := deployer.deploymentResult(OPERATION_DEPLOY, time.Now(), nil)
+	assert.Equal(t, []DependencyResult{{
+		Name:     "repo",
+		Location: "http://" + LOCK_TEST_HOST + "/owner/repo",
+		Version:  "^1.0.0",
+		Tag:      LOCK_TEST_TAG,
+		Commit:   LOCK_TEST_COMMIT,
+	}}, result.Dependencies)
+}
+
+func TestServiceDeployer_ResolveDependencies_IntersectRanges(t *testing.T) {
+	restore := withFakeGitHubTags(map[string]string{"owner/repo": "packages:\n  repo:\n"}, "v1.2.0", "v1.2.3", "v1.3.0", "v2.0.0")
+	defer restore()
+	location := "http://" + LOCK_TEST_HOST + "/owner/repo"
+	// a, resolved first, accepts v1.3.0 which the project does not
+	deployer, cleanup := newResolverProject(t, map[string]string{
+		".": `packages:
+  root:
+    dependencies:
+      a:
+        location: ./a
+      repo:
+        location: ` + location + `
+        version: ~1.2.0
+`,
+		"a": `packages:
+  a:
+    dependencies:
+      repo:
+        location: ` + location + `
+        version: ^1.0.0
+`,
+	})
+	defer cleanup()
+
+	graph, err := deployer.ResolveDependencies()
+	assert.NoError(t, err)
+	node := graph.Nodes["repo"]
+	assert.Equal(t, "v1.2.3", node.Tag, "the highest tag satisfying both ranges")
+	assert.Equal(t, lockTestCommit("v1.2.3"), node.Commit)
+	assert.Equal(t, "^1.0.0 ~1.2.0", node.Record.Version)
+	assert.Equal(t, 2, len(node.RequiredBy))
+	depPath := dependencyProjectPath("repo", graph.record("repo", dependencies.DependencyRecord{}))
+	assert.True(t, utils.FileExists(utils.GetManifestFilePath(depPath)))
+	assert.Equal(t, node.Record, graph.record("repo", graph.Nodes["a"].Record), "every package deploys the fetched dependency")
+	assert.NoError(t, deployer.WriteLockfile())
+
+	// both ranges are satisfied by the locked tag on the next deployment
+	next := NewServiceDeployer()
+	next.ProjectPath, next.ManifestPath = deployer.ProjectPath, deployer.ManifestPath
+	graph, err = next.ResolveDependencies()
+	assert.NoError(t, err)
+	assert.Equal(t, "v1.2.3", graph.Nodes["repo"].Tag)
+	assert.False(t, next.Lockfile.Changed(), "the lockfile is stable")
+
+	// ranges without a tag in common are incompatible
+	manifestPath := filepath.Join(deployer.ProjectPath, "a", utils.ManifestFileNameYaml)
+	assert.NoError(t, ioutil.WriteFile(manifestPath, []byte(`packages:
+  a:
+    dependencies:
+      repo:
+        location: `+location+`
+        version: ^1.3.0
+`), 0644))
+	assert.NoError(t, os.Remove(dependencies.LockfilePath(deployer.ProjectPath)))
+	next = NewServiceDeployer()
+	next.ProjectPath, next.ManifestPath = deployer.ProjectPath, deployer.ManifestPath
+	_, err = next.ResolveDependencies()
+	assert.IsType(t, &wskderrors.DependencyError{}, err)
+	assert.Contains(t, err.Error(), "^1.3.0 ~1.2.0")
+}
+
+func TestServiceDeployer_ConstructDeploymentPlan_ReadOnly(t *testing.T) {
+	restore := withFakeGitHub(map[string]string{"owner/repo": "packages:\n  repo:\n"})
+	defer restore()
+	manifest := `packages:
+  root:
+    dependencies:
+      repo:
+        location: http://` + LOCK_TEST_HOST + `/owner/repo
+`
+	deployer, cleanup := newResolverProject(t, map[string]string{".": manifest})
+	defer cleanup()
+	lockPath := dependencies.LockfilePath(deployer.ProjectPath)
+	newDeployer := func() *ServiceDeployer {
+		d := NewServiceDeployer()
+		d.ProjectPath, d.ManifestPath = deployer.ProjectPath, deployer.ManifestPath
+		d.ClientConfig = &whisk.Config{Namespace: "test", AuthToken: "user:pass", Host: "host"}
+		d.Preview = false
+		return d
+	}
+
+	for _, readOnly := range []func(*ServiceDeployer){
+		func(d *ServiceDeployer) { d.Preview = true },
+		func(d *ServiceDeployer) { d.Report = true },
+		func(d *ServiceDeployer) { d.Plan = true },
+	} {
+		d := newDeployer()
+		readOnly(d)
+		assert.NoError(t, d.ConstructDeploymentPlan())
+		assert.Nil(t, d.DependencyGraph, "Read-only commands do not download dependencies.")
+		assert.False(t, utils.FileExists(lockPath), "Read-only commands do not write the lockfile.")
+	}
+
+	d := newDeployer()
+	assert.NoError(t, d.ConstructDeploymentPlan())
+	assert.NotNil(t, d.DependencyGraph)
+	assert.False(t, utils.FileExists(lockPath), "The lockfile is only written on deployment.")
+	assert.NoError(t, d.WriteLockfile())
+	lock, err := dependencies.ReadLockfile(deployer.ProjectPath)
+	assert.NoError(t, err)
+	assert.Equal(t, LOCK_TEST_COMMIT, lock.Dependencies["repo"].Commit)
+}
+
+func TestServiceDeployer_ResolveDependencies_Cycle(t *testing.T) {
+	deployer, cleanup := newResolverProject(t, map[string]string{
+		".": `packages:
+  root:
+    dependencies:
+      a:
+        location: ./a
+`,
+		"a": `packages:
+  a:
+    dependencies:
+      b:
+        location: ../b
+`,
+		"b": `packages:
+  b:
+    dependencies:
+      a:
+        location: ../a
+`,
+	})
+	defer cleanup()
+
+	_, err := deployer.ResolveDependencies()
+	assert.IsType(t, &wskderrors.DependencyError{}, err)
+	assert.Contains(t, err.Error(), "a -> b -> a")
+	assert.Nil(t, deployer.DependencyGraph)
+}
+
+func TestServiceDeployer_ResolveDependencies_Conflict(t *testing.T) {
+	// a requests another utils than the project
+	deployer, cleanup := newResolverProject(t, map[string]string{
+		".": `packages:
+  root:
+    dependencies:
+      a:
+        location: ./a
+      utils:
+        location: ./utils
+`,
+		"a": `packages:
+  a:
+    dependencies:
+      utils:
+        location: ../a/utils
+`,
+		"a/utils": `packages:
+  utils:
+`,
+		"utils": `packages:
+  utils:
+`,
+	})
+	defer cleanup()
+
+	_, err := deployer.ResolveDependencies()
+	assert.IsType(t, &wskderrors.DependencyError{}, err)
+	assert.Contains(t, err.Error(), "[../a/utils] in ["+filepath.Join(deployer.ProjectPath, "a", utils.ManifestFileNameYaml)+"]")
+	assert.Contains(t, err.Error(), "[./utils] in ["+deployer.ManifestPath+"]")
+}
+
+func TestDependencyGraph_MarkDeployed_Nil(t *testing.T) {
+	var graph *DependencyGraph
+	assert.True(t, graph.markDeployed("a"), "dependencies are deployed when they were not resolved")
+}
@@ -0,0 +1,342 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/apache/openwhisk-wskdeploy/parsers"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+	"github.com/apache/openwhisk-wskdeploy/wskprint"
+)
+
+const (
+	DEFAULT_PARALLELISM = 1
+	TASK_DEPENDENCIES   = "dependencies"
+	TASK_MANAGED        = "managed" // deletion of the entities removed from a managed project
+)
+
+// deployTask is a single unit of work of a deployment, i.e. creating one entity
+type deployTask struct {
+	id    string
+	index int
+	deps  []string
+	run   func() error
+}
+
+// deployGraph holds the deployment tasks and the dependencies between them.
+// Tasks are executed as soon as all of their dependencies have been deployed,
+// independent tasks are executed concurrently by a bounded pool of workers.
+type deployGraph struct {
+	tasks        []*deployTask
+	index        map[string]*deployTask
+	started      map[string]bool
+	manifestPath string
+}
+
+type deployResult struct {
+	task *deployTask
+	err  error
+}
+
+func newDeployGraph() *deployGraph {
+	return &deployGraph{index: make(map[string]*deployTask), started: make(map[string]bool)}
+}
+
+// add registers a task; the order in which tasks are added is the order in which
+// ready tasks are started and in which failures are reported.
+// Dependencies on tasks which are not part of the graph are ignored.
+func (g *deployGraph) add(id string, run func() error, deps ...string) {
+	task := &deployTask{id: id, index: len(g.tasks), deps: deps, run: run}
+	g.tasks = append(g.tasks, task)
+	g.index[id] = task
+}
+
+// run executes the graph with at most parallelism tasks at a time. Once a task fails
+// the tasks depending on it are skipped while independent tasks still run, which makes
+// the set of failures independent of scheduling. The failure of the first task
+// (in the order tasks were added) is returned, any further failure is printed.
+func (g *deployGraph) run(parallelism int) error {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	pending := make(map[*deployTask]int)
+	dependents := make(map[*deployTask][]*deployTask)
+	for _, task := range g.tasks {
+		seen := make(map[*deployTask]bool)
+		for _, dep := range task.deps {
+			if d, ok := g.index[dep]; ok && d != task && !seen[d] {
+				seen[d] = true
+				pending[task]++
+				dependents[d] = append(dependents[d], task)
+			}
+		}
+	}
+
+	var ready []*deployTask
+	for _, task := range g.tasks {
+		if pending[task] == 0 {
+			ready = append(ready, task)
+		}
+	}
+
+	errs := make([]error, len(g.tasks))
+	results := make(chan deployResult)
+	finished := 0
+	running := 0
+
+	// mark the task and every task depending on it, directly or not, as finished without running them
+	var skip func(task *deployTask)
+	skip = func(task *deployTask) {
+		for _, d := range dependents[task] {
+			if pending[d] > 0 {
+				pending[d] = -1
+				finished++
+				skip(d)
+			}
+		}
+	}
+
+	for finished < len(g.tasks) {
+		for running < parallelism && len(ready) > 0 {
+			task := ready[0]
+			ready = ready[1:]
+			running++
+			g.started[task.id] = true
+			go func(t *deployTask) {
+				results <- deployResult{task: t, err: t.run()}
+			}(task)
+		}
+
+		if running == 0 {
+			// tasks left which can never become ready are part of a dependency cycle
+			var cycle []string
+			for _, task := range g.tasks {
+				if pending[task] > 0 {
+					cycle = append(cycle, task.id)
+				}
+			}
+			errMessage := wski18n.T(wski18n.ID_ERR_DEPLOYMENT_CYCLE_X_entities_X,
+				map[string]interface{}{wski18n.KEY_ENTITIES: strings.Join(cycle, ", ")})
+			return wskderrors.NewYAMLFileFormatError(g.manifestPath, errMessage)
+		}
+
+		result := <-results
+		running--
+		finished++
+		if result.err != nil {
+			errs[result.task.index] = result.err
+			skip(result.task)
+			continue
+		}
+		for _, d := range dependents[result.task] {
+			if pending[d] > 0 {
+				pending[d]--
+				if pending[d] == 0 {
+					ready = insertByIndex(ready, d)
+				}
+			}
+		}
+	}
+
+	var first error
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		if first == nil {
+			first = err
+		} else {
+			wskprint.PrintOpenWhiskFromError(err)
+		}
+	}
+	return first
+}
+
+// insertByIndex keeps the ready queue sorted by the order in which tasks were added
+func insertByIndex(ready []*deployTask, task *deployTask) []*deployTask {
+	i := sort.Search(len(ready), func(i int) bool { return ready[i].index > task.index })
+	ready = append(ready, nil)
+	copy(ready[i+1:], ready[i:])
+	ready[i] = task
+	return ready
+}
+
+// feedActionTasks returns the tasks of the actions handling the operations of the feed
+func feedActionTasks(feed *utils.FeedRecord) map[string]bool {
+	tasks := make(map[string]bool)
+	for _, action := range feed.Operations {
+		tasks[actionTaskID(action)] = true
+	}
+	return tasks
+}
+
+func taskID(kind string, name string) string {
+	return kind + ":" + name
+}
+
+// actionTaskID returns the task id of an action or sequence given its (possibly fully qualified)
+// name, actions and sequences share the same name space and so do their tasks
+func actionTaskID(name string) string {
+	name = shortName(name)
+	if strings.HasPrefix(name, parsers.DEFAULT_PACKAGE+parsers.PATH_SEPARATOR) {
+		name = strings.TrimPrefix(name, parsers.DEFAULT_PACKAGE+parsers.PATH_SEPARATOR)
+	}
+	return taskID(parsers.YAML_KEY_ACTION, name)
+}
+
+// buildDeployGraph translates the deployment plan into a graph of tasks:
+//
+//	package -> action -> sequence -> rule | api
+//	action -> trigger of a feed of the manifest
+//	trigger -> rule
+//
+// Dependencies are deployed once all packages exist and before any action.
+func (deployer *ServiceDeployer) buildDeployGraph() *deployGraph {
+	g := newDeployGraph()
+	g.manifestPath = deployer.ManifestPath
+	var packageTasks []string
+
+	pkgNames := sortedPackageNames(deployer.Deployment.Packages)
+	for _, pkgName := range pkgNames {
+		pack := deployer.Deployment.Packages[pkgName]
+		// "default" package is a reserved package name, its actions are deployed directly under /<namespace>
+		if strings.ToLower(pack.Package.Name) != parsers.DEFAULT_PACKAGE && !deployer.isContainer(pack.Package.Name) {
+			pkg := pack.Package
+			id := taskID(parsers.YAML_KEY_PACKAGE, pkg.Name)
+			g.add(id, deployer.tracked(id, parsers.YAML_KEY_PACKAGE, pkg.Name, OPERATION_DEPLOY,
+				func() error { return deployer.createPackage(pkg) }))
+			packageTasks = append(packageTasks, id)
+		}
+	}
+
+	deployDependencies := deployer.DeployDependencies
+	if names := deployer.dependencyNames(); len(names) != 0 {
+		deployDependencies = deployer.tracked(TASK_DEPENDENCIES, TASK_DEPENDENCIES, strings.Join(names, ", "),
+			OPERATION_DEPLOY, deployDependencies)
+	}
+	g.add(TASK_DEPENDENCIES, deployDependencies, packageTasks...)
+
+	for _, kind := range []string{parsers.YAML_KEY_ACTION, parsers.YAML_KEY_SEQUENCE} {
+		for _, pkgName := range pkgNames {
+			pack := deployer.Deployment.Packages[pkgName]
+			records := pack.Actions
+			if kind == parsers.YAML_KEY_SEQUENCE {
+				records = pack.Sequences
+			}
+			for _, name := range sortedActionNames(records) {
+				action := records[name].Action
+				pkg := pack.Package.Name
+				actionName := action.Name
+				if strings.ToLower(pkg) != parsers.DEFAULT_PACKAGE {
+					actionName = strings.Join([]string{pkg, action.Name}, parsers.PATH_SEPARATOR)
+				}
+				deps := []string{taskID(parsers.YAML_KEY_PACKAGE, pkg), TASK_DEPENDENCIES}
+				if action.Exec != nil {
+					for _, component := range action.Exec.Components {
+						deps = append(deps, actionTaskID(component))
+					}
+				}
+				id := actionTaskID(actionName)
+				g.add(id, deployer.tracked(id, kind, actionName, OPERATION_DEPLOY,
+					func() error { return deployer.createAction(pkg, action) }), deps...)
+			}
+		}
+	}
+
+	for _, name := range sortedTriggerNames(deployer.Deployment.Triggers) {
+		trigger := deployer.Deployment.Triggers[name]
+		// the trigger of a feed of the manifest is created once the actions of the feed exist
+		var deps []string
+		if feedname, isFeed := utils.IsFeedAction(trigger); isFeed {
+			if feed, ok := deployer.Deployment.Feeds[feedname]; ok {
+				deps = utils.SortedKeys(feedActionTasks(feed))
+			}
+		}
+		id := taskID(parsers.YAML_KEY_TRIGGER, trigger.Name)
+		g.add(id, deployer.tracked(id, parsers.YAML_KEY_TRIGGER, trigger.Name, OPERATION_DEPLOY, func() error {
+			if feedname, isFeed := utils.IsFeedAction(trigger); isFeed {
+				return deployer.createFeedAction(trigger, feedname)
+			}
+			return deployer.createTrigger(trigger)
+		}), deps...)
+	}
+
+	for _, name := range sortedRuleNames(deployer.Deployment.Rules) {
+		rule := deployer.Deployment.Rules[name]
+		var deps []string
+		if trigger, ok := rule.Trigger.(string); ok {
+			deps = append(deps, taskID(parsers.YAML_KEY_TRIGGER, shortName(trigger)))
+		}
+		if action, ok := rule.Action.(string); ok {
+			deps = append(deps, actionTaskID(action))
+		}
+		id := taskID(parsers.YAML_KEY_RULE, rule.Name)
+		g.add(id, deployer.tracked(id, parsers.YAML_KEY_RULE, rule.Name, OPERATION_DEPLOY,
+			func() error { return deployer.createRule(rule) }), deps...)
+	}
+
+	// NOTE: Only deploy either swagger or manifest defined api, but not both
+	// NOTE: Swagger API takes precedence
+	if deployer.Deployment.SwaggerApi != nil && deployer.Deployment.SwaggerApiOptions != nil {
+		var deps []string
+		for _, task := range g.tasks {
+			if strings.HasPrefix(task.id, parsers.YAML_KEY_ACTION+":") {
+				deps = append(deps, task.id)
+			}
+		}
+		id := taskID(parsers.YAML_KEY_API, parsers.YAML_KEY_API)
+		g.add(id, deployer.tracked(id, parsers.YAML_KEY_API, parsers.YAML_KEY_API, OPERATION_DEPLOY, func() error {
+			return deployer.createSwaggerApi(deployer.Deployment.SwaggerApi)
+		}), deps...)
+	} else {
+		apiPaths := make([]string, 0, len(deployer.Deployment.Apis))
+		for apiPath := range deployer.Deployment.Apis {
+			apiPaths = append(apiPaths, apiPath)
+		}
+		sort.Strings(apiPaths)
+		for _, apiPath := range apiPaths {
+			api := deployer.Deployment.Apis[apiPath]
+			var deps []string
+			if api.ApiDoc != nil && api.ApiDoc.Action != nil {
+				deps = append(deps, actionTaskID(api.ApiDoc.Action.Name))
+			}
+			id := taskID(parsers.YAML_KEY_API, apiPath)
+			g.add(id, deployer.tracked(id, parsers.YAML_KEY_API, apiPath, OPERATION_DEPLOY,
+				func() error { return deployer.createApi(api) }), deps...)
+		}
+	}
+
+	return g
+}
+
+// dependencyNames returns the sorted names of the dependencies of all packages
+func (deployer *ServiceDeployer) dependencyNames() []string {
+	var names []string
+	for _, pack := range deployer.Deployment.Packages {
+		for name := range pack.Dependencies {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
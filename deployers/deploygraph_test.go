@@ -0,0 +1,163 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder keeps track of the order in which tasks completed
+type recorder struct {
+	mu    sync.Mutex
+	order []string
+}
+
+func (r *recorder) task(id string, err error) func() error {
+	return func() error {
+		time.Sleep(time.Millisecond)
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.order = append(r.order, id)
+		return err
+	}
+}
+
+func (r *recorder) position(id string) int {
+	for i, o := range r.order {
+		if o == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestDeployGraph_Sequential(t *testing.T) {
+	r := new(recorder)
+	g := newDeployGraph()
+	g.add("rule:r", r.task("rule:r", nil), "trigger:t", "action:p/a")
+	g.add("package:p", r.task("package:p", nil))
+	g.add("action:p/a", r.task("action:p/a", nil), "package:p")
+	g.add("trigger:t", r.task("trigger:t", nil))
+
+	assert.Nil(t, g.run(1))
+	assert.Equal(t, []string{"package:p", "action:p/a", "trigger:t", "rule:r"}, r.order)
+}
+
+func TestDeployGraph_ParallelRespectsDependencies(t *testing.T) {
+	for _, parallelism := range []int{2, 8} {
+		r := new(recorder)
+		g := newDeployGraph()
+		g.add("package:p", r.task("package:p", nil))
+		for _, a := range []string{"a", "b", "c", "d", "e"} {
+			g.add("action:p/"+a, r.task("action:p/"+a, nil), "package:p")
+		}
+		g.add("action:p/seq", r.task("action:p/seq", nil), "package:p", "action:p/a", "action:p/e")
+		g.add("rule:r", r.task("rule:r", nil), "action:p/seq", "trigger:unknown")
+
+		assert.Nil(t, g.run(parallelism))
+		assert.Equal(t, 8, len(r.order))
+		assert.Equal(t, 0, r.position("package:p"))
+		assert.True(t, r.position("action:p/seq") > r.position("action:p/a"))
+		assert.True(t, r.position("action:p/seq") > r.position("action:p/e"))
+		assert.Equal(t, 7, r.position("rule:r"))
+	}
+}
+
+func TestDeployGraph_BoundedWorkers(t *testing.T) {
+	var running, max int32
+	g := newDeployGraph()
+	for _, id := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		g.add(id, func() error {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&max)
+				if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return nil
+		})
+	}
+	assert.Nil(t, g.run(3))
+	assert.Equal(t, int32(3), max)
+}
+
+func TestDeployGraph_FailureIsDeterministic(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		r := new(recorder)
+		g := newDeployGraph()
+		g.add("package:p", r.task("package:p", nil))
+		g.add("action:p/a", r.task("action:p/a", errors.New("a failed")), "package:p")
+		g.add("action:p/b", r.task("action:p/b", errors.New("b failed")), "package:p")
+		g.add("action:p/seq", r.task("action:p/seq", nil), "action:p/b")
+		g.add("trigger:t", r.task("trigger:t", nil))
+
+		err := g.run(4)
+		assert.NotNil(t, err)
+		assert.Equal(t, "a failed", err.Error())
+		// dependents of a failed entity are skipped, independent entities are still deployed
+		assert.Equal(t, -1, r.position("action:p/seq"))
+		assert.NotEqual(t, -1, r.position("trigger:t"))
+	}
+}
+
+func TestDeployGraph_Cycle(t *testing.T) {
+	r := new(recorder)
+	g := newDeployGraph()
+	g.add("action:p/s1", r.task("action:p/s1", nil), "action:p/s2")
+	g.add("action:p/s2", r.task("action:p/s2", nil), "action:p/s1")
+	g.add("trigger:t", r.task("trigger:t", nil))
+
+	err := g.run(2)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "action:p/s1, action:p/s2")
+	assert.Equal(t, []string{"trigger:t"}, r.order)
+}
+
+func TestDeployGraph_BuildFromDeployment(t *testing.T) {
+	deployer := NewServiceDeployer()
+	pkg := NewDeploymentPackage()
+	pkg.Package = &whisk.Package{Name: "p"}
+	pkg.Actions["a"] = utils.ActionRecord{Action: &whisk.Action{Name: "a", Exec: &whisk.Exec{Kind: "nodejs:10"}}}
+	pkg.Sequences["s"] = utils.ActionRecord{Action: &whisk.Action{Name: "s", Exec: &whisk.Exec{Kind: "sequence", Components: []string{"/_/p/a"}}}}
+	deployer.Deployment.Packages["p"] = pkg
+	deployer.Deployment.Triggers["t"] = &whisk.Trigger{Name: "t"}
+	deployer.Deployment.Rules["r"] = &whisk.Rule{Name: "r", Trigger: "t", Action: "p/s"}
+
+	g := deployer.buildDeployGraph()
+	ids := make([]string, 0)
+	for _, task := range g.tasks {
+		ids = append(ids, task.id)
+	}
+	assert.Equal(t, []string{"package:p", TASK_DEPENDENCIES, "action:p/a", "action:p/s", "trigger:t", "rule:r"}, ids)
+	assert.Contains(t, g.index["action:p/s"].deps, "action:p/a")
+	assert.Contains(t, g.index["rule:r"].deps, "trigger:t")
+	assert.Contains(t, g.index["rule:r"].deps, "action:p/s")
+}
@@ -18,6 +18,8 @@
 package deployers
 
 import (
+	"path"
+
 	"github.com/apache/openwhisk-client-go/whisk"
 	"github.com/apache/openwhisk-wskdeploy/parsers"
 	"github.com/apache/openwhisk-wskdeploy/utils"
@@ -29,6 +31,7 @@ import (
 type DeploymentReader struct {
 	serviceDeployer      *ServiceDeployer
 	DeploymentDescriptor *parsers.YAML
+	Sources              parsers.DeploymentSources // deployment file of each input and annotation
 }
 
 func NewDeploymentReader(serviceDeployer *ServiceDeployer) *DeploymentReader {
@@ -43,13 +46,29 @@ func (reader *DeploymentReader) HandleYaml() error {
 
 	dep := reader.serviceDeployer
 
+	// the deployment overlays are merged over the deployment file in order
 	deploymentParser := parsers.NewYAMLParser()
-	deployment, err := deploymentParser.ParseDeployment(dep.DeploymentPath)
+	paths := append([]string{dep.DeploymentPath}, dep.DeploymentOverlays...)
+	deployment, sources, err := deploymentParser.ParseDeployments(paths)
 	reader.DeploymentDescriptor = deployment
+	reader.Sources = sources
 
 	return err
 }
 
+// Update project inputs with the inputs of the deployment file, project inputs have to be
+// bound before the packages of the manifest file are composed as packages inherit them
+func (reader *DeploymentReader) BindProjectInputs() {
+	dep := reader.serviceDeployer
+	for name, input := range reader.DeploymentDescriptor.GetProject().Inputs {
+		param := dep.ProjectInputs[name]
+		param.Value = wskenv.InterpolateStringWithEnvVar(input.Value)
+		dep.ProjectInputs[name] = param
+		dep.setInputSource(parsers.YAML_KEY_PROJECT, "", name,
+			reader.Sources[parsers.SourceKey(parsers.YAML_KEY_PROJECT, parsers.YAML_KEY_INPUTS, name)])
+	}
+}
+
 // Update entities with deployment settings
 func (reader *DeploymentReader) BindAssets() error {
 
@@ -74,6 +93,9 @@ func (reader *DeploymentReader) BindAssets() error {
 	if err := reader.bindTriggerInputsAndAnnotations(paramsCLI); err != nil {
 		return err
 	}
+	if err := reader.bindRuleStatus(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -119,7 +141,7 @@ func (reader *DeploymentReader) getListOfParameters(inputs map[string]parsers.Pa
 	for name, input := range inputs {
 		var keyVal whisk.KeyValue
 		keyVal.Key = name
-		keyVal.Value = wskenv.InterpolateStringWithEnvVar(input.Value)
+		keyVal.Value = utils.ConvertInterfaceValue(wskenv.InterpolateStringWithEnvVar(input.Value))
 		keyValArr = append(keyValArr, keyVal)
 	}
 	return keyValArr
@@ -130,7 +152,7 @@ func (reader *DeploymentReader) getListOfAnnotations(inputs map[string]interface
 	for name, input := range inputs {
 		var keyVal whisk.KeyValue
 		keyVal.Key = name
-		keyVal.Value = wskenv.InterpolateStringWithEnvVar(input)
+		keyVal.Value = utils.ConvertInterfaceValue(wskenv.InterpolateStringWithEnvVar(input))
 		keyValArr = append(keyValArr, keyVal)
 	}
 	return keyValArr
@@ -167,6 +189,11 @@ func (reader *DeploymentReader) bindPackageInputsAndAnnotations(paramsCLI interf
 				}
 			}
 
+			for name := range pack.Inputs {
+				reader.serviceDeployer.setInputSource(parsers.YAML_KEY_PACKAGE, packName, name,
+					reader.Sources[parsers.SourceKey(parsers.YAML_KEY_PACKAGE, packName, parsers.YAML_KEY_INPUTS, name)])
+			}
+
 			packageInputs := make(whisk.KeyValueArr, 0)
 
 			if paramsCLI != nil {
@@ -174,6 +201,7 @@ func (reader *DeploymentReader) bindPackageInputsAndAnnotations(paramsCLI interf
 					// check if this particular input is specified on CLI
 					if v, ok := paramsCLI.(map[string]interface{})[kv.Key]; ok {
 						kv.Value = wskenv.ConvertSingleName(v.(string))
+						reader.serviceDeployer.setInputSource(parsers.YAML_KEY_PACKAGE, packName, kv.Key, wski18n.COMMAND_LINE)
 					}
 					packageInputs = append(packageInputs, kv)
 				}
@@ -195,6 +223,11 @@ func (reader *DeploymentReader) bindPackageInputsAndAnnotations(paramsCLI interf
 				}
 			}
 
+			for name := range pack.Annotations {
+				reader.serviceDeployer.setAnnotationSource(parsers.YAML_KEY_PACKAGE, packName, name,
+					reader.Sources[parsers.SourceKey(parsers.YAML_KEY_PACKAGE, packName, parsers.YAML_KEY_ANNOTATIONS, name)])
+			}
+
 			serviceDeployPack.Package.Annotations = keyValArr
 		}
 	}
@@ -237,6 +270,12 @@ func (reader *DeploymentReader) bindActionInputsAndAnnotations(paramsCLI interfa
 						}
 					}
 
+					entityName := path.Join(packName, actionName)
+					for name := range action.Inputs {
+						reader.serviceDeployer.setInputSource(parsers.YAML_KEY_ACTION, entityName, name,
+							reader.Sources[parsers.SourceKey(parsers.YAML_KEY_PACKAGE, packName, parsers.YAML_KEY_ACTION, actionName, parsers.YAML_KEY_INPUTS, name)])
+					}
+
 					actionInputs := make(whisk.KeyValueArr, 0)
 
 					if paramsCLI != nil {
@@ -244,6 +283,7 @@ func (reader *DeploymentReader) bindActionInputsAndAnnotations(paramsCLI interfa
 							// check if this particular input is specified on CLI
 							if v, ok := paramsCLI.(map[string]interface{})[kv.Key]; ok {
 								kv.Value = wskenv.ConvertSingleName(v.(string))
+								reader.serviceDeployer.setInputSource(parsers.YAML_KEY_ACTION, entityName, kv.Key, wski18n.COMMAND_LINE)
 							}
 							actionInputs = append(actionInputs, kv)
 						}
@@ -270,6 +310,10 @@ func (reader *DeploymentReader) bindActionInputsAndAnnotations(paramsCLI interfa
 							keyValArr = append(keyValArr, keyVal)
 						}
 					}
+					for name := range action.Annotations {
+						reader.serviceDeployer.setAnnotationSource(parsers.YAML_KEY_ACTION, path.Join(packName, actionName), name,
+							reader.Sources[parsers.SourceKey(parsers.YAML_KEY_PACKAGE, packName, parsers.YAML_KEY_ACTION, actionName, parsers.YAML_KEY_ANNOTATIONS, name)])
+					}
 					wskAction.Action.Annotations = keyValArr
 				} else {
 					displayEntityNotFoundInDeploymentWarning(parsers.YAML_KEY_ACTION, actionName)
@@ -315,6 +359,11 @@ func (reader *DeploymentReader) bindTriggerInputsAndAnnotations(paramsCLI interf
 						}
 					}
 
+					for name := range trigger.Inputs {
+						reader.serviceDeployer.setInputSource(parsers.YAML_KEY_TRIGGER, triggerName, name,
+							reader.Sources[parsers.SourceKey(parsers.YAML_KEY_PACKAGE, pack.Packagename, parsers.YAML_KEY_TRIGGER, triggerName, parsers.YAML_KEY_INPUTS, name)])
+					}
+
 					triggerInputs := make(whisk.KeyValueArr, 0)
 
 					if paramsCLI != nil {
@@ -322,6 +371,7 @@ func (reader *DeploymentReader) bindTriggerInputsAndAnnotations(paramsCLI interf
 							// check if this particular input is specified on CLI
 							if v, ok := paramsCLI.(map[string]interface{})[kv.Key]; ok {
 								kv.Value = wskenv.ConvertSingleName(v.(string))
+								reader.serviceDeployer.setInputSource(parsers.YAML_KEY_TRIGGER, triggerName, kv.Key, wski18n.COMMAND_LINE)
 							}
 							triggerInputs = append(triggerInputs, kv)
 						}
@@ -348,6 +398,10 @@ func (reader *DeploymentReader) bindTriggerInputsAndAnnotations(paramsCLI interf
 							keyValArr = append(keyValArr, keyVal)
 						}
 					}
+					for name := range trigger.Annotations {
+						reader.serviceDeployer.setAnnotationSource(parsers.YAML_KEY_TRIGGER, triggerName, name,
+							reader.Sources[parsers.SourceKey(parsers.YAML_KEY_PACKAGE, pack.Packagename, parsers.YAML_KEY_TRIGGER, triggerName, parsers.YAML_KEY_ANNOTATIONS, name)])
+					}
 					wskTrigger.Annotations = keyValArr
 				} else {
 					displayEntityNotFoundInDeploymentWarning(parsers.YAML_KEY_TRIGGER, triggerName)
@@ -360,6 +414,29 @@ func (reader *DeploymentReader) bindTriggerInputsAndAnnotations(paramsCLI interf
 	return nil
 }
 
+// bindRuleStatus overrides the status of the rules of the manifest with the deployment file
+func (reader *DeploymentReader) bindRuleStatus() error {
+	rules := reader.serviceDeployer.Deployment.Rules
+	for _, pack := range reader.getPackageMap() {
+		for ruleName, rule := range pack.Rules {
+			if len(rule.Status) == 0 {
+				continue
+			}
+			status, err := parsers.ComposeStatus(reader.DeploymentDescriptor.Filepath, parsers.YAML_KEY_RULE, ruleName, rule.Status)
+			if err != nil {
+				return err
+			}
+			if wskRule, exists := rules[ruleName]; exists {
+				displayEntityFoundInDeploymentTrace(parsers.YAML_KEY_RULE, ruleName)
+				wskRule.Status = status
+			} else {
+				displayEntityNotFoundInDeploymentWarning(parsers.YAML_KEY_RULE, ruleName)
+			}
+		}
+	}
+	return nil
+}
+
 func displayEntityNotFoundInDeploymentWarning(entityType string, entityName string) {
 	warnMsg := wski18n.T(
 		wski18n.ID_WARN_DEPLOYMENT_NAME_NOT_FOUND_X_key_X_name_X,
@@ -20,8 +20,10 @@
 package deployers
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/parsers"
 	"github.com/apache/openwhisk-wskdeploy/utils"
 	"github.com/apache/openwhisk-wskdeploy/wskprint"
 	"github.com/stretchr/testify/assert"
@@ -267,3 +269,76 @@ func TestDeploymentReader_BindAssets_ActionAnnotations(t *testing.T) {
 	eq = reflect.DeepEqual(actual_annotations, expected_annotations)
 	assert.True(t, eq, "Expected list of annotations does not match with actual list, expected annotations: %v actual annotations: %v", expected_annotations, actual_annotations)
 }
+
+func TestDeploymentReader_OverlayBindSources(t *testing.T) {
+	base := "../tests/dat/deployment_overlay_base.yaml"
+	prod := "../tests/dat/deployment_overlay_prod.yaml"
+
+	sDeployer := NewServiceDeployer()
+	sDeployer.ManifestPath = "manifest.yaml"
+	sDeployer.DeploymentPath = base
+	sDeployer.DeploymentOverlays = []string{prod}
+	sDeployer.ProjectInputs = map[string]parsers.Parameter{"region": {}, "tier": {}}
+	sDeployer.Deployment.Triggers["everyMinute"] = new(whisk.Trigger)
+
+	dReader := NewDeploymentReader(sDeployer)
+	if err := dReader.HandleYaml(); err != nil {
+		assert.Fail(t, fmt.Sprintf(TEST_ERROR_DEPLOYMENT_PARSE_FAILURE, prod))
+	}
+	dReader.BindProjectInputs()
+	var inputs interface{}
+	assert.Nil(t, dReader.bindTriggerInputsAndAnnotations(inputs))
+	pkg := &DeploymentPackage{Package: &whisk.Package{Name: "hello"}, Actions: map[string]utils.ActionRecord{
+		"greet": {Action: &whisk.Action{Name: "greet", Annotations: whisk.KeyValueArr{{Key: "exec", Value: "nodejs"}}}},
+	}}
+	sDeployer.Deployment.Packages["hello"] = pkg
+	// binding stops at the first package of the deployment files missing from the manifest
+	audit := NewDeploymentPackage()
+	audit.Package = &whisk.Package{Name: "audit"}
+	sDeployer.Deployment.Packages["audit"] = audit
+	assert.Nil(t, dReader.bindActionInputsAndAnnotations(inputs))
+
+	assert.Equal(t, "gold", sDeployer.ProjectInputs["tier"].Value)
+	assert.Equal(t, "0 * * * *", sDeployer.Deployment.Triggers["everyMinute"].Parameters.GetValue("cron"))
+
+	sources := sDeployer.sourcesOf(parsers.YAML_KEY_PROJECT, "", map[string]interface{}{"region": nil, "tier": nil})
+	assert.Equal(t, base, sources["region"])
+	assert.Equal(t, prod, sources["tier"])
+	sources = sDeployer.sourcesOf(parsers.YAML_KEY_TRIGGER, "everyMinute", map[string]interface{}{"cron": nil, "other": nil})
+	assert.Equal(t, prod, sources["cron"])
+	assert.Equal(t, "manifest.yaml", sources["other"])
+
+	// report shows the annotations read from the deployment files, and their file
+	var greet parsers.DisplayInputs
+	for _, display := range sDeployer.displayInputs() {
+		if display.Name == "greet" {
+			greet = display
+		}
+	}
+	assert.Equal(t, map[string]interface{}{"owner": "dev-team", "labels": map[string]interface{}{"team": "ops", "tier": "web"}}, greet.Annotations,
+		"Annotations of the manifest, e.g. exec, are not shown.")
+	assert.Equal(t, map[string]string{"owner": base, "labels": prod}, greet.AnnotationSources)
+	assert.Equal(t, prod, greet.Sources["config"])
+	assert.Equal(t, map[string]interface{}{"host": "example.com", "port": 443,
+		"tls": map[string]interface{}{"enabled": true, "version": "1.2"}}, greet.Inputs["config"])
+	_, err := json.Marshal(greet)
+	assert.Nil(t, err, "Maps of the deployment files are bound as JSON objects.")
+}
+
+func TestDeploymentReader_BindRuleStatus(t *testing.T) {
+	path := "../tests/dat/deployment_deploymentreader_rule_status.yaml"
+	sDeployer := NewServiceDeployer()
+	sDeployer.DeploymentPath = path
+	sDeployer.Deployment.Rules["everyMinuteGreeting"] = &whisk.Rule{Name: "everyMinuteGreeting", Status: parsers.YAML_VALUE_ACTIVE}
+
+	dReader := NewDeploymentReader(sDeployer)
+	if err := dReader.HandleYaml(); err != nil {
+		assert.Fail(t, fmt.Sprintf(TEST_ERROR_DEPLOYMENT_PARSE_FAILURE, path))
+	}
+	assert.Nil(t, dReader.bindRuleStatus())
+	assert.Equal(t, parsers.YAML_VALUE_INACTIVE, sDeployer.Deployment.Rules["everyMinuteGreeting"].Status)
+	assert.Nil(t, sDeployer.Deployment.Rules["unknownRule"])
+
+	dReader.DeploymentDescriptor.GetProject().Packages["hello"].Rules["everyMinuteGreeting"] = parsers.Rule{Status: "disabled"}
+	assert.NotNil(t, dReader.bindRuleStatus())
+}
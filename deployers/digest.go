@@ -0,0 +1,160 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/parsers"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+)
+
+/*
+ * Every package, action, sequence, trigger and rule deployed by wskdeploy is annotated with
+ * a digest of its content:
+ * wskdeploy-digest: sha256:<SHA256 of the entity content as deployed>
+ *
+ * On the next deployment, the digest of the entity is computed again and the entity is only
+ * sent to the server when the digest differs from the one found on the deployed entity.
+ *
+ * The project hash of the managed annotation is not part of the digest, it changes with
+ * any change to the manifest file and would otherwise change the digest of every entity.
+ * An entity left unchanged only gets its managed annotation updated.
+ *
+ * The digest annotation is kept by updates made outside of wskdeploy, e.g. "wsk action update".
+ * An entity is only left unchanged when it still carries the update time recorded by the
+ * deployment state of the last deployment, or else when its deployed content matches.
+ */
+
+const DIGEST_ANNOTATION = "wskdeploy-digest"
+
+// contentDigest returns the SHA-256 digest of a value rendered as canonical JSON
+func contentDigest(content interface{}) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(canonicalJSON(content))))
+}
+
+// digestAnnotations returns the annotations covered by the digest: all annotations but the
+// digest itself, with the project hash removed from the managed annotation
+func digestAnnotations(annotations whisk.KeyValueArr) whisk.KeyValueArr {
+	result := make(whisk.KeyValueArr, 0, len(annotations))
+	for _, kv := range annotations {
+		if kv.Key == DIGEST_ANNOTATION {
+			continue
+		}
+		if kv.Key == utils.MANAGED {
+			if ma, ok := kv.Value.(map[string]interface{}); ok {
+				managed := make(map[string]interface{}, len(ma))
+				for k, v := range ma {
+					if k != utils.OW_PROJECT_HASH {
+						managed[k] = v
+					}
+				}
+				kv = whisk.KeyValue{Key: kv.Key, Value: managed}
+			}
+		}
+		result = append(result, kv)
+	}
+	return result
+}
+
+func actionDigest(action *whisk.Action) string {
+	exec := map[string]interface{}{}
+	if action.Exec != nil {
+		exec["kind"] = action.Exec.Kind
+		exec["code"] = codeHash(action.Exec.Code)
+		exec["main"] = action.Exec.Main
+		exec["image"] = action.Exec.Image
+		exec["components"] = action.Exec.Components
+		exec["binary"] = action.Exec.Binary
+	}
+	return contentDigest(map[string]interface{}{
+		"exec":        exec,
+		"limits":      action.Limits,
+		"parameters":  action.Parameters,
+		"annotations": digestAnnotations(action.Annotations),
+		"publish":     action.Publish,
+	})
+}
+
+func packageDigest(pkg *whisk.Package) string {
+	return contentDigest(map[string]interface{}{
+		"binding":     pkg.Binding,
+		"parameters":  pkg.Parameters,
+		"annotations": digestAnnotations(pkg.Annotations),
+		"publish":     pkg.Publish,
+	})
+}
+
+func triggerDigest(trigger *whisk.Trigger) string {
+	return contentDigest(map[string]interface{}{
+		"parameters":  trigger.Parameters,
+		"annotations": digestAnnotations(trigger.Annotations),
+		"publish":     trigger.Publish,
+	})
+}
+
+func ruleDigest(rule *whisk.Rule) string {
+	return contentDigest(map[string]interface{}{
+		"trigger":     rule.Trigger,
+		"action":      rule.Action,
+		"annotations": digestAnnotations(rule.Annotations),
+		"publish":     rule.Publish,
+	})
+}
+
+// withDigest returns a copy of the annotations holding the given digest, the annotations
+// of the deployment plan may be shared between entities and are not modified in place
+func withDigest(annotations whisk.KeyValueArr, digest string) whisk.KeyValueArr {
+	result := make(whisk.KeyValueArr, 0, len(annotations)+1)
+	for _, kv := range annotations {
+		if kv.Key != DIGEST_ANNOTATION {
+			result = append(result, kv)
+		}
+	}
+	return append(result, whisk.KeyValue{Key: DIGEST_ANNOTATION, Value: digest})
+}
+
+// hasDigest reports whether deployed annotations carry the given digest
+func hasDigest(annotations whisk.KeyValueArr, digest string) bool {
+	deployed, ok := annotations.GetValue(DIGEST_ANNOTATION).(string)
+	return ok && deployed == digest
+}
+
+// hasStaleProjectHash reports whether deployed annotations carry the managed annotation of
+// an earlier deployment of the project
+func hasStaleProjectHash(deployed whisk.KeyValueArr, planned whisk.KeyValueArr) bool {
+	ma, ok := planned.GetValue(utils.MANAGED).(map[string]interface{})
+	if !ok {
+		return false
+	}
+	deployedMa, ok := deployed.GetValue(utils.MANAGED).(map[string]interface{})
+	return !ok || deployedMa[utils.OW_PROJECT_HASH] != ma[utils.OW_PROJECT_HASH]
+}
+
+// isUnchanged tells whether an entity deployed with the digest of the deployment plan was
+// left alone since: it was not updated after the last deployment, or its deployed content
+// does not differ from the deployment plan
+func (deployer *ServiceDeployer) isUnchanged(kind string, namespace string, name string, updated int64, unchanged func() bool) bool {
+	qualifiedName := parsers.PATH_SEPARATOR + namespace + parsers.PATH_SEPARATOR + name
+	if recorded, found := deployer.deployed[entityName{kind, qualifiedName}]; found && recorded == updated {
+		return true
+	}
+	return unchanged()
+}
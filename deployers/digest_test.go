@@ -0,0 +1,152 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func newDigestTestAction(code string, projectHash string) *whisk.Action {
+	return &whisk.Action{
+		Name:       "hello",
+		Exec:       &whisk.Exec{Kind: "nodejs:10", Code: &code},
+		Parameters: whisk.KeyValueArr{{Key: "name", Value: "Amy"}},
+		Annotations: whisk.KeyValueArr{{Key: utils.MANAGED, Value: map[string]interface{}{
+			utils.OW_PROJECT_NAME: "demo",
+			utils.OW_PROJECT_HASH: projectHash,
+		}}},
+	}
+}
+
+func TestDigest_IgnoresProjectHash(t *testing.T) {
+	assert.Equal(t,
+		actionDigest(newDigestTestAction("function main() {}", "1111")),
+		actionDigest(newDigestTestAction("function main() {}", "2222")))
+	assert.NotEqual(t,
+		actionDigest(newDigestTestAction("function main() {}", "1111")),
+		actionDigest(newDigestTestAction("function main() { return {}; }", "1111")))
+
+	action := newDigestTestAction("function main() {}", "1111")
+	digest := actionDigest(action)
+	action.Annotations = withDigest(action.Annotations, digest)
+	// the digest annotation itself is not part of the digest
+	assert.Equal(t, digest, actionDigest(action))
+	assert.True(t, hasDigest(action.Annotations, digest))
+	assert.Equal(t, 2, len(action.Annotations))
+}
+
+func TestDigest_WithDigestDoesNotModifyAnnotations(t *testing.T) {
+	shared := make(whisk.KeyValueArr, 1, 2)
+	shared[0] = whisk.KeyValue{Key: DIGEST_ANNOTATION, Value: "sha256:old"}
+	updated := withDigest(shared, "sha256:new")
+	assert.Equal(t, "sha256:old", shared[0].Value)
+	assert.Equal(t, "sha256:new", updated.GetValue(DIGEST_ANNOTATION))
+}
+
+func TestDigest_CreateActionSkipsUnchanged(t *testing.T) {
+	var mu sync.Mutex
+	var deployed *whisk.Action
+	updates, codeFetches := 0, 0
+	var lastUpdate *whisk.Action
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPut {
+			updates++
+			lastUpdate = new(whisk.Action)
+			json.NewDecoder(r.Body).Decode(lastUpdate)
+			update := *lastUpdate
+			// an update keeps the code and parameters it leaves out
+			if update.Exec == nil && deployed != nil {
+				update.Exec, update.Parameters = deployed.Exec, deployed.Parameters
+			}
+			update.Namespace, update.Name, update.Updated = "guest/p", "hello", int64(updates)
+			deployed = &update
+			json.NewEncoder(w).Encode(deployed)
+			return
+		}
+		if deployed == nil || !strings.HasSuffix(r.URL.Path, "/actions/p/hello") {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"The requested resource does not exist."}`))
+			return
+		}
+		if r.URL.Query().Get("code") != "false" {
+			codeFetches++
+		}
+		json.NewEncoder(w).Encode(deployed)
+	}))
+	defer server.Close()
+
+	client, err := whisk.NewClient(http.DefaultClient, &whisk.Config{Host: server.URL, AuthToken: "user:pass", Namespace: "guest"})
+	assert.Nil(t, err)
+	deployer := NewServiceDeployer()
+	deployer.Client = client
+	deployer.ClientConfig = client.Config
+
+	assert.Nil(t, deployer.createAction("p", newDigestTestAction("function main() {}", "1111")))
+	assert.Equal(t, 1, updates)
+
+	// only the manifest changed, not the action: the managed annotation is refreshed
+	// without sending the code again
+	assert.Nil(t, deployer.createAction("p", newDigestTestAction("function main() {}", "2222")))
+	assert.Equal(t, 2, updates)
+	assert.Nil(t, lastUpdate.Exec)
+	assert.Equal(t, "2222", lastUpdate.Annotations.GetValue(utils.MANAGED).(map[string]interface{})[utils.OW_PROJECT_HASH])
+	assert.Equal(t, "function main() {}", *deployed.Exec.Code)
+
+	// nothing changed at all
+	assert.Nil(t, deployer.createAction("p", newDigestTestAction("function main() {}", "2222")))
+	assert.Equal(t, 2, updates)
+
+	// the code was updated outside of wskdeploy, the digest annotation was kept
+	changed := "function main() { return {changed: true}; }"
+	deployed.Exec = &whisk.Exec{Kind: "nodejs:10", Code: &changed}
+	assert.Nil(t, deployer.createAction("p", newDigestTestAction("function main() {}", "2222")))
+	assert.Equal(t, 3, updates)
+	assert.Equal(t, "function main() {}", *deployed.Exec.Code)
+
+	// the action carries the update time recorded by the last deployment, its code is not fetched
+	deployer.deployed = map[entityName]int64{{"action", "/guest/p/hello"}: deployed.Updated}
+	fetches := codeFetches
+	assert.Nil(t, deployer.createAction("p", newDigestTestAction("function main() {}", "2222")))
+	assert.Equal(t, 3, updates)
+	assert.Equal(t, fetches, codeFetches)
+
+	assert.Nil(t, deployer.createAction("p", newDigestTestAction("function main() { return {}; }", "2222")))
+	assert.Equal(t, 4, updates)
+}
+
+func TestDigest_HasStaleProjectHash(t *testing.T) {
+	deployed := newDigestTestAction("function main() {}", "1111").Annotations
+	assert.False(t, hasStaleProjectHash(deployed, newDigestTestAction("function main() {}", "1111").Annotations))
+	assert.True(t, hasStaleProjectHash(deployed, newDigestTestAction("function main() {}", "2222").Annotations))
+	// entities deployed without --managed have no project hash to refresh
+	assert.False(t, hasStaleProjectHash(deployed, whisk.KeyValueArr{}))
+}
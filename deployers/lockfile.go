@@ -0,0 +1,118 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/apache/openwhisk-wskdeploy/dependencies"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+	"github.com/apache/openwhisk-wskdeploy/wskprint"
+)
+
+// lockfile returns the lockfile of the project, reading it on first use
+func (deployer *ServiceDeployer) lockfile() (*dependencies.Lockfile, error) {
+	if deployer.Lockfile == nil {
+		lock, err := dependencies.ReadLockfile(deployer.ProjectPath)
+		if err != nil {
+			return nil, err
+		}
+		lock.Frozen = utils.Flags.FrozenLockfile
+		lock.Verbose = utils.Flags.Verbose
+		lock.Cache = dependencyCache()
+		lock.VendorDir = filepath.Join(deployer.ProjectPath, dependencies.VENDOR_DIR)
+		deployer.Lockfile = lock
+	}
+	return deployer.Lockfile, nil
+}
+
+// WriteLockfile saves the lockfile of the project if resolving the dependencies changed it
+func (deployer *ServiceDeployer) WriteLockfile() error {
+	lock := deployer.Lockfile
+	if lock == nil || !lock.Changed() {
+		return nil
+	}
+	if err := lock.Write(); err != nil {
+		return err
+	}
+	wskprint.PrintlnOpenWhiskVerbose(utils.Flags.Verbose, wski18n.T(wski18n.ID_MSG_LOCKFILE_UPDATED_X_path_X,
+		map[string]interface{}{wski18n.KEY_PATH: lock.Path}))
+	return nil
+}
+
+// UpdateLockfile resolves the version of every remote dependency of the manifest, and of
+// the manifests of these dependencies, to a commit again and replaces the lockfile of the
+// project with the result
+func (deployer *ServiceDeployer) UpdateLockfile() error {
+	lock := dependencies.NewLockfile(dependencies.LockfilePath(deployer.ProjectPath))
+	lock.Verbose = utils.Flags.Verbose
+	lock.Cache = dependencyCache()
+
+	if _, err := resolveDependencies(lock, deployer.ProjectPath, deployer.ManifestPath); err != nil {
+		return err
+	}
+	deployer.Lockfile = lock
+	return lock.Write()
+}
+
+// VendorDependencies resolves the dependencies of the project, records them in the lockfile
+// and replaces the vendor folder of the project with the files of the remote ones
+func (deployer *ServiceDeployer) VendorDependencies() error {
+	graph, err := deployer.ResolveDependencies()
+	if err != nil {
+		return err
+	}
+	if err := deployer.WriteLockfile(); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(deployer.Lockfile.VendorDir); err != nil {
+		return err
+	}
+	for _, name := range utils.SortedKeys(graph.Nodes) {
+		if err := deployer.Lockfile.Vendor(name, graph.Nodes[name].Record); err != nil {
+			return wskderrors.NewDependencyError(name, err.Error())
+		}
+	}
+	return nil
+}
+
+// dependencyCache returns the cache of the downloaded dependencies
+func dependencyCache() *dependencies.Cache {
+	dir := utils.Flags.CacheDir
+	if len(dir) == 0 {
+		dir = dependencies.DefaultCacheDir(utils.GetHomeDirectory())
+	}
+	return dependencies.NewCache(dir)
+}
+
+// dependencyProjectPath returns the folder holding the manifest of a dependency, once fetched
+// for a remote one
+func dependencyProjectPath(depName string, depRecord dependencies.DependencyRecord) string {
+	if depRecord.Source == dependencies.SOURCE_LOCAL {
+		return depRecord.BaseRepo
+	}
+	projectPath := path.Join(depRecord.ProjectPath, dependencies.DependencyFolder(depName, depRecord.Version))
+	if len(depRecord.SubFolder) > 0 {
+		projectPath = path.Join(projectPath, depRecord.SubFolder)
+	}
+	return projectPath
+}
@@ -0,0 +1,252 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/apache/openwhisk-wskdeploy/dependencies"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	LOCK_TEST_HOST   = "github.example.com"
+	LOCK_TEST_COMMIT = "0123456789abcdef0123456789abcdef01234567"
+	LOCK_TEST_TAG    = "v1.2.0"
+)
+
+// withFakeGitHub routes every request of the default http client to a server holding
+// the given repositories, keyed by owner/repo, at a single commit tagged LOCK_TEST_TAG,
+// each of them only containing a manifest, and caches the dependencies in a new folder
+func withFakeGitHub(manifests map[string]string) func() {
+	return withFakeGitHubTags(manifests, LOCK_TEST_TAG)
+}
+
+// withFakeGitHubTags serves the repositories with the given tags, LOCK_TEST_TAG and master
+// at LOCK_TEST_COMMIT, every other tag at a commit of its own, see lockTestCommit
+func withFakeGitHubTags(manifests map[string]string, tags ...string) func() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for repo, manifest := range manifests {
+			api := dependencies.GITHUB_API_PATH + "/repos/" + repo
+			zipball := "/" + repo + "/zipball/"
+			switch {
+			case r.URL.Path == api+"/commits/master":
+				w.Write([]byte(LOCK_TEST_COMMIT))
+				return
+			case strings.HasPrefix(r.URL.Path, api+"/commits/"):
+				w.Write([]byte(lockTestCommit(strings.TrimPrefix(r.URL.Path, api+"/commits/"))))
+				return
+			case r.URL.Path == api+"/tags":
+				names := []map[string]string{}
+				for _, tag := range tags {
+					names = append(names, map[string]string{"name": tag})
+				}
+				json.NewEncoder(w).Encode(names)
+				return
+			case strings.HasPrefix(r.URL.Path, zipball):
+				commit := strings.TrimPrefix(r.URL.Path, zipball)
+				root := strings.Replace(repo, "/", "-", 1) + "-" + commit[:7] + "/"
+				archive := zip.NewWriter(w)
+				archive.Create(root)
+				file, _ := archive.Create(root + utils.ManifestFileNameYaml)
+				file.Write([]byte(manifest))
+				archive.Close()
+				return
+			}
+		}
+		http.NotFound(w, r)
+	}))
+
+	transport := http.DefaultClient.Transport
+	http.DefaultClient.Transport = &http.Transport{
+		DialContext: func(ctx context.Context, network string, addr string) (net.Conn, error) {
+			return net.Dial(network, server.Listener.Addr().String())
+		},
+	}
+	// the repositories of every test hold different files at the same commit
+	cache, _ := ioutil.TempDir("", "cache")
+	utils.Flags.CacheDir = cache
+	return func() {
+		http.DefaultClient.Transport = transport
+		server.Close()
+		utils.Flags.CacheDir = ""
+		os.RemoveAll(cache)
+	}
+}
+
+// lockTestCommit returns the commit of a tag of the fake GitHub
+func lockTestCommit(tag string) string {
+	if tag == LOCK_TEST_TAG {
+		return LOCK_TEST_COMMIT
+	}
+	return fmt.Sprintf("%x", sha1.Sum([]byte(tag)))
+}
+
+func TestServiceDeployer_UpdateLockfile(t *testing.T) {
+	// the root project depends on owner/repo which depends on owner/other
+	restore := withFakeGitHub(map[string]string{
+		"owner/repo": `packages:
+  repo:
+    dependencies:
+      other:
+        location: http://` + LOCK_TEST_HOST + `/owner/other
+`,
+		"owner/other": `packages:
+  other:
+`,
+	})
+	defer restore()
+
+	dir, err := ioutil.TempDir("", "lockfile")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	manifestPath := filepath.Join(dir, utils.ManifestFileNameYaml)
+	manifest := `packages:
+  root:
+    dependencies:
+      repo:
+        location: http://` + LOCK_TEST_HOST + `/owner/repo
+      cloudant:
+        location: /whisk.system/cloudant
+`
+	assert.NoError(t, ioutil.WriteFile(manifestPath, []byte(manifest), 0644))
+
+	deployer := NewServiceDeployer()
+	deployer.ProjectPath = dir
+	deployer.ManifestPath = manifestPath
+	assert.NoError(t, deployer.UpdateLockfile())
+
+	lock, err := dependencies.ReadLockfile(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(lock.Dependencies), "bindings are not locked")
+	for _, name := range []string{"repo", "other"} {
+		assert.Equal(t, LOCK_TEST_COMMIT, lock.Dependencies[name].Commit)
+		assert.Equal(t, "master", lock.Dependencies[name].Version)
+		assert.True(t, strings.HasPrefix(lock.Dependencies[name].Checksum, dependencies.CHECKSUM_PREFIX))
+	}
+	assert.Equal(t, "http://"+LOCK_TEST_HOST+"/owner/other", lock.Dependencies["other"].Location)
+
+	// a frozen lockfile rejects dependencies which are not locked
+	delete(lock.Dependencies, "repo")
+	assert.NoError(t, lock.Write())
+	deployer = NewServiceDeployer()
+	deployer.ProjectPath = dir
+	deployer.ManifestPath = manifestPath
+	utils.Flags.FrozenLockfile = true
+	defer func() { utils.Flags.FrozenLockfile = false }()
+	_, err = deployer.ResolveDependencies()
+	assert.IsType(t, &wskderrors.DependencyError{}, err)
+}
+
+func TestServiceDeployer_UpdateLockfile_LocalDependency(t *testing.T) {
+	// the local package is part of the project, its own dependencies are locked
+	restore := withFakeGitHub(map[string]string{
+		"owner/repo": `packages:
+  repo:
+`,
+	})
+	defer restore()
+
+	dir, err := ioutil.TempDir("", "lockfile")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "utils"), os.ModePerm))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "utils", utils.ManifestFileNameYaml), []byte(`packages:
+  utils:
+    dependencies:
+      repo:
+        location: http://`+LOCK_TEST_HOST+`/owner/repo
+`), 0644))
+	manifestPath := filepath.Join(dir, utils.ManifestFileNameYaml)
+	assert.NoError(t, ioutil.WriteFile(manifestPath, []byte(`packages:
+  root:
+    dependencies:
+      utils:
+        location: ./utils
+`), 0644))
+
+	deployer := NewServiceDeployer()
+	deployer.ProjectPath = dir
+	deployer.ManifestPath = manifestPath
+	assert.NoError(t, deployer.UpdateLockfile())
+
+	lock, err := dependencies.ReadLockfile(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(lock.Dependencies), "local dependencies are not locked")
+	assert.Equal(t, LOCK_TEST_COMMIT, lock.Dependencies["repo"].Commit)
+}
+
+func TestServiceDeployer_VendorDependencies(t *testing.T) {
+	restore := withFakeGitHub(map[string]string{
+		"owner/repo": `packages:
+  repo:
+`,
+	})
+
+	dir, err := ioutil.TempDir("", "lockfile")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	manifestPath := filepath.Join(dir, utils.ManifestFileNameYaml)
+	assert.NoError(t, ioutil.WriteFile(manifestPath, []byte(`packages:
+  root:
+    dependencies:
+      repo:
+        location: http://`+LOCK_TEST_HOST+`/owner/repo
+      cloudant:
+        location: /whisk.system/cloudant
+`), 0644))
+
+	deployer := NewServiceDeployer()
+	deployer.ProjectPath = dir
+	deployer.ManifestPath = manifestPath
+	assert.NoError(t, deployer.VendorDependencies())
+	restore()
+
+	vendored, err := ioutil.ReadDir(filepath.Join(dir, dependencies.VENDOR_DIR))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(vendored), "only remote dependencies are vendored")
+	assert.True(t, utils.FileExists(filepath.Join(dir, dependencies.VENDOR_DIR, "repo", utils.ManifestFileNameYaml)))
+
+	// without network nor cache, the vendored dependencies are deployed
+	assert.NoError(t, os.RemoveAll(filepath.Join(dir, "Packages")))
+	utils.Flags.FrozenLockfile = true
+	defer func() { utils.Flags.FrozenLockfile = false }()
+	deployer = NewServiceDeployer()
+	deployer.ProjectPath = dir
+	deployer.ManifestPath = manifestPath
+	graph, err := deployer.ResolveDependencies()
+	assert.NoError(t, err)
+	assert.Equal(t, LOCK_TEST_COMMIT, graph.Nodes["repo"].Commit)
+	assert.True(t, utils.FileExists(filepath.Join(dependencyProjectPath("repo", graph.Nodes["repo"].Record), utils.ManifestFileNameYaml)))
+}
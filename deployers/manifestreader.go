@@ -98,7 +98,12 @@ func (reader *ManifestReader) HandleYaml(manifestParser *parsers.YAMLParser, man
 		return wskderrors.NewYAMLFileFormatError(manifestName, err)
 	}
 
-	triggers, err := manifestParser.ComposeTriggersFromAllPackages(manifest, reader.serviceDeployer.ManifestPath, managedAnnotations, inputs)
+	feeds, err := manifestParser.ComposeFeedsFromAllPackages(manifest, reader.serviceDeployer.ManifestPath)
+	if err != nil {
+		return wskderrors.NewYAMLFileFormatError(manifestName, err)
+	}
+
+	triggers, statuses, err := manifestParser.ComposeTriggersFromAllPackages(manifest, reader.serviceDeployer.ManifestPath, managedAnnotations, inputs)
 	if err != nil {
 		return wskderrors.NewYAMLFileFormatError(manifestName, err)
 	}
@@ -118,6 +123,11 @@ func (reader *ManifestReader) HandleYaml(manifestParser *parsers.YAMLParser, man
 		return wskderrors.NewYAMLFileFormatError(manifestName, err)
 	}
 
+	schemas, err := manifestParser.ComposeParameterSchemasFromAllPackages(manifest, reader.serviceDeployer.ManifestPath, inputs)
+	if err != nil {
+		return wskderrors.NewYAMLFileFormatError(manifestName, err)
+	}
+
 	err = reader.SetDependencies(deps)
 	if err != nil {
 		return wskderrors.NewYAMLFileFormatError(manifestName, err)
@@ -133,7 +143,12 @@ func (reader *ManifestReader) HandleYaml(manifestParser *parsers.YAMLParser, man
 		return wskderrors.NewYAMLFileFormatError(manifestName, err)
 	}
 
-	err = reader.SetTriggers(triggers)
+	err = reader.SetFeeds(feeds)
+	if err != nil {
+		return wskderrors.NewYAMLFileFormatError(manifestName, err)
+	}
+
+	err = reader.SetTriggers(triggers, statuses)
 	if err != nil {
 		return wskderrors.NewYAMLFileFormatError(manifestName, err)
 	}
@@ -153,6 +168,11 @@ func (reader *ManifestReader) HandleYaml(manifestParser *parsers.YAMLParser, man
 		return wskderrors.NewYAMLFileFormatError(manifestName, err)
 	}
 
+	err = reader.SetParameterSchemas(schemas)
+	if err != nil {
+		return wskderrors.NewYAMLFileFormatError(manifestName, err)
+	}
+
 	return nil
 }
 
@@ -185,7 +205,7 @@ func (reader *ManifestReader) SetDependencies(deps map[string]dependencies.Depen
 		}
 		if !dependency.IsBinding && !reader.IsUndeploy {
 			if _, exists := dep.DependencyMaster[depName]; exists {
-				if !dependencies.CompareDependencyRecords(dep.DependencyMaster[depName], dependency) {
+				if !dependencies.CompatibleDependencyRecords(dep.DependencyMaster[depName], dependency) {
 					location := strings.Join([]string{dep.DependencyMaster[depName].Location, dependency.Location}, ",")
 					errmsg := wski18n.T(wski18n.ID_ERR_DEPENDENCIES_WITH_SAME_LABEL_X_dependency_X_location_X,
 						map[string]interface{}{wski18n.KEY_DEPENDENCY: depName,
@@ -193,11 +213,6 @@ func (reader *ManifestReader) SetDependencies(deps map[string]dependencies.Depen
 					return wskderrors.NewYAMLParserErr(dep.ManifestPath, errmsg)
 				}
 			}
-			gitReader := dependencies.NewGitReader(depName, dependency)
-			err := gitReader.CloneDependency()
-			if err != nil {
-				return err
-			}
 		}
 		// store in two places (one local to package to preserve relationship, one in master record to check for conflics
 		dep.Deployment.Packages[dependency.Packagename].Dependencies[depName] = dependency
@@ -244,7 +259,40 @@ func (reader *ManifestReader) SetSequences(sequences []utils.ActionRecord) error
 	return nil
 }
 
-func (reader *ManifestReader) SetTriggers(triggers []*whisk.Trigger) error {
+// SetFeeds records the feeds of the packages, and annotates the actions implementing
+// them as feed actions
+func (reader *ManifestReader) SetFeeds(feeds []utils.FeedRecord) error {
+	dep := reader.serviceDeployer
+	dep.mt.Lock()
+	defer dep.mt.Unlock()
+
+	for i := range feeds {
+		feed := feeds[i]
+		pack := dep.Deployment.Packages[feed.Packagename]
+		name := feed.Action[strings.LastIndex(feed.Action, parsers.PATH_SEPARATOR)+1:]
+		record, ok := pack.Actions[name]
+		if !ok {
+			record = pack.Sequences[name]
+		}
+		if record.Action != nil && !isFeedAction(record.Action) {
+			record.Action.Annotations = append(record.Action.Annotations, whisk.KeyValue{Key: parsers.YAML_KEY_FEED, Value: true})
+		}
+		dep.Deployment.Feeds[feed.Action] = &feed
+	}
+	return nil
+}
+
+// isFeedAction tells whether the action is annotated as a feed action
+func isFeedAction(action *whisk.Action) bool {
+	for _, annotation := range action.Annotations {
+		if annotation.Key == parsers.YAML_KEY_FEED {
+			return true
+		}
+	}
+	return false
+}
+
+func (reader *ManifestReader) SetTriggers(triggers []*whisk.Trigger, statuses map[string]string) error {
 
 	dep := reader.serviceDeployer
 
@@ -276,6 +324,9 @@ func (reader *ManifestReader) SetTriggers(triggers []*whisk.Trigger) error {
 		}
 		dep.Deployment.Triggers[trigger.Name] = trigger
 	}
+	for name, status := range statuses {
+		dep.Deployment.TriggerStatus[name] = status
+	}
 	return nil
 }
 
@@ -336,6 +387,15 @@ func (reader *ManifestReader) SetSwaggerApi(api *whisk.ApiCreateRequest, respons
 	return nil
 }
 
+func (reader *ManifestReader) SetParameterSchemas(schemas []parsers.ParameterSchema) error {
+	dep := reader.serviceDeployer
+
+	dep.mt.Lock()
+	defer dep.mt.Unlock()
+	dep.Deployment.ParameterSchemas = append(dep.Deployment.ParameterSchemas, schemas...)
+	return nil
+}
+
 // Check action record before deploying it
 // action record is created by reading and composing action elements from manifest file
 // Action.kind is mandatory which is set to
@@ -230,6 +230,29 @@ func TestManifestReader_SetDependencies_Bogus(t *testing.T) {
 	assert.NotNil(t, err, fmt.Sprintf(TEST_ERROR_FAILED_TO_REPORT_ERROR, manifestFile))
 }
 
+func TestManifestReader_SetDependencies_CompatibleRanges(t *testing.T) {
+	manifestFile := "../tests/dat/manifest_validate_dependencies.yaml"
+	deployer, err := buildServiceDeployer(manifestFile)
+	assert.Nil(t, err, fmt.Sprintf(TEST_ERROR_BUILD_SERVICE_DEPLOYER, manifestFile))
+	deployer.Deployment.Packages["pkg1"] = NewDeploymentPackage()
+	deployer.Deployment.Packages["pkg2"] = NewDeploymentPackage()
+
+	location := "https://github.com/my-org/my-project/utils"
+	var manifestReader = NewManifestReader(deployer)
+	err = manifestReader.SetDependencies(map[string]dependencies.DependencyRecord{
+		"pkg1:utils": dependencies.NewDependencyRecord("", "pkg1", location, "^1.0.0", nil, nil, false)})
+	assert.Nil(t, err)
+
+	// the dependency resolver picks a tag satisfying both ranges
+	err = manifestReader.SetDependencies(map[string]dependencies.DependencyRecord{
+		"pkg2:utils": dependencies.NewDependencyRecord("", "pkg2", location, "~1.2.0", nil, nil, false)})
+	assert.Nil(t, err, "Rejects compatible version ranges of the same dependency")
+
+	err = manifestReader.SetDependencies(map[string]dependencies.DependencyRecord{
+		"pkg2:utils": dependencies.NewDependencyRecord("", "pkg2", location, "v2.0.0", nil, nil, false)})
+	assert.NotNil(t, err, fmt.Sprintf(TEST_ERROR_FAILED_TO_REPORT_ERROR, manifestFile))
+}
+
 func TestManifestReader_SetActions(t *testing.T) {
 	manifestFile := "../tests/dat/manifest_validate_action_all.yaml"
 	deployer, err := buildServiceDeployer(manifestFile)
@@ -296,6 +319,35 @@ func TestManifestReader_SetActions(t *testing.T) {
 	}
 }
 
+func TestManifestReader_SetFeeds(t *testing.T) {
+	manifestFile := "../tests/dat/manifest_data_compose_feeds.yaml"
+	deployer, err := buildServiceDeployer(manifestFile)
+	assert.Nil(t, err, fmt.Sprintf(TEST_ERROR_BUILD_SERVICE_DEPLOYER, manifestFile))
+
+	var manifestReader = NewManifestReader(deployer)
+	manifest, manifestParser, err := manifestReader.ParseManifest()
+	assert.Nil(t, err, fmt.Sprintf(TEST_ERROR_MANIFEST_PARSE_FAILURE, manifestFile))
+	err = manifestReader.InitPackages(manifestParser, manifest, whisk.KeyValue{})
+	assert.Nil(t, err, fmt.Sprintf(TEST_ERROR_MANIFEST_SET_PACKAGES, manifestFile))
+	err = manifestReader.HandleYaml(manifestParser, manifest, whisk.KeyValue{})
+	assert.Nil(t, err, fmt.Sprintf(TEST_ERROR_MANIFEST_PARSE_FAILURE, manifestFile))
+
+	// feeds are keyed by their action, which is annotated as a feed action
+	assert.Equal(t, 2, len(deployer.Deployment.Feeds))
+	assert.Equal(t, "changes", deployer.Deployment.Feeds["events/changesFeed"].Name)
+	assert.Equal(t, "ticks", deployer.Deployment.Feeds["events/ticks"].Name)
+	actions := deployer.Deployment.Packages["events"].Actions
+	assert.Equal(t, true, actions["changesFeed"].Action.Annotations.GetValue(parsers.YAML_KEY_FEED))
+	assert.Equal(t, true, actions["ticks"].Action.Annotations.GetValue(parsers.YAML_KEY_FEED))
+	assert.Nil(t, actions["pauseChanges"].Action.Annotations.GetValue(parsers.YAML_KEY_FEED))
+
+	// the trigger of a feed is created once the actions of the feed are
+	g := deployer.buildDeployGraph()
+	assert.Equal(t, []string{"action:events/changesFeed", "action:events/pauseChanges"}, g.index["trigger:onChange"].deps)
+	assert.Equal(t, []string{"action:events/ticks"}, g.index["trigger:onTick"].deps)
+	assert.Empty(t, g.index["trigger:onAlarm"].deps)
+}
+
 func TestManifestReader_SetSequences_Bogus(t *testing.T) {
 	manifestFile := "../tests/dat/manifest_validate_sequences_bogus.yaml"
 	deployer, err := buildServiceDeployer(manifestFile)
@@ -0,0 +1,98 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"path"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/parsers"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+)
+
+/*
+ * Parameters declaring a schema in the manifest file are validated against it once the
+ * deployment plan is complete, i.e. after the values of the deployment file and of the
+ * command line have been bound and the secrets resolved. So are the parameters of the
+ * types validated by parsers.ValidateParameterValue, e.g. string64.
+ */
+
+// validateParameterSchemas validates the final value of every parameter declaring a schema
+// or a validated type
+func (deployer *ServiceDeployer) validateParameterSchemas() error {
+	for _, schema := range deployer.Deployment.ParameterSchemas {
+		parameters, ok := deployer.parameterValues(schema)
+		if !ok {
+			continue
+		}
+		value := parameters.GetValue(schema.Parameter)
+		if value == nil {
+			continue
+		}
+		if len(schema.Type) != 0 {
+			if err := parsers.ValidateParameterValue(deployer.valueSource(schema), schema.Parameter, schema.Type, value); err != nil {
+				wskderrors.SetEntity(err, schema.Key+":"+schema.Entity)
+				return err
+			}
+		}
+		if schema.Schema == nil {
+			continue
+		}
+		if violations := schema.Schema.ValidateValue(value); len(violations) > 0 {
+			err := wskderrors.NewParameterSchemaError(schema.Filepath, schema.Key, schema.Entity, schema.Parameter, violations)
+			wskderrors.SetEntity(err, schema.Key+":"+schema.Entity)
+			return err
+		}
+	}
+	return nil
+}
+
+// parameterValues returns the parameters of the package, action or trigger declaring a
+// schema, if this entity is part of the deployment plan
+func (deployer *ServiceDeployer) parameterValues(schema parsers.ParameterSchema) (whisk.KeyValueArr, bool) {
+	switch schema.Key {
+	case parsers.YAML_KEY_PACKAGE:
+		if pack, ok := deployer.Deployment.Packages[schema.Package]; ok && pack.Package != nil {
+			return pack.Package.Parameters, true
+		}
+	case parsers.YAML_KEY_ACTION:
+		if pack, ok := deployer.Deployment.Packages[schema.Package]; ok {
+			if record, ok := pack.Actions[schema.Entity]; ok && record.Action != nil {
+				return record.Action.Parameters, true
+			}
+		}
+	case parsers.YAML_KEY_TRIGGER:
+		if trigger, ok := deployer.Deployment.Triggers[schema.Entity]; ok {
+			return trigger.Parameters, true
+		}
+	}
+	return nil, false
+}
+
+// valueSource returns the file, or the command line, the value of the parameter was read
+// from, the manifest file unless a deployment file or --param set it
+func (deployer *ServiceDeployer) valueSource(schema parsers.ParameterSchema) string {
+	entity := schema.Entity
+	if schema.Key == parsers.YAML_KEY_ACTION {
+		entity = path.Join(schema.Package, schema.Entity)
+	}
+	if source, ok := deployer.inputSources[inputSourceKey(schema.Key, entity, schema.Parameter)]; ok {
+		return source
+	}
+	return schema.Filepath
+}
@@ -0,0 +1,115 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildSchemaParamsDeployer(t *testing.T) *ServiceDeployer {
+	manifestFile := "../tests/dat/manifest_validate_schema_params.yaml"
+	deployer, err := buildServiceDeployer(manifestFile)
+	assert.Nil(t, err, fmt.Sprintf(TEST_ERROR_BUILD_SERVICE_DEPLOYER, manifestFile))
+
+	manifestReader := NewManifestReader(deployer)
+	manifest, manifestParser, err := manifestReader.ParseManifest()
+	assert.Nil(t, err, fmt.Sprintf(TEST_ERROR_MANIFEST_PARSE_FAILURE, manifestFile))
+	assert.Nil(t, manifestReader.InitPackages(manifestParser, manifest, whisk.KeyValue{}))
+	assert.Nil(t, manifestReader.HandleYaml(manifestParser, manifest, whisk.KeyValue{}))
+	return deployer
+}
+
+func TestValidateParameterSchemas(t *testing.T) {
+	deployer := buildSchemaParamsDeployer(t)
+	assert.Equal(t, 5, len(deployer.Deployment.ParameterSchemas), "4 schemas and a string16")
+	assert.Nil(t, deployer.validateParameterSchemas())
+
+	trigger := deployer.Deployment.Triggers["schedule"]
+	trigger.Parameters = whisk.KeyValueArr{{Key: "cron", Value: "daily"}}
+	err := deployer.validateParameterSchemas()
+	assert.NotNil(t, err, "A value violating its schema should fail the deployment plan.")
+	assert.Equal(t, wskderrors.EXIT_CODE_PARAMETER_SCHEMA_VIOLATION, wskderrors.ExitCode(err))
+	assert.Equal(t, "trigger:schedule", err.(*wskderrors.ParameterSchemaError).GetEntity())
+}
+
+func TestValidateParameterSchemas_DeploymentFile(t *testing.T) {
+	deployer := buildSchemaParamsDeployer(t)
+	deployer.DeploymentPath = "../tests/dat/deployment_validate_schema_params.yaml"
+
+	dReader := NewDeploymentReader(deployer)
+	assert.Nil(t, dReader.HandleYaml())
+	var inputs interface{}
+	assert.Nil(t, dReader.bindActionInputsAndAnnotations(inputs))
+
+	err := deployer.validateParameterSchemas()
+	assert.NotNil(t, err, "A value of the deployment file violating its schema should fail the deployment plan.")
+	schemaErr, ok := err.(*wskderrors.ParameterSchemaError)
+	assert.True(t, ok, "Expected a parameter schema error, got: "+err.Error())
+	assert.Equal(t, "config", schemaErr.Parameter)
+	assert.Equal(t, []string{"/port"}, violationPaths(schemaErr.Violations))
+	assert.Contains(t, err.Error(), "Parameter [config] of action [validate_schema_params] does not conform to its schema")
+
+	// once config is fixed, the next parameter is reported with every violation
+	params := deployer.Deployment.Packages["validate_schema"].Actions["validate_schema_params"].Action.Parameters
+	for i := range params {
+		if params[i].Key == "config" {
+			params[i].Value = map[string]interface{}{"host": "example.com", "port": 443}
+		}
+	}
+	err = deployer.validateParameterSchemas()
+	assert.NotNil(t, err)
+	schemaErr = err.(*wskderrors.ParameterSchemaError)
+	assert.Equal(t, "retry", schemaErr.Parameter)
+	assert.Equal(t, []string{"/attempts", "/backoff", "/delay"}, violationPaths(schemaErr.Violations))
+
+	// the value of a typed parameter bound by the deployment file is validated again
+	for i := range params {
+		if params[i].Key == "retry" {
+			params[i].Value = map[string]interface{}{"attempts": 3, "delay": "500ms"}
+		}
+	}
+	err = deployer.validateParameterSchemas()
+	assert.NotNil(t, err, "A string16 value of the deployment file longer than 16 characters should fail the deployment plan.")
+	assert.IsType(t, &wskderrors.ParameterTypeMismatchError{}, err)
+	assert.Contains(t, err.Error(), "Parameter [title]")
+	assert.Contains(t, err.Error(), "string(30)")
+	assert.Contains(t, err.Error(), "deployment_validate_schema_params.yaml", "The error names the file the value was read from.")
+	assert.Equal(t, "action:validate_schema_params", err.(*wskderrors.ParameterTypeMismatchError).GetEntity())
+
+	for i := range params {
+		if params[i].Key == "title" {
+			params[i].Value = "Hello"
+		}
+	}
+	assert.Nil(t, deployer.validateParameterSchemas())
+}
+
+func violationPaths(violations []wskderrors.SchemaViolation) []string {
+	paths := make([]string, 0, len(violations))
+	for _, v := range violations {
+		paths = append(paths, v.Path)
+	}
+	return paths
+}
@@ -0,0 +1,817 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/parsers"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+	"github.com/apache/openwhisk-wskdeploy/wskprint"
+)
+
+// Possible outcomes for an entity of the deployment plan once compared
+// against what is currently deployed in the namespace
+const (
+	PLAN_CREATE    = "create"
+	PLAN_UPDATE    = "update"
+	PLAN_UNCHANGED = "unchanged"
+	PLAN_ORPHANED  = "orphaned"
+)
+
+// Fields reported in a plan diff
+const (
+	PLAN_FIELD_CODE        = "code"
+	PLAN_FIELD_KIND        = "kind"
+	PLAN_FIELD_MAIN        = "main"
+	PLAN_FIELD_IMAGE       = "image"
+	PLAN_FIELD_COMPONENTS  = "components"
+	PLAN_FIELD_LIMITS      = "limits"
+	PLAN_FIELD_PARAMETERS  = "parameters"
+	PLAN_FIELD_ANNOTATIONS = "annotations"
+	PLAN_FIELD_TRIGGER     = "trigger"
+	PLAN_FIELD_ACTION      = "action"
+	PLAN_FIELD_STATUS      = "status"
+)
+
+// maximum page size accepted by the OpenWhisk list APIs
+const PLAN_LIST_LIMIT = 200
+
+// annotations the server attaches to actions on its own, they never appear in a manifest
+var serverGeneratedAnnotations = map[string]bool{
+	"exec":            true,
+	"provide-api-key": true,
+}
+
+// PlanFieldDiff holds a single field which differs between the manifest and the server
+type PlanFieldDiff struct {
+	Field    string `json:"field"`
+	Deployed string `json:"deployed"`
+	Planned  string `json:"planned"`
+}
+
+// PlanEntry describes what a deployment would do to one entity
+type PlanEntry struct {
+	Kind   string          `json:"kind"`
+	Name   string          `json:"name"`
+	Change string          `json:"change"`
+	Diffs  []PlanFieldDiff `json:"diffs,omitempty"`
+}
+
+// ServerPlan is the deployment plan compared against the namespace contents
+type ServerPlan struct {
+	Entries []PlanEntry `json:"entries"`
+}
+
+func (plan *ServerPlan) add(kind string, name string, diffs []PlanFieldDiff, exists bool) {
+	entry := PlanEntry{Kind: kind, Name: name, Diffs: diffs}
+	if !exists {
+		entry.Change = PLAN_CREATE
+	} else if len(diffs) > 0 {
+		entry.Change = PLAN_UPDATE
+	} else {
+		entry.Change = PLAN_UNCHANGED
+	}
+	plan.Entries = append(plan.Entries, entry)
+}
+
+func (plan *ServerPlan) addOrphan(kind string, name string) {
+	plan.Entries = append(plan.Entries, PlanEntry{Kind: kind, Name: name, Change: PLAN_ORPHANED})
+}
+
+// Count returns the number of entries with the given change
+func (plan *ServerPlan) Count(change string) int {
+	count := 0
+	for _, entry := range plan.Entries {
+		if entry.Change == change {
+			count++
+		}
+	}
+	return count
+}
+
+// ConstructServerPlan compares the deployment plan built by ConstructDeploymentPlan()
+// with the entities deployed in the namespace. Entities found on the server which are
+// not part of the plan are reported as orphaned when they live in one of the project
+// packages or carry the managed annotation of the current project.
+func (deployer *ServiceDeployer) ConstructServerPlan() (*ServerPlan, error) {
+	plan := new(ServerPlan)
+	planned := make(map[string]bool)
+
+	for _, pkgName := range sortedPackageNames(deployer.Deployment.Packages) {
+		pack := deployer.Deployment.Packages[pkgName]
+		isDefault := strings.ToLower(pack.Package.Name) == parsers.DEFAULT_PACKAGE
+		var remotePkg *whisk.Package
+
+		if !isDefault && !deployer.isContainer(pack.Package.Name) {
+			var err error
+			remotePkg, err = deployer.getRemotePackage(pack.Package.Name)
+			if err != nil {
+				return nil, err
+			}
+			var diffs []PlanFieldDiff
+			if remotePkg != nil {
+				diffs = diffPackage(pack.Package, remotePkg)
+			}
+			plan.add(parsers.YAML_KEY_PACKAGE, pack.Package.Name, diffs, remotePkg != nil)
+			planned[planKey(parsers.YAML_KEY_PACKAGE, pack.Package.Name)] = true
+		}
+
+		for _, kind := range []string{parsers.YAML_KEY_ACTION, parsers.YAML_KEY_SEQUENCE} {
+			records := pack.Actions
+			if kind == parsers.YAML_KEY_SEQUENCE {
+				records = pack.Sequences
+			}
+			for _, name := range sortedActionNames(records) {
+				action := records[name].Action
+				actionName := action.Name
+				if !isDefault {
+					actionName = strings.Join([]string{pack.Package.Name, action.Name}, parsers.PATH_SEPARATOR)
+				}
+				planned[planKey(parsers.YAML_KEY_ACTION, actionName)] = true
+
+				remote, err := deployer.getRemoteAction(actionName)
+				if err != nil {
+					return nil, err
+				}
+				var diffs []PlanFieldDiff
+				if remote != nil {
+					diffs = diffAction(action, remote, remotePkg)
+				}
+				plan.add(kind, actionName, diffs, remote != nil)
+			}
+		}
+
+		// actions deployed in a project package but no longer part of it,
+		// a selective deployment does not know about the entities which are not selected
+		if remotePkg != nil && !deployer.IsSelective() {
+			remoteActions, err := deployer.listRemoteActions(pack.Package.Name)
+			if err != nil {
+				return nil, err
+			}
+			for _, remote := range remoteActions {
+				actionName := strings.Join([]string{pack.Package.Name, remote.Name}, parsers.PATH_SEPARATOR)
+				if !planned[planKey(parsers.YAML_KEY_ACTION, actionName)] {
+					plan.addOrphan(remoteActionKind(&remote), actionName)
+					planned[planKey(parsers.YAML_KEY_ACTION, actionName)] = true
+				}
+			}
+		}
+	}
+
+	for _, name := range sortedTriggerNames(deployer.Deployment.Triggers) {
+		trigger := deployer.Deployment.Triggers[name]
+		planned[planKey(parsers.YAML_KEY_TRIGGER, trigger.Name)] = true
+		remote, err := deployer.getRemoteTrigger(trigger.Name)
+		if err != nil {
+			return nil, err
+		}
+		var diffs []PlanFieldDiff
+		if remote != nil {
+			diffs = diffTrigger(trigger, remote)
+		}
+		plan.add(parsers.YAML_KEY_TRIGGER, trigger.Name, diffs, remote != nil)
+	}
+
+	for _, name := range sortedRuleNames(deployer.Deployment.Rules) {
+		rule := deployer.Deployment.Rules[name]
+		planned[planKey(parsers.YAML_KEY_RULE, rule.Name)] = true
+		remote, err := deployer.getRemoteRule(rule.Name)
+		if err != nil {
+			return nil, err
+		}
+		var diffs []PlanFieldDiff
+		if remote != nil {
+			diffs = diffRule(rule, remote)
+		}
+		plan.add(parsers.YAML_KEY_RULE, rule.Name, diffs, remote != nil)
+	}
+
+	if err := deployer.planApis(plan); err != nil {
+		return nil, err
+	}
+
+	if !deployer.IsSelective() {
+		if err := deployer.planManagedOrphans(plan, planned); err != nil {
+			return nil, err
+		}
+	}
+
+	return plan, nil
+}
+
+// PlanDeployment prints the server side plan of the current deployment without changing anything
+func (deployer *ServiceDeployer) PlanDeployment() error {
+	plan, err := deployer.ConstructServerPlan()
+	if err != nil {
+		return err
+	}
+	printServerPlan(plan)
+	return nil
+}
+
+func (deployer *ServiceDeployer) planApis(plan *ServerPlan) error {
+	// NOTE: deploy applies either the swagger or the manifest defined apis, never both
+	if deployer.Deployment.SwaggerApi != nil && deployer.Deployment.SwaggerApiOptions != nil {
+		// a swagger document is always re-applied as a whole on deploy,
+		// hence an existing swagger API is always reported as an update
+		basePath := deployer.Deployment.SwaggerApiOptions.ApiBasePath
+		apis, err := deployer.getRemoteApis(&whisk.ApiGetRequestOptions{ApiBasePath: basePath})
+		if err != nil {
+			return err
+		}
+		change := PLAN_CREATE
+		if len(apis) > 0 {
+			change = PLAN_UPDATE
+		}
+		plan.Entries = append(plan.Entries, PlanEntry{Kind: parsers.YAML_KEY_API, Name: basePath, Change: change})
+		return nil
+	}
+
+	apiPaths := make([]string, 0, len(deployer.Deployment.Apis))
+	for apiPath := range deployer.Deployment.Apis {
+		apiPaths = append(apiPaths, apiPath)
+	}
+	sort.Strings(apiPaths)
+
+	for _, apiPath := range apiPaths {
+		api := deployer.Deployment.Apis[apiPath].ApiDoc
+		apis, err := deployer.getRemoteApis(&whisk.ApiGetRequestOptions{
+			ApiBasePath: api.GatewayBasePath,
+			ApiRelPath:  api.GatewayRelPath,
+			ApiVerb:     api.GatewayMethod,
+		})
+		if err != nil {
+			return err
+		}
+		remoteAction, found := findApiAction(apis, api.GatewayRelPath, api.GatewayMethod)
+		var diffs []PlanFieldDiff
+		if found && api.Action != nil && remoteAction != api.Action.Name {
+			diffs = append(diffs, PlanFieldDiff{Field: PLAN_FIELD_ACTION, Deployed: remoteAction, Planned: api.Action.Name})
+		}
+		plan.add(parsers.YAML_KEY_API, apiPath, diffs, found)
+	}
+	return nil
+}
+
+// entities carrying the managed annotation of this project which are not part of the plan
+func (deployer *ServiceDeployer) planManagedOrphans(plan *ServerPlan, planned map[string]bool) error {
+	if len(deployer.ProjectName) == 0 {
+		return nil
+	}
+
+	packages, err := deployer.listRemotePackages()
+	if err != nil {
+		return err
+	}
+	for _, pkg := range packages {
+		if !planned[planKey(parsers.YAML_KEY_PACKAGE, pkg.Name)] && deployer.isManagedByProject(pkg.Annotations) {
+			plan.addOrphan(parsers.YAML_KEY_PACKAGE, pkg.Name)
+		}
+	}
+
+	actions, err := deployer.listRemoteActions("")
+	if err != nil {
+		return err
+	}
+	for _, action := range actions {
+		actionName := remoteActionName(&action)
+		if !planned[planKey(parsers.YAML_KEY_ACTION, actionName)] && deployer.isManagedByProject(action.Annotations) {
+			plan.addOrphan(remoteActionKind(&action), actionName)
+		}
+	}
+
+	triggers, err := deployer.listRemoteTriggers()
+	if err != nil {
+		return err
+	}
+	for _, trigger := range triggers {
+		if !planned[planKey(parsers.YAML_KEY_TRIGGER, trigger.Name)] && deployer.isManagedByProject(trigger.Annotations) {
+			plan.addOrphan(parsers.YAML_KEY_TRIGGER, trigger.Name)
+		}
+	}
+
+	rules, err := deployer.listRemoteRules()
+	if err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		if !planned[planKey(parsers.YAML_KEY_RULE, rule.Name)] && deployer.isManagedByProject(rule.Annotations) {
+			plan.addOrphan(parsers.YAML_KEY_RULE, rule.Name)
+		}
+	}
+	return nil
+}
+
+func (deployer *ServiceDeployer) isManagedByProject(annotations whisk.KeyValueArr) bool {
+	if a := annotations.GetValue(utils.MANAGED); a != nil {
+		if ma, ok := a.(map[string]interface{}); ok {
+			return ma[utils.OW_PROJECT_NAME] == deployer.ProjectName
+		}
+	}
+	return false
+}
+
+func (deployer *ServiceDeployer) getRemotePackage(name string) (*whisk.Package, error) {
+	var pkg *whisk.Package
+	var response *http.Response
+	err := retry(deployer.Retry, func() (*http.Response, error) {
+		var err error
+		pkg, response, err = deployer.Client.Packages.Get(name)
+		return response, err
+	})
+	if isNotFound(response) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, planLookupError(err, response)
+	}
+	return pkg, nil
+}
+
+func (deployer *ServiceDeployer) getRemoteAction(name string) (*whisk.Action, error) {
+	return deployer.lookupRemoteAction(name, true)
+}
+
+// lookupRemoteAction fetches an action, along with its code if fetchCode is set
+func (deployer *ServiceDeployer) lookupRemoteAction(name string, fetchCode bool) (*whisk.Action, error) {
+	var action *whisk.Action
+	var response *http.Response
+	err := retry(deployer.Retry, func() (*http.Response, error) {
+		var err error
+		action, response, err = deployer.Client.Actions.Get(name, fetchCode)
+		return response, err
+	})
+	if isNotFound(response) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, planLookupError(err, response)
+	}
+	return action, nil
+}
+
+func (deployer *ServiceDeployer) getRemoteTrigger(name string) (*whisk.Trigger, error) {
+	var trigger *whisk.Trigger
+	var response *http.Response
+	err := retry(deployer.Retry, func() (*http.Response, error) {
+		var err error
+		trigger, response, err = deployer.Client.Triggers.Get(name)
+		return response, err
+	})
+	if isNotFound(response) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, planLookupError(err, response)
+	}
+	return trigger, nil
+}
+
+func (deployer *ServiceDeployer) getRemoteRule(name string) (*whisk.Rule, error) {
+	var rule *whisk.Rule
+	var response *http.Response
+	err := retry(deployer.Retry, func() (*http.Response, error) {
+		var err error
+		rule, response, err = deployer.Client.Rules.Get(name)
+		return response, err
+	})
+	if isNotFound(response) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, planLookupError(err, response)
+	}
+	return rule, nil
+}
+
+func (deployer *ServiceDeployer) getRemoteApis(options *whisk.ApiGetRequestOptions) ([]whisk.ApiItem, error) {
+	if len(deployer.Client.Config.ApigwTenantId) > 0 {
+		options.SpaceGuid = deployer.Client.Config.ApigwTenantId
+	} else {
+		options.SpaceGuid = strings.Split(deployer.Client.Config.AuthToken, ":")[0]
+	}
+	options.AccessToken = deployer.Client.Config.ApigwAccessToken
+
+	var apis *whisk.ApiGetResponse
+	var response *http.Response
+	err := retry(deployer.Retry, func() (*http.Response, error) {
+		var err error
+		apis, response, err = deployer.Client.Apis.Get(&whisk.ApiGetRequest{}, options)
+		return response, err
+	})
+	if isNotFound(response) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, planLookupError(err, response)
+	}
+	return apis.Apis, nil
+}
+
+func (deployer *ServiceDeployer) listRemotePackages() ([]whisk.Package, error) {
+	var packages []whisk.Package
+	for skip := 0; ; skip += PLAN_LIST_LIMIT {
+		var page []whisk.Package
+		var response *http.Response
+		err := retry(deployer.Retry, func() (*http.Response, error) {
+			var err error
+			page, response, err = deployer.Client.Packages.List(&whisk.PackageListOptions{Limit: PLAN_LIST_LIMIT, Skip: skip})
+			return response, err
+		})
+		if err != nil {
+			return nil, planLookupError(err, response)
+		}
+		packages = append(packages, page...)
+		if len(page) < PLAN_LIST_LIMIT {
+			return packages, nil
+		}
+	}
+}
+
+func (deployer *ServiceDeployer) listRemoteActions(packageName string) ([]whisk.Action, error) {
+	var actions []whisk.Action
+	for skip := 0; ; skip += PLAN_LIST_LIMIT {
+		var page []whisk.Action
+		var response *http.Response
+		err := retry(deployer.Retry, func() (*http.Response, error) {
+			var err error
+			page, response, err = deployer.Client.Actions.List(packageName, &whisk.ActionListOptions{Limit: PLAN_LIST_LIMIT, Skip: skip})
+			return response, err
+		})
+		if err != nil {
+			return nil, planLookupError(err, response)
+		}
+		actions = append(actions, page...)
+		if len(page) < PLAN_LIST_LIMIT {
+			return actions, nil
+		}
+	}
+}
+
+func (deployer *ServiceDeployer) listRemoteTriggers() ([]whisk.Trigger, error) {
+	var triggers []whisk.Trigger
+	for skip := 0; ; skip += PLAN_LIST_LIMIT {
+		var page []whisk.Trigger
+		var response *http.Response
+		err := retry(deployer.Retry, func() (*http.Response, error) {
+			var err error
+			page, response, err = deployer.Client.Triggers.List(&whisk.TriggerListOptions{Limit: PLAN_LIST_LIMIT, Skip: skip})
+			return response, err
+		})
+		if err != nil {
+			return nil, planLookupError(err, response)
+		}
+		triggers = append(triggers, page...)
+		if len(page) < PLAN_LIST_LIMIT {
+			return triggers, nil
+		}
+	}
+}
+
+func (deployer *ServiceDeployer) listRemoteRules() ([]whisk.Rule, error) {
+	var rules []whisk.Rule
+	for skip := 0; ; skip += PLAN_LIST_LIMIT {
+		var page []whisk.Rule
+		var response *http.Response
+		err := retry(deployer.Retry, func() (*http.Response, error) {
+			var err error
+			page, response, err = deployer.Client.Rules.List(&whisk.RuleListOptions{Limit: PLAN_LIST_LIMIT, Skip: skip})
+			return response, err
+		})
+		if err != nil {
+			return nil, planLookupError(err, response)
+		}
+		rules = append(rules, page...)
+		if len(page) < PLAN_LIST_LIMIT {
+			return rules, nil
+		}
+	}
+}
+
+func diffPackage(local *whisk.Package, remote *whisk.Package) []PlanFieldDiff {
+	var diffs []PlanFieldDiff
+	diffs = append(diffs, diffKeyValues(PLAN_FIELD_PARAMETERS, local.Parameters, remote.Parameters, nil)...)
+	diffs = append(diffs, diffKeyValues(PLAN_FIELD_ANNOTATIONS, digestAnnotations(local.Annotations), digestAnnotations(remote.Annotations), nil)...)
+	return diffs
+}
+
+// diffAction compares an action (or sequence) of the manifest with its deployed version,
+// parameters inherited from the enclosing package are not reported as differences
+func diffAction(local *whisk.Action, remote *whisk.Action, remotePkg *whisk.Package) []PlanFieldDiff {
+	var diffs []PlanFieldDiff
+
+	if local.Exec != nil && remote.Exec != nil {
+		if local.Exec.Kind != remote.Exec.Kind {
+			diffs = append(diffs, PlanFieldDiff{Field: PLAN_FIELD_KIND, Deployed: remote.Exec.Kind, Planned: local.Exec.Kind})
+		}
+		if local.Exec.Kind == parsers.YAML_KEY_SEQUENCE {
+			localComponents := shortNames(local.Exec.Components)
+			remoteComponents := shortNames(remote.Exec.Components)
+			if strings.Join(localComponents, ",") != strings.Join(remoteComponents, ",") {
+				diffs = append(diffs, PlanFieldDiff{
+					Field:    PLAN_FIELD_COMPONENTS,
+					Deployed: strings.Join(remoteComponents, ","),
+					Planned:  strings.Join(localComponents, ",")})
+			}
+		} else {
+			if localHash, remoteHash := codeHash(local.Exec.Code), codeHash(remote.Exec.Code); localHash != remoteHash {
+				diffs = append(diffs, PlanFieldDiff{Field: PLAN_FIELD_CODE, Deployed: remoteHash, Planned: localHash})
+			}
+			if len(local.Exec.Main) > 0 && local.Exec.Main != remote.Exec.Main {
+				diffs = append(diffs, PlanFieldDiff{Field: PLAN_FIELD_MAIN, Deployed: remote.Exec.Main, Planned: local.Exec.Main})
+			}
+			if local.Exec.Image != remote.Exec.Image {
+				diffs = append(diffs, PlanFieldDiff{Field: PLAN_FIELD_IMAGE, Deployed: remote.Exec.Image, Planned: local.Exec.Image})
+			}
+		}
+	}
+
+	diffs = append(diffs, diffLimits(local.Limits, remote.Limits)...)
+
+	inherited := make(map[string]bool)
+	if remotePkg != nil {
+		for _, p := range remotePkg.Parameters {
+			if local.Parameters.FindKeyValue(p.Key) < 0 {
+				inherited[p.Key] = true
+			}
+		}
+	}
+	diffs = append(diffs, diffKeyValues(PLAN_FIELD_PARAMETERS, local.Parameters, remote.Parameters, inherited)...)
+	diffs = append(diffs, diffKeyValues(PLAN_FIELD_ANNOTATIONS, digestAnnotations(local.Annotations), digestAnnotations(remote.Annotations), serverGeneratedAnnotations)...)
+	return diffs
+}
+
+func diffTrigger(local *whisk.Trigger, remote *whisk.Trigger) []PlanFieldDiff {
+	var diffs []PlanFieldDiff
+	// parameters of a feed trigger are handed over to the feed action and never stored with the trigger
+	if _, isFeed := utils.IsFeedAction(local); !isFeed {
+		diffs = append(diffs, diffKeyValues(PLAN_FIELD_PARAMETERS, local.Parameters, remote.Parameters, nil)...)
+	}
+	diffs = append(diffs, diffKeyValues(PLAN_FIELD_ANNOTATIONS, digestAnnotations(local.Annotations), digestAnnotations(remote.Annotations), nil)...)
+	return diffs
+}
+
+func diffRule(local *whisk.Rule, remote *whisk.Rule) []PlanFieldDiff {
+	var diffs []PlanFieldDiff
+	if localTrigger, remoteTrigger := ruleEntityName(local.Trigger), ruleEntityName(remote.Trigger); localTrigger != remoteTrigger {
+		diffs = append(diffs, PlanFieldDiff{Field: PLAN_FIELD_TRIGGER, Deployed: remoteTrigger, Planned: localTrigger})
+	}
+	if localAction, remoteAction := ruleEntityName(local.Action), ruleEntityName(remote.Action); localAction != remoteAction {
+		diffs = append(diffs, PlanFieldDiff{Field: PLAN_FIELD_ACTION, Deployed: remoteAction, Planned: localAction})
+	}
+	// rules are active unless the manifest or the deployment file says otherwise
+	localStatus := local.Status
+	if len(localStatus) == 0 {
+		localStatus = parsers.YAML_VALUE_ACTIVE
+	}
+	if localStatus != remote.Status {
+		diffs = append(diffs, PlanFieldDiff{Field: PLAN_FIELD_STATUS, Deployed: remote.Status, Planned: localStatus})
+	}
+	diffs = append(diffs, diffKeyValues(PLAN_FIELD_ANNOTATIONS, digestAnnotations(local.Annotations), digestAnnotations(remote.Annotations), nil)...)
+	return diffs
+}
+
+// diffLimits only reports the limits set in the manifest, unset limits are left untouched on deploy
+func diffLimits(local *whisk.Limits, remote *whisk.Limits) []PlanFieldDiff {
+	var diffs []PlanFieldDiff
+	if local == nil {
+		return diffs
+	}
+	if remote == nil {
+		remote = new(whisk.Limits)
+	}
+	compare := func(name string, l *int, r *int) {
+		if l != nil && (r == nil || *l != *r) {
+			deployed := ""
+			if r != nil {
+				deployed = strconv.Itoa(*r)
+			}
+			diffs = append(diffs, PlanFieldDiff{Field: PLAN_FIELD_LIMITS + "." + name, Deployed: deployed, Planned: strconv.Itoa(*l)})
+		}
+	}
+	compare(parsers.LIMIT_VALUE_TIMEOUT, local.Timeout, remote.Timeout)
+	compare(parsers.LIMIT_VALUE_MEMORY_SIZE, local.Memory, remote.Memory)
+	compare(parsers.LIMIT_VALUE_LOG_SIZE, local.Logsize, remote.Logsize)
+	compare(parsers.LIMIT_VALUE_CONCURRENT_ACTIVATIONS, local.Concurrency, remote.Concurrency)
+	return diffs
+}
+
+// diffKeyValues compares two sets of parameters or annotations key by key,
+// keys listed in ignore are skipped when they only exist on the server
+func diffKeyValues(field string, local whisk.KeyValueArr, remote whisk.KeyValueArr, ignore map[string]bool) []PlanFieldDiff {
+	values := make(map[string][2]string)
+	for _, kv := range remote {
+		if !ignore[kv.Key] {
+			values[kv.Key] = [2]string{canonicalJSON(kv.Value), ""}
+		}
+	}
+	for _, kv := range local {
+		v := values[kv.Key]
+		v[1] = canonicalJSON(kv.Value)
+		values[kv.Key] = v
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var diffs []PlanFieldDiff
+	for _, key := range keys {
+		if v := values[key]; v[0] != v[1] {
+			diffs = append(diffs, PlanFieldDiff{Field: field + "." + key, Deployed: v[0], Planned: v[1]})
+		}
+	}
+	return diffs
+}
+
+// canonicalJSON renders a value so that values read from YAML and values
+// returned by the server (e.g. int vs. float64, key order) compare equal
+func canonicalJSON(value interface{}) string {
+	if m, ok := value.(map[interface{}]interface{}); ok {
+		value = utils.ConvertInterfaceMap(m)
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	var generic interface{}
+	if err := json.Unmarshal(b, &generic); err != nil {
+		return string(b)
+	}
+	b, _ = json.Marshal(generic)
+	return string(b)
+}
+
+func codeHash(code *string) string {
+	if code == nil {
+		return ""
+	}
+	return fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(*code)))
+}
+
+// shortName drops the namespace from a fully qualified entity name, i.e. /ns/pkg/action => pkg/action
+func shortName(name string) string {
+	if strings.HasPrefix(name, parsers.PATH_SEPARATOR) {
+		parts := strings.SplitN(strings.TrimPrefix(name, parsers.PATH_SEPARATOR), parsers.PATH_SEPARATOR, 2)
+		if len(parts) == 2 {
+			return parts[1]
+		}
+	}
+	return name
+}
+
+func shortNames(names []string) []string {
+	short := make([]string, 0, len(names))
+	for _, name := range names {
+		short = append(short, shortName(name))
+	}
+	return short
+}
+
+// ruleEntityName returns the trigger or action name of a rule without its namespace,
+// rules read from the manifest hold names while rules read from the server hold {path, name}
+func ruleEntityName(entity interface{}) string {
+	switch e := entity.(type) {
+	case string:
+		return shortName(e)
+	case map[string]interface{}:
+		path, _ := e["path"].(string)
+		name, _ := e["name"].(string)
+		return shortName(parsers.PATH_SEPARATOR + path + parsers.PATH_SEPARATOR + name)
+	}
+	return ""
+}
+
+// findApiAction looks up the action backing the API operation (relPath, verb)
+func findApiAction(apis []whisk.ApiItem, relPath string, verb string) (string, bool) {
+	for _, item := range apis {
+		if item.ApiValue == nil || item.ApiValue.Swagger == nil {
+			continue
+		}
+		if path, ok := item.ApiValue.Swagger.Paths[relPath]; ok && path != nil {
+			if op, ok := path.MakeOperationMap()[strings.ToLower(verb)]; ok {
+				if op.XOpenWhisk == nil {
+					return "", true
+				}
+				if len(op.XOpenWhisk.Package) == 0 || op.XOpenWhisk.Package == parsers.DEFAULT_PACKAGE {
+					return op.XOpenWhisk.ActionName, true
+				}
+				return op.XOpenWhisk.Package + parsers.PATH_SEPARATOR + op.XOpenWhisk.ActionName, true
+			}
+		}
+	}
+	return "", false
+}
+
+func remoteActionKind(action *whisk.Action) string {
+	if action.Exec != nil && action.Exec.Kind == parsers.YAML_KEY_SEQUENCE {
+		return parsers.YAML_KEY_SEQUENCE
+	}
+	if kind := action.Annotations.GetValue("exec"); kind == parsers.YAML_KEY_SEQUENCE {
+		return parsers.YAML_KEY_SEQUENCE
+	}
+	return parsers.YAML_KEY_ACTION
+}
+
+// remoteActionName returns pkg/action for actions listed across the whole namespace
+func remoteActionName(action *whisk.Action) string {
+	parts := strings.SplitN(action.Namespace, parsers.PATH_SEPARATOR, 2)
+	if len(parts) == 2 {
+		return parts[1] + parsers.PATH_SEPARATOR + action.Name
+	}
+	return action.Name
+}
+
+func planKey(kind string, name string) string {
+	return kind + ":" + name
+}
+
+func isNotFound(response *http.Response) bool {
+	return response != nil && response.StatusCode == http.StatusNotFound
+}
+
+func planLookupError(err error, response *http.Response) error {
+	if wskErr, ok := err.(*whisk.WskError); ok {
+		return wskderrors.NewWhiskClientError(wskErr.Error(), wskErr.ExitCode, response)
+	}
+	return err
+}
+
+func sortedPackageNames(packages map[string]*DeploymentPackage) []string {
+	names := make([]string, 0, len(packages))
+	for name := range packages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedActionNames(records map[string]utils.ActionRecord) []string {
+	names := make([]string, 0, len(records))
+	for name := range records {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedTriggerNames(triggers map[string]*whisk.Trigger) []string {
+	names := make([]string, 0, len(triggers))
+	for name := range triggers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedRuleNames(rules map[string]*whisk.Rule) []string {
+	names := make([]string, 0, len(rules))
+	for name := range rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func printServerPlan(plan *ServerPlan) {
+	wskprint.PrintlnOpenWhiskOutput(wski18n.T(wski18n.ID_MSG_PLAN_HEADER))
+	for _, entry := range plan.Entries {
+		wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("%-10s %s: %s", entry.Change, entry.Kind, entry.Name))
+		for _, diff := range entry.Diffs {
+			wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("    - %s: %s => %s", diff.Field, diff.Deployed, diff.Planned))
+		}
+	}
+	wskprint.PrintlnOpenWhiskOutput(wski18n.T(wski18n.ID_MSG_PLAN_SUMMARY_X_create_X_update_X_unchanged_X_orphaned_X,
+		map[string]interface{}{
+			wski18n.KEY_CREATE:    plan.Count(PLAN_CREATE),
+			wski18n.KEY_UPDATE:    plan.Count(PLAN_UPDATE),
+			wski18n.KEY_UNCHANGED: plan.Count(PLAN_UNCHANGED),
+			wski18n.KEY_ORPHANED:  plan.Count(PLAN_ORPHANED)}))
+}
@@ -0,0 +1,133 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPlanTestAction(code string, timeout int) *whisk.Action {
+	return &whisk.Action{
+		Name:        "hello",
+		Exec:        &whisk.Exec{Kind: "nodejs:10", Code: &code},
+		Limits:      &whisk.Limits{Timeout: &timeout},
+		Parameters:  whisk.KeyValueArr{{Key: "name", Value: "Amy"}, {Key: "count", Value: 1}},
+		Annotations: whisk.KeyValueArr{{Key: "web-export", Value: true}},
+	}
+}
+
+func TestPlan_DiffActionUnchanged(t *testing.T) {
+	local := newPlanTestAction("function main() {}", 60000)
+	remote := newPlanTestAction("function main() {}", 60000)
+	// values returned by the server are decoded from JSON
+	remote.Parameters = whisk.KeyValueArr{{Key: "count", Value: float64(1)}, {Key: "name", Value: "Amy"}, {Key: "inherited", Value: "x"}}
+	remote.Annotations = append(remote.Annotations, whisk.KeyValue{Key: "exec", Value: "nodejs:10"})
+	remotePkg := &whisk.Package{Parameters: whisk.KeyValueArr{{Key: "inherited", Value: "x"}}}
+
+	diffs := diffAction(local, remote, remotePkg)
+	assert.Empty(t, diffs, "Expected no differences but got %v", diffs)
+}
+
+func TestPlan_DiffActionChanged(t *testing.T) {
+	local := newPlanTestAction("function main() { return {}; }", 300000)
+	local.Annotations = whisk.KeyValueArr{{Key: "web-export", Value: false}}
+	remote := newPlanTestAction("function main() {}", 60000)
+	remote.Parameters = whisk.KeyValueArr{{Key: "name", Value: "Bob"}, {Key: "count", Value: float64(1)}, {Key: "old", Value: "y"}}
+
+	diffs := diffAction(local, remote, nil)
+	fields := make(map[string]PlanFieldDiff)
+	for _, d := range diffs {
+		fields[d.Field] = d
+	}
+
+	assert.Contains(t, fields, PLAN_FIELD_CODE)
+	assert.Equal(t, "60000", fields["limits.timeout"].Deployed)
+	assert.Equal(t, "300000", fields["limits.timeout"].Planned)
+	assert.Equal(t, "\"Bob\"", fields["parameters.name"].Deployed)
+	assert.Equal(t, "\"Amy\"", fields["parameters.name"].Planned)
+	assert.Equal(t, "", fields["parameters.old"].Planned)
+	assert.Equal(t, "false", fields["annotations.web-export"].Planned)
+	assert.NotContains(t, fields, "parameters.count")
+	assert.Equal(t, 5, len(diffs))
+}
+
+func TestPlan_DiffSequenceComponents(t *testing.T) {
+	local := &whisk.Action{Exec: &whisk.Exec{Kind: "sequence", Components: []string{"/_/pkg/a", "/_/pkg/b"}}}
+	remote := &whisk.Action{Exec: &whisk.Exec{Kind: "sequence", Components: []string{"/guest/pkg/a", "/guest/pkg/b"}}}
+	assert.Empty(t, diffAction(local, remote, nil))
+
+	remote.Exec.Components = []string{"/guest/pkg/a"}
+	diffs := diffAction(local, remote, nil)
+	assert.Equal(t, 1, len(diffs))
+	assert.Equal(t, PLAN_FIELD_COMPONENTS, diffs[0].Field)
+	assert.Equal(t, "pkg/a,pkg/b", diffs[0].Planned)
+}
+
+func TestPlan_DiffRule(t *testing.T) {
+	local := &whisk.Rule{Name: "r", Trigger: "t", Action: "pkg/a"}
+	remote := &whisk.Rule{
+		Name:    "r",
+		Trigger: map[string]interface{}{"path": "guest", "name": "t"},
+		Action:  map[string]interface{}{"path": "guest/pkg", "name": "a"},
+		Status:  "active",
+	}
+	assert.Empty(t, diffRule(local, remote))
+
+	local.Action = "pkg/b"
+	diffs := diffRule(local, remote)
+	assert.Equal(t, 1, len(diffs))
+	assert.Equal(t, PlanFieldDiff{Field: PLAN_FIELD_ACTION, Deployed: "pkg/a", Planned: "pkg/b"}, diffs[0])
+
+	local.Action = "pkg/a"
+	local.Status = "inactive"
+	diffs = diffRule(local, remote)
+	assert.Equal(t, []PlanFieldDiff{{Field: PLAN_FIELD_STATUS, Deployed: "active", Planned: "inactive"}}, diffs)
+}
+
+func TestPlan_DiffTriggerWithFeed(t *testing.T) {
+	local := &whisk.Trigger{
+		Name:        "t",
+		Parameters:  whisk.KeyValueArr{{Key: "cron", Value: "* * * * *"}},
+		Annotations: whisk.KeyValueArr{{Key: "feed", Value: "/whisk.system/alarms/alarm"}},
+	}
+	remote := &whisk.Trigger{
+		Name:        "t",
+		Annotations: whisk.KeyValueArr{{Key: "feed", Value: "/whisk.system/alarms/alarm"}},
+	}
+	assert.Empty(t, diffTrigger(local, remote))
+}
+
+func TestPlan_Count(t *testing.T) {
+	plan := new(ServerPlan)
+	plan.add("action", "a", nil, false)
+	plan.add("action", "b", []PlanFieldDiff{{Field: PLAN_FIELD_CODE}}, true)
+	plan.add("action", "c", nil, true)
+	plan.addOrphan("action", "d")
+
+	assert.Equal(t, PLAN_CREATE, plan.Entries[0].Change)
+	assert.Equal(t, PLAN_UPDATE, plan.Entries[1].Change)
+	assert.Equal(t, PLAN_UNCHANGED, plan.Entries[2].Change)
+	assert.Equal(t, PLAN_ORPHANED, plan.Entries[3].Change)
+	assert.Equal(t, 1, plan.Count(PLAN_CREATE))
+	assert.Equal(t, 1, plan.Count(PLAN_ORPHANED))
+}
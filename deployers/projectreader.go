@@ -20,6 +20,7 @@ package deployers
 import (
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/apache/openwhisk-client-go/whisk"
 	"github.com/apache/openwhisk-wskdeploy/parsers"
@@ -46,13 +47,14 @@ func (deployer *ServiceDeployer) UpdatePackageInputs() error {
 
 	if paramsCLI != nil {
 		// iterate over each package to update its set of inputs with CLI
-		for _, pkg := range deployer.Deployment.Packages {
+		for pkgName, pkg := range deployer.Deployment.Packages {
 			// iterate over each input of type Parameter
 			for name, param := range pkg.Inputs.Inputs {
 				inputValue := param.Value
 				// check if this particular input is specified on CLI
 				if v, ok := paramsCLI.(map[string]interface{})[name]; ok {
 					inputValue = wskenv.InterpolateStringWithEnvVar(v)
+					deployer.setInputSource(parsers.YAML_KEY_PACKAGE, pkgName, name, wski18n.COMMAND_LINE)
 				}
 				param.Value = inputValue
 				pkg.Inputs.Inputs[name] = param
@@ -98,6 +100,7 @@ func (deployer *ServiceDeployer) UnDeployProjectAssets() error {
 
 	// calculate all the project entities such as packages, actions, sequences,
 	// triggers, and rules based on the project name in "whisk-managed" annotation
+	deployer.ProjectName = utils.Flags.ProjectName
 	deployer.SetProjectAssets(utils.Flags.ProjectName)
 	// calculate all the dependencies based on the project name
 	projectDeps, err := deployer.SetProjectDependencies(utils.Flags.ProjectName)
@@ -105,8 +108,21 @@ func (deployer *ServiceDeployer) UnDeployProjectAssets() error {
 		return err
 	}
 
+	// restrict the undeployment to the selected entities, dependencies are then left alone
+	if deployer.IsSelective() {
+		if err := deployer.selectEntities(true); err != nil {
+			return err
+		}
+		projectDeps = nil
+	}
+
 	// show preview of which all OpenWhisk entities will be deployed
 	if utils.Flags.Preview {
+		if wskprint.IsStructuredOutput() {
+			result := deployer.previewResult(wski18n.CMD_UNDEPLOY, deployer.Deployment)
+			result.Dependencies = projectDeps
+			return wskprint.PrintOpenWhiskDocument(result)
+		}
 		deployer.printDeploymentAssets(deployer.Deployment)
 		for _, deps := range projectDeps {
 			deployer.printDeploymentAssets(deps)
@@ -116,16 +132,20 @@ func (deployer *ServiceDeployer) UnDeployProjectAssets() error {
 
 	// now, undeploy all those project dependencies if not used by
 	// any other project or packages
+	start := time.Now()
 	for _, deps := range projectDeps {
 		if err := deployer.unDeployAssets(deps); err != nil {
+			deployer.printDeploymentResult(wski18n.CMD_UNDEPLOY, start, err)
 			return err
 		}
 	}
 
 	// undeploy all the project entities
-	return deployer.unDeployAssets(deployer.Deployment)
-
-	return nil
+	err = deployer.unDeployAssets(deployer.Deployment)
+	if printErr := deployer.printDeploymentResult(wski18n.CMD_UNDEPLOY, start, err); err == nil {
+		err = printErr
+	}
+	return err
 }
 
 // based on the project name set in "whisk-managed" annotation
@@ -171,12 +191,12 @@ func (deployer *ServiceDeployer) getPackage(packageName string) (*DeploymentPack
 	var err error
 	var p *whisk.Package
 	var response *http.Response
-	err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
+	err = retry(deployer.Retry, func() (*http.Response, error) {
 		p, response, err = deployer.Client.Packages.Get(packageName)
-		return err
+		return response, err
 	})
 	if err != nil {
-		return nil, createWhiskClientError(err.(*whisk.WskError), response, parsers.YAML_KEY_PACKAGE, false)
+		return nil, createWhiskClientError(err, response, parsers.YAML_KEY_PACKAGE, false)
 	}
 	newPack := NewDeploymentPackage()
 	newPack.Package = p
@@ -217,12 +237,12 @@ func (deployer *ServiceDeployer) getPackageActionsAndSequences(packageName strin
 		if deployer.isManagedEntity(action.Annotations.GetValue(utils.MANAGED), projectName) {
 			var a *whisk.Action
 			var response *http.Response
-			err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
+			err = retry(deployer.Retry, func() (*http.Response, error) {
 				a, response, err = deployer.Client.Actions.Get(packageName+parsers.PATH_SEPARATOR+action.Name, false)
-				return err
+				return response, err
 			})
 			if err != nil {
-				return listOfActions, listOfSequences, createWhiskClientError(err.(*whisk.WskError), response, parsers.YAML_KEY_ACTION, false)
+				return listOfActions, listOfSequences, createWhiskClientError(err, response, parsers.YAML_KEY_ACTION, false)
 			}
 			ar := utils.ActionRecord{Action: a, Packagename: packageName}
 			if a.Exec.Kind == parsers.YAML_KEY_SEQUENCE {
@@ -259,12 +279,12 @@ func (deployer *ServiceDeployer) getProjectTriggers(projectName string) (map[str
 		if deployer.isManagedEntity(trigger.Annotations.GetValue(utils.MANAGED), projectName) {
 			var t *whisk.Trigger
 			var response *http.Response
-			err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
+			err = retry(deployer.Retry, func() (*http.Response, error) {
 				t, response, err = deployer.Client.Triggers.Get(trigger.Name)
-				return err
+				return response, err
 			})
 			if err != nil {
-				return triggers, createWhiskClientError(err.(*whisk.WskError), response, parsers.YAML_KEY_TRIGGER, false)
+				return triggers, createWhiskClientError(err, response, parsers.YAML_KEY_TRIGGER, false)
 			}
 			triggers[trigger.Name] = t
 		}
@@ -293,12 +313,12 @@ func (deployer *ServiceDeployer) getProjectRules(projectName string) (map[string
 		if deployer.isManagedEntity(rule.Annotations.GetValue(utils.MANAGED), projectName) {
 			var r *whisk.Rule
 			var response *http.Response
-			err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
+			err = retry(deployer.Retry, func() (*http.Response, error) {
 				r, response, err = deployer.Client.Rules.Get(rule.Name)
-				return err
+				return response, err
 			})
 			if err != nil {
-				return rules, createWhiskClientError(err.(*whisk.WskError), response, parsers.YAML_KEY_RULE, false)
+				return rules, createWhiskClientError(err, response, parsers.YAML_KEY_RULE, false)
 			}
 			rules[rule.Name] = r
 		}
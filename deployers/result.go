@@ -0,0 +1,239 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"sync"
+	"time"
+
+	"github.com/apache/openwhisk-wskdeploy/parsers"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/apache/openwhisk-wskdeploy/wskprint"
+)
+
+/*
+ * With --output json|yaml, deploy and undeploy print a single document describing
+ * the outcome of every entity instead of the human readable messages:
+ *
+ * {
+ *   "command": "deploy",
+ *   "status": "failed",
+ *   "entities": [
+ *     {"kind": "action", "name": "hello/greet", "operation": "update", "status": "failed",
+ *      "durationMs": 212, "error": {"type": "ERROR_WHISK_CLIENT_ERROR", "code": 40, ...}},
+ *     ...
+ *   ]
+ * }
+ */
+
+const (
+	// operations, the generic deploy and undeploy are used when the entity is not looked up first
+	OPERATION_CREATE    = "create"
+	OPERATION_UPDATE    = "update"
+	OPERATION_UNCHANGED = "unchanged"
+	OPERATION_DELETE    = "delete"
+	OPERATION_DEPLOY    = "deploy"
+	OPERATION_UNDEPLOY  = "undeploy"
+
+	STATUS_SUCCEEDED = "succeeded"
+	STATUS_FAILED    = "failed"
+	STATUS_SKIPPED   = "skipped"
+)
+
+// EntityResult is the outcome of deploying or undeploying one entity
+type EntityResult struct {
+	Kind      string                    `json:"kind"`
+	Name      string                    `json:"name"`
+	Operation string                    `json:"operation"`
+	Status    string                    `json:"status"`
+	Duration  int64                     `json:"durationMs"`
+	Error     *wskderrors.ErrorDocument `json:"error,omitempty"`
+}
+
+// DependencyResult is a remote dependency of the deployment and the commit it resolved to
+type DependencyResult struct {
+	Name     string `json:"name"`
+	Location string `json:"location"`
+	Version  string `json:"version"`
+	Tag      string `json:"tag,omitempty"`
+	Commit   string `json:"commit"`
+}
+
+// DeploymentResult is the outcome of a deploy or undeploy command
+type DeploymentResult struct {
+	Command      string                    `json:"command"`
+	Project      string                    `json:"project,omitempty"`
+	Namespace    string                    `json:"namespace,omitempty"`
+	Status       string                    `json:"status"`
+	Duration     int64                     `json:"durationMs"`
+	Dependencies []DependencyResult        `json:"dependencies,omitempty"`
+	Entities     []EntityResult            `json:"entities"`
+	Error        *wskderrors.ErrorDocument `json:"error,omitempty"`
+}
+
+// PreviewResult is the deployment plan printed by --preview and report
+type PreviewResult struct {
+	Command      string                  `json:"command"`
+	Project      string                  `json:"project,omitempty"`
+	Namespace    string                  `json:"namespace,omitempty"`
+	Deployment   *DeploymentProject      `json:"deployment"`
+	Dependencies []*DeploymentProject    `json:"dependencies,omitempty"`
+	Inputs       []parsers.DisplayInputs `json:"inputs,omitempty"`
+}
+
+// entityResults collects the outcome of the entities of a deployment in the order in
+// which they were planned, entities planned but never run are reported as skipped
+type entityResults struct {
+	lock    sync.Mutex
+	order   []string
+	results map[string]*EntityResult
+}
+
+func newEntityResults() *entityResults {
+	return &entityResults{results: make(map[string]*EntityResult)}
+}
+
+func (r *entityResults) expect(id string, kind string, name string, operation string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if _, ok := r.results[id]; !ok {
+		r.order = append(r.order, id)
+	}
+	r.results[id] = &EntityResult{Kind: kind, Name: name, Operation: operation, Status: STATUS_SKIPPED}
+}
+
+func (r *entityResults) setOperation(id string, operation string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if result, ok := r.results[id]; ok {
+		result.Operation = operation
+	}
+}
+
+func (r *entityResults) finish(id string, duration time.Duration, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	result, ok := r.results[id]
+	if !ok {
+		return
+	}
+	result.Duration = durationMs(duration)
+	result.Status = STATUS_SUCCEEDED
+	if err != nil {
+		result.Status = STATUS_FAILED
+		result.Error = wskderrors.NewErrorDocument(err)
+	}
+}
+
+func (r *entityResults) list() []EntityResult {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	list := make([]EntityResult, 0, len(r.order))
+	for _, id := range r.order {
+		list = append(list, *r.results[id])
+	}
+	return list
+}
+
+// tracked registers an entity of the deployment and returns the task deploying it,
+// which records the operation, duration and error of the task
+func (deployer *ServiceDeployer) tracked(id string, kind string, name string, operation string, run func() error) func() error {
+	deployer.results.expect(id, kind, name, operation)
+	return func() error {
+		start := time.Now()
+		err := run()
+		if err != nil {
+			wskderrors.SetEntity(err, kind+":"+name)
+		}
+		deployer.results.finish(id, time.Since(start), err)
+		return err
+	}
+}
+
+// setOperation records whether deploying an entity creates, updates or leaves it unchanged
+func (deployer *ServiceDeployer) setOperation(id string, operation string) {
+	deployer.results.setOperation(id, operation)
+}
+
+// deploymentResult returns the outcome of the deploy or undeploy command started at start
+func (deployer *ServiceDeployer) deploymentResult(command string, start time.Time, err error) DeploymentResult {
+	result := DeploymentResult{
+		Command:  command,
+		Project:  deployer.ProjectName,
+		Status:   STATUS_SUCCEEDED,
+		Duration: durationMs(time.Since(start)),
+		Entities: deployer.results.list(),
+	}
+	if deployer.ClientConfig != nil {
+		result.Namespace = deployer.ClientConfig.Namespace
+	}
+	if deployer.DependencyGraph != nil {
+		for _, name := range utils.SortedKeys(deployer.DependencyGraph.Nodes) {
+			node := deployer.DependencyGraph.Nodes[name]
+			if len(node.Commit) != 0 {
+				result.Dependencies = append(result.Dependencies, DependencyResult{
+					Name:     node.Name,
+					Location: node.Record.Location,
+					Version:  node.Record.Version,
+					Tag:      node.Tag,
+					Commit:   node.Commit,
+				})
+			}
+		}
+	}
+	if err != nil {
+		result.Status = STATUS_FAILED
+		result.Error = wskderrors.NewErrorDocument(err)
+	}
+	return result
+}
+
+// printDeploymentResult prints the outcome of the deploy or undeploy command when a json
+// or yaml output format was selected
+func (deployer *ServiceDeployer) printDeploymentResult(command string, start time.Time, err error) error {
+	if !wskprint.IsStructuredOutput() {
+		return nil
+	}
+	return wskprint.PrintOpenWhiskDocument(deployer.deploymentResult(command, start, err))
+}
+
+// previewResult returns the deployment plan of the preview or report command
+func (deployer *ServiceDeployer) previewResult(command string, assets *DeploymentProject) PreviewResult {
+	result := PreviewResult{
+		Command:    command,
+		Project:    deployer.ProjectName,
+		Deployment: assets,
+	}
+	if deployer.ClientConfig != nil {
+		result.Namespace = deployer.ClientConfig.Namespace
+	}
+	return result
+}
+
+// deployOperation returns the operation deploying an entity which exists or not
+func deployOperation(exists bool) string {
+	if exists {
+		return OPERATION_UPDATE
+	}
+	return OPERATION_CREATE
+}
+
+func durationMs(duration time.Duration) int64 {
+	return int64(duration / time.Millisecond)
+}
@@ -0,0 +1,129 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntityResults_OrderAndStatus(t *testing.T) {
+	deployer := NewServiceDeployer()
+	pkg := deployer.tracked("package:p", "package", "p", OPERATION_DEPLOY, func() error { return nil })
+	action := deployer.tracked("action:p/a", "action", "p/a", OPERATION_DEPLOY, func() error {
+		return wskderrors.NewYAMLFileFormatError("manifest.yaml", "invalid")
+	})
+	deployer.tracked("rule:r", "rule", "r", OPERATION_DEPLOY, func() error { return nil })
+
+	assert.NotNil(t, action())
+	assert.Nil(t, pkg())
+
+	results := deployer.results.list()
+	assert.Equal(t, 3, len(results))
+	assert.Equal(t, "p", results[0].Name, "Entities are listed in the order they were planned.")
+	assert.Equal(t, STATUS_SUCCEEDED, results[0].Status)
+	assert.Equal(t, STATUS_FAILED, results[1].Status)
+	assert.Equal(t, wskderrors.ERROR_YAML_FILE_FORMAT_ERROR, results[1].Error.Type)
+	assert.Equal(t, wskderrors.EXIT_CODE_YAML_FILE_FORMAT_ERROR, results[1].Error.Code)
+	assert.Equal(t, "manifest.yaml", results[1].Error.File)
+	assert.Equal(t, "action:p/a", results[1].Error.Entity, "The failed entity is recorded on the error.")
+	assert.Equal(t, STATUS_SKIPPED, results[2].Status, "An entity which never ran is skipped.")
+}
+
+func TestDeploymentResult_JSON(t *testing.T) {
+	deployer := NewServiceDeployer()
+	deployer.ProjectName = "demo"
+	deployer.ClientConfig = &whisk.Config{Namespace: "guest"}
+	deployer.tracked("trigger:t", "trigger", "t", OPERATION_DEPLOY, func() error { return nil })()
+
+	result := deployer.deploymentResult("deploy", time.Now(), errors.New("failed\n"))
+	content, err := json.Marshal(result)
+	assert.Nil(t, err)
+
+	var document map[string]interface{}
+	assert.Nil(t, json.Unmarshal(content, &document))
+	assert.Equal(t, "deploy", document["command"])
+	assert.Equal(t, "demo", document["project"])
+	assert.Equal(t, "guest", document["namespace"])
+	assert.Equal(t, STATUS_FAILED, document["status"])
+	documentErr := document["error"].(map[string]interface{})
+	assert.Equal(t, "failed", documentErr["message"])
+	assert.Equal(t, "", documentErr["type"], "Only wskdeploy errors have a type.")
+	assert.Equal(t, float64(wskderrors.EXIT_CODE_UNKNOWN_ERROR), documentErr["code"])
+	assert.NotContains(t, entity(document), "error")
+	assert.Equal(t, "trigger", entity(document)["kind"])
+	assert.Equal(t, STATUS_SUCCEEDED, entity(document)["status"])
+	assert.Contains(t, entity(document), "durationMs")
+}
+
+func entity(document map[string]interface{}) map[string]interface{} {
+	return document["entities"].([]interface{})[0].(map[string]interface{})
+}
+
+func TestEntityResults_CreateActionOperation(t *testing.T) {
+	var mu sync.Mutex
+	var deployed *whisk.Action
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPut {
+			deployed = new(whisk.Action)
+			json.NewDecoder(r.Body).Decode(deployed)
+			json.NewEncoder(w).Encode(deployed)
+			return
+		}
+		if deployed == nil || !strings.HasSuffix(r.URL.Path, "/actions/p/hello") {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"The requested resource does not exist."}`))
+			return
+		}
+		json.NewEncoder(w).Encode(deployed)
+	}))
+	defer server.Close()
+
+	client, err := whisk.NewClient(http.DefaultClient, &whisk.Config{Host: server.URL, AuthToken: "user:pass", Namespace: "guest"})
+	assert.Nil(t, err)
+	deployer := NewServiceDeployer()
+	deployer.Client = client
+	deployer.ClientConfig = client.Config
+
+	deploy := func(code string) string {
+		action := newDigestTestAction(code, "1111")
+		run := deployer.tracked("action:p/hello", "action", "p/hello", OPERATION_DEPLOY,
+			func() error { return deployer.createAction("p", action) })
+		assert.Nil(t, run())
+		return deployer.results.list()[0].Operation
+	}
+
+	assert.Equal(t, OPERATION_CREATE, deploy("function main() {}"))
+	assert.Equal(t, OPERATION_UNCHANGED, deploy("function main() {}"))
+	assert.Equal(t, OPERATION_UPDATE, deploy("function main() { return {}; }"))
+}
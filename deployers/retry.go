@@ -0,0 +1,249 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"math/rand"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+	"github.com/apache/openwhisk-wskdeploy/wskprint"
+)
+
+const (
+	DEFAULT_ATTEMPTS  = 3
+	DEFAULT_INTERVAL  = 1 * time.Second
+	DEFAULT_MAX_DELAY = 30 * time.Second
+
+	// .wskprops properties configuring the retry policy
+	PROP_RETRY_ATTEMPTS  = "RETRY_ATTEMPTS"
+	PROP_RETRY_DELAY     = "RETRY_DELAY"
+	PROP_RETRY_MAX_DELAY = "RETRY_MAX_DELAY"
+
+	HEADER_RETRY_AFTER = "Retry-After"
+)
+
+// HTTP status codes of the responses worth retrying, the OpenWhisk client reports
+// them as the exit code of its errors minus 256
+var retryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy tells how often and for how long a failed server call is retried.
+// The delay before the n-th retry is BaseDelay * 2^(n-1), bounded by MaxDelay, of which
+// a random half is added as jitter so that concurrent deployments do not retry in lockstep.
+// A Retry-After header sent by the server is waited for if it asks for a longer delay, up to MaxDelay.
+type RetryPolicy struct {
+	Attempts  int           // number of attempts, including the first one
+	BaseDelay time.Duration // delay before the first retry
+	MaxDelay  time.Duration // maximum delay between two attempts
+}
+
+var DefaultRetryPolicy = RetryPolicy{Attempts: DEFAULT_ATTEMPTS, BaseDelay: DEFAULT_INTERVAL, MaxDelay: DEFAULT_MAX_DELAY}
+
+// sleep and jitter are replaced by tests
+var sleep = time.Sleep
+var jitter = func(max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(max) + 1))
+}
+
+// NewRetryPolicy returns the retry policy set by the --retry-attempts, --retry-delay and
+// --retry-max-delay flags, or else by the RETRY_ATTEMPTS, RETRY_DELAY and RETRY_MAX_DELAY
+// properties of the .wskprops file at proppath ($HOME/.wskprops by default), or else
+// DefaultRetryPolicy. Unless it is set, the maximum delay is at least the base delay.
+func NewRetryPolicy(proppath string) (RetryPolicy, error) {
+	policy := DefaultRetryPolicy
+	if len(proppath) == 0 {
+		proppath = filepath.Join(os.Getenv("HOME"), whisk.DEFAULT_LOCAL_CONFIG)
+	}
+	props, _ := whisk.ReadProps(proppath)
+
+	if value, ok := props[PROP_RETRY_ATTEMPTS]; ok {
+		attempts, err := strconv.Atoi(value)
+		if err != nil {
+			return policy, invalidRetryPolicyError(PROP_RETRY_ATTEMPTS, value)
+		}
+		policy.Attempts = attempts
+	}
+	for key, delay := range map[string]*time.Duration{PROP_RETRY_DELAY: &policy.BaseDelay, PROP_RETRY_MAX_DELAY: &policy.MaxDelay} {
+		if value, ok := props[key]; ok {
+			d, err := time.ParseDuration(value)
+			if err != nil {
+				return policy, invalidRetryPolicyError(key, value)
+			}
+			*delay = d
+		}
+	}
+
+	if utils.Flags.RetryAttempts != nil {
+		policy.Attempts = *utils.Flags.RetryAttempts
+	}
+	if utils.Flags.RetryDelay != nil {
+		policy.BaseDelay = *utils.Flags.RetryDelay
+	}
+	if utils.Flags.RetryMaxDelay != nil {
+		policy.MaxDelay = *utils.Flags.RetryMaxDelay
+	}
+	if _, ok := props[PROP_RETRY_MAX_DELAY]; !ok && utils.Flags.RetryMaxDelay == nil && policy.MaxDelay < policy.BaseDelay {
+		policy.MaxDelay = policy.BaseDelay
+	}
+
+	switch {
+	case policy.Attempts < 1:
+		return policy, invalidRetryPolicyError(PROP_RETRY_ATTEMPTS, strconv.Itoa(policy.Attempts))
+	case policy.BaseDelay < 0:
+		return policy, invalidRetryPolicyError(PROP_RETRY_DELAY, policy.BaseDelay.String())
+	case policy.MaxDelay < policy.BaseDelay:
+		return policy, invalidRetryPolicyError(PROP_RETRY_MAX_DELAY, policy.MaxDelay.String())
+	}
+	return policy, nil
+}
+
+func invalidRetryPolicyError(key string, value string) error {
+	return wskderrors.NewWhiskClientInvalidConfigError(wski18n.T(wski18n.ID_ERR_RETRY_POLICY_INVALID_X_key_X_value_X,
+		map[string]interface{}{wski18n.KEY_KEY: key, wski18n.KEY_VALUE: value}))
+}
+
+// delay returns how long to wait before the given retry, 1 for the first one
+func (policy RetryPolicy) delay(retry int, response *http.Response) time.Duration {
+	delay := policy.BaseDelay
+	for i := 1; i < retry && delay < policy.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+	delay = delay/2 + jitter(delay-delay/2)
+	if after, ok := retryAfter(response); ok && after > delay {
+		delay = after
+	}
+	if delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+	return delay
+}
+
+// retryAfter reads the Retry-After header of a response, either a number of seconds or a date
+func retryAfter(response *http.Response) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+	value := strings.TrimSpace(response.Header.Get(HEADER_RETRY_AFTER))
+	if len(value) == 0 {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date), true
+	}
+	return 0, false
+}
+
+// isRetryable tests if a server call failed with a transient error: a concurrent modification
+// of the entity, throttling (429), an unavailable server or gateway (502, 503, 504) or a
+// network timeout
+func isRetryable(response *http.Response, err error) bool {
+	if wskErr, ok := err.(*whisk.WskError); ok {
+		if wskErr.ExitCode == CONFLICT_CODE && strings.Contains(wskErr.Error(), CONFLICT_MESSAGE) {
+			return true
+		}
+	}
+	status := statusCode(response, err)
+	for _, code := range retryableStatusCodes {
+		if status == code {
+			return true
+		}
+	}
+	if wskErr, ok := err.(*whisk.WskError); ok {
+		err = wskErr.RootErr
+	}
+	netErr, ok := err.(net.Error)
+	return ok && netErr.Timeout()
+}
+
+// isRetryableInvocation tests if an invocation failed with a transient error after which the
+// action did not run: throttling (429) or an unavailable server (503). Gateway errors (502, 504)
+// and network timeouts may be reported once the action ran, invocations of actions which must
+// not run twice, such as feed actions, are not retried on them.
+func isRetryableInvocation(response *http.Response, err error) bool {
+	status := statusCode(response, err)
+	return status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable
+}
+
+// statusCode returns the HTTP status code of a failed server call, derived from the exit code
+// of the error of the OpenWhisk client when there is no response, or 0 if there is none
+func statusCode(response *http.Response, err error) int {
+	if response != nil && response.StatusCode != 0 {
+		return response.StatusCode
+	}
+	if wskErr, ok := err.(*whisk.WskError); ok {
+		return wskErr.ExitCode + 256
+	}
+	return 0
+}
+
+// whiskErrorCode returns the exit code of an error of the OpenWhisk client
+func whiskErrorCode(err error) int {
+	if wskErr, ok := err.(*whisk.WskError); ok {
+		return wskErr.ExitCode
+	}
+	return whisk.EXIT_CODE_ERR_GENERAL
+}
+
+// retry calls callback until it succeeds, fails with an error which is not transient or
+// the attempts of the policy are exhausted; the error of the last attempt is returned.
+// callback returns the response of the server, if any, which may carry a Retry-After header.
+func retry(policy RetryPolicy, callback func() (*http.Response, error)) error {
+	return retryIf(policy, isRetryable, callback)
+}
+
+// retryIf calls callback like retry, retrying the errors for which retryable holds
+func retryIf(policy RetryPolicy, retryable func(*http.Response, error) bool, callback func() (*http.Response, error)) error {
+	for attempt := 1; ; attempt++ {
+		response, err := callback()
+		if err == nil || attempt >= policy.Attempts || !retryable(response, err) {
+			return err
+		}
+		delay := policy.delay(attempt, response)
+		warningMsg := wski18n.T(wski18n.ID_WARN_RETRY_X_attempt_X_attempts_X_delay_X_err_X,
+			map[string]interface{}{
+				wski18n.KEY_ATTEMPT:  attempt + 1,
+				wski18n.KEY_ATTEMPTS: policy.Attempts,
+				wski18n.KEY_DELAY:    delay.Round(time.Millisecond).String(),
+				wski18n.KEY_ERR:      strings.TrimSpace(err.Error())})
+		wskprint.PrintlnOpenWhiskWarning(warningMsg)
+		sleep(delay)
+	}
+}
@@ -0,0 +1,216 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package deployers
+
+import (
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubSleep records the delays retry() waits for instead of sleeping, jitter is always maximal
+func stubSleep(t *testing.T) *[]time.Duration {
+	var delays []time.Duration
+	oldSleep, oldJitter := sleep, jitter
+	sleep = func(d time.Duration) { delays = append(delays, d) }
+	jitter = func(max time.Duration) time.Duration { return max }
+	t.Cleanup(func() { sleep, jitter = oldSleep, oldJitter })
+	return &delays
+}
+
+// httpError returns the error and the response of the OpenWhisk client for an HTTP status
+func httpError(status int, header http.Header) (*http.Response, error) {
+	return &http.Response{StatusCode: status, Header: header},
+		whisk.MakeWskError(errors.New(http.StatusText(status)), status-256)
+}
+
+func TestRetry_TransientErrors(t *testing.T) {
+	policy := RetryPolicy{Attempts: 5, BaseDelay: time.Second, MaxDelay: 3 * time.Second}
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		delays := stubSleep(t)
+		calls := 0
+		err := retry(policy, func() (*http.Response, error) {
+			calls++
+			if calls < 4 {
+				return httpError(status, nil)
+			}
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 4, calls)
+		assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 3 * time.Second}, *delays,
+			"The delay doubles on every retry up to the maximum delay.")
+	}
+}
+
+func TestRetry_Exhausted(t *testing.T) {
+	delays := stubSleep(t)
+	calls := 0
+	err := retry(RetryPolicy{Attempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}, func() (*http.Response, error) {
+		calls++
+		return httpError(http.StatusServiceUnavailable, nil)
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, 2, len(*delays))
+}
+
+func TestRetry_PermanentErrors(t *testing.T) {
+	stubSleep(t)
+	for _, failure := range []func() (*http.Response, error){
+		func() (*http.Response, error) { return httpError(http.StatusBadRequest, nil) },
+		func() (*http.Response, error) { return httpError(http.StatusNotFound, nil) },
+		func() (*http.Response, error) { return nil, errors.New("not a client error") },
+		func() (*http.Response, error) { return nil, wskderrors.NewWhiskClientInvalidConfigError("no auth") },
+	} {
+		calls := 0
+		err := retry(DefaultRetryPolicy, func() (*http.Response, error) {
+			calls++
+			return failure()
+		})
+		assert.NotNil(t, err)
+		assert.Equal(t, 1, calls, "Permanent errors are not retried.")
+	}
+}
+
+func TestRetry_RetryAfter(t *testing.T) {
+	delays := stubSleep(t)
+	calls := 0
+	policy := RetryPolicy{Attempts: 3, BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+	err := retry(policy, func() (*http.Response, error) {
+		calls++
+		switch calls {
+		case 1:
+			return httpError(http.StatusTooManyRequests, http.Header{HEADER_RETRY_AFTER: []string{"7"}})
+		case 2:
+			return httpError(http.StatusServiceUnavailable, http.Header{HEADER_RETRY_AFTER: []string{"3600"}})
+		}
+		return nil, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []time.Duration{7 * time.Second, 10 * time.Second}, *delays,
+		"Retry-After is honoured up to the maximum delay.")
+
+	date := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	after, ok := retryAfter(&http.Response{Header: http.Header{HEADER_RETRY_AFTER: []string{date}}})
+	assert.True(t, ok)
+	assert.True(t, after > 59*time.Minute)
+}
+
+func TestIsRetryable(t *testing.T) {
+	timeout := &net.DNSError{Err: "i/o timeout", IsTimeout: true}
+	assert.True(t, isRetryable(nil, whisk.MakeWskError(timeout, whisk.EXIT_CODE_ERR_NETWORK)))
+	assert.True(t, isRetryable(nil, timeout))
+	assert.False(t, isRetryable(nil, whisk.MakeWskError(&net.DNSError{Err: "no such host"}, whisk.EXIT_CODE_ERR_NETWORK)))
+	assert.True(t, isRetryable(nil, whisk.MakeWskError(errors.New(CONFLICT_MESSAGE), CONFLICT_CODE)))
+	assert.True(t, isRetryable(nil, whisk.MakeWskError(errors.New("throttled"), http.StatusTooManyRequests-256)),
+		"The status is derived from the exit code of the client when there is no response.")
+}
+
+func TestRetryIf_Invocations(t *testing.T) {
+	stubSleep(t)
+	policy := RetryPolicy{Attempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	for status, expected := range map[int]int{
+		http.StatusTooManyRequests:    3,
+		http.StatusServiceUnavailable: 3,
+		http.StatusBadGateway:         1,
+		http.StatusGatewayTimeout:     1,
+	} {
+		calls := 0
+		err := retryIf(policy, isRetryableInvocation, func() (*http.Response, error) {
+			calls++
+			return httpError(status, nil)
+		})
+		assert.NotNil(t, err)
+		assert.Equal(t, expected, calls, http.StatusText(status))
+	}
+	timeout := &net.DNSError{Err: "i/o timeout", IsTimeout: true}
+	assert.False(t, isRetryableInvocation(nil, whisk.MakeWskError(timeout, whisk.EXIT_CODE_ERR_NETWORK)),
+		"The action may have run when the invocation timed out.")
+}
+
+func TestNewRetryPolicy(t *testing.T) {
+	defer func() { utils.Flags.RetryAttempts, utils.Flags.RetryDelay, utils.Flags.RetryMaxDelay = nil, nil, nil }()
+	dir, err := ioutil.TempDir("", "wskprops")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	props := filepath.Join(dir, ".wskprops")
+
+	policy, err := NewRetryPolicy(props)
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultRetryPolicy, policy)
+
+	assert.Nil(t, ioutil.WriteFile(props, []byte("APIHOST=localhost\nRETRY_ATTEMPTS=6\nRETRY_DELAY=250ms\n"), 0600))
+	policy, err = NewRetryPolicy(props)
+	assert.Nil(t, err)
+	assert.Equal(t, RetryPolicy{Attempts: 6, BaseDelay: 250 * time.Millisecond, MaxDelay: DEFAULT_MAX_DELAY}, policy)
+
+	attempts, maxDelay := 2, 5*time.Second
+	utils.Flags.RetryAttempts = &attempts
+	utils.Flags.RetryMaxDelay = &maxDelay
+	policy, err = NewRetryPolicy(props)
+	assert.Nil(t, err)
+	assert.Equal(t, RetryPolicy{Attempts: 2, BaseDelay: 250 * time.Millisecond, MaxDelay: 5 * time.Second}, policy,
+		"Flags take precedence over .wskprops.")
+
+	var noDelay time.Duration
+	utils.Flags.RetryDelay = &noDelay
+	policy, err = NewRetryPolicy(props)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Duration(0), policy.BaseDelay, "An explicit zero flag takes precedence over .wskprops.")
+
+	utils.Flags.RetryAttempts, utils.Flags.RetryMaxDelay = nil, nil
+	longDelay := time.Minute
+	utils.Flags.RetryDelay = &longDelay
+	policy, err = NewRetryPolicy(props)
+	assert.Nil(t, err, "A delay above the default maximum delay is valid when no maximum delay is set.")
+	assert.Equal(t, RetryPolicy{Attempts: 6, BaseDelay: time.Minute, MaxDelay: time.Minute}, policy)
+
+	utils.Flags.RetryAttempts, utils.Flags.RetryMaxDelay = &attempts, &maxDelay
+	_, err = NewRetryPolicy(props)
+	assert.Equal(t, wskderrors.ERROR_WHISK_CLIENT_INVALID_CONFIG, wskderrors.GetErrorType(err),
+		"A delay above the maximum delay set is invalid.")
+
+	utils.Flags.RetryDelay = &noDelay
+	attempts = -1
+	_, err = NewRetryPolicy(props)
+	assert.Equal(t, wskderrors.ERROR_WHISK_CLIENT_INVALID_CONFIG, wskderrors.GetErrorType(err))
+
+	attempts = 0
+	_, err = NewRetryPolicy(props)
+	assert.Equal(t, wskderrors.ERROR_WHISK_CLIENT_INVALID_CONFIG, wskderrors.GetErrorType(err),
+		"An explicit zero number of attempts is invalid.")
+
+	utils.Flags.RetryAttempts, utils.Flags.RetryDelay, utils.Flags.RetryMaxDelay = nil, nil, nil
+	assert.Nil(t, ioutil.WriteFile(props, []byte("RETRY_DELAY=soon\n"), 0600))
+	_, err = NewRetryPolicy(props)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,535 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/dependencies"
+	"github.com/apache/openwhisk-wskdeploy/parsers"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+	"github.com/apache/openwhisk-wskdeploy/wskprint"
+)
+
+// entitySnapshot is the state of an entity on the server before deployment
+type entitySnapshot struct {
+	task      string           // id of the deployment task touching this entity
+	owner     *ServiceDeployer // deployer of the entity, dependencies have their own
+	kind      string
+	name      string
+	existed   bool
+	feed      string
+	digest    string // digest of the entity in the deployment plan
+	unchanged bool   // the deployment leaves the entity as it is, see isLeftUnchanged
+	orphan    bool   // removed from the project, deleted by a managed deployment
+
+	// feed of the trigger on the server, its configuration and status as read from the feed
+	deployedFeed string
+	feedConfig   map[string]interface{}
+	feedActive   bool
+
+	pkg     *whisk.Package
+	action  *whisk.Action
+	trigger *whisk.Trigger
+	rule    *whisk.Rule
+	api     *whisk.ApiCreateRequest
+	apiDoc  *whisk.ApiSwagger
+
+	// trigger as defined in the manifest, needed to delete a newly created feed
+	localTrigger *whisk.Trigger
+}
+
+// deploymentSnapshot holds the prior state of every entity a deployment will touch
+type deploymentSnapshot struct {
+	entities []*entitySnapshot
+}
+
+// rollback order, dependents are deleted before what they depend on and restored after
+var rollbackOrder = map[string]int{
+	parsers.YAML_KEY_RULE:     0,
+	parsers.YAML_KEY_API:      1,
+	parsers.YAML_KEY_TRIGGER:  2,
+	parsers.YAML_KEY_SEQUENCE: 3,
+	parsers.YAML_KEY_ACTION:   4,
+	parsers.YAML_KEY_PACKAGE:  5,
+}
+
+// takeSnapshot fetches the server side state of every package, action, sequence,
+// trigger, rule and API of the deployment plan and of its dependencies, as well as of
+// the entities a managed deployment deletes as they were removed from the project.
+func (deployer *ServiceDeployer) takeSnapshot() (*deploymentSnapshot, error) {
+	snapshot := new(deploymentSnapshot)
+	if err := deployer.collectSnapshot(snapshot, make(map[string]bool)); err != nil {
+		return nil, err
+	}
+	if (utils.Flags.Managed || utils.Flags.Sync) && !deployer.IsSelective() {
+		if err := deployer.collectManagedOrphans(snapshot); err != nil {
+			return nil, err
+		}
+	}
+
+	// fetch the prior state of all entities using the same pool of workers used to deploy them
+	g := newDeployGraph()
+	for _, entity := range snapshot.entities {
+		e := entity
+		if e.owner == nil {
+			e.owner = deployer
+		}
+		g.add(e.kind+":"+e.name, func() error { return e.owner.fetchSnapshot(e) })
+	}
+	if err := g.run(deployer.Parallelism); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// collectSnapshot adds the entities of the deployment plan to the snapshot, along with the
+// entities of its dependencies: package bindings, e.g. /whisk.system/utils, and the entities
+// of the manifest of other dependencies, which are deployed by their own deployer.
+// seen holds the dependencies already collected, a dependency is deployed once.
+func (deployer *ServiceDeployer) collectSnapshot(snapshot *deploymentSnapshot, seen map[string]bool) error {
+	for _, pkgName := range sortedPackageNames(deployer.Deployment.Packages) {
+		pack := deployer.Deployment.Packages[pkgName]
+		isDefault := strings.ToLower(pack.Package.Name) == parsers.DEFAULT_PACKAGE
+		if !isDefault {
+			entity := snapshot.add(taskID(parsers.YAML_KEY_PACKAGE, pack.Package.Name), parsers.YAML_KEY_PACKAGE, pack.Package.Name)
+			entity.digest = packageDigest(pack.Package)
+		}
+		depNames := make([]string, 0, len(pack.Dependencies))
+		for depName := range pack.Dependencies {
+			depNames = append(depNames, depName)
+		}
+		sort.Strings(depNames)
+		for _, depName := range depNames {
+			if depRecord := pack.Dependencies[depName]; depRecord.IsBinding {
+				snapshot.add(TASK_DEPENDENCIES, parsers.YAML_KEY_PACKAGE, depName)
+			} else if !seen[depName] {
+				seen[depName] = true
+				if err := deployer.collectDependencySnapshot(snapshot, seen, depName, depRecord); err != nil {
+					return err
+				}
+			}
+		}
+		for _, kind := range []string{parsers.YAML_KEY_ACTION, parsers.YAML_KEY_SEQUENCE} {
+			records := pack.Actions
+			if kind == parsers.YAML_KEY_SEQUENCE {
+				records = pack.Sequences
+			}
+			for _, name := range sortedActionNames(records) {
+				actionName := records[name].Action.Name
+				if !isDefault {
+					actionName = strings.Join([]string{pack.Package.Name, actionName}, parsers.PATH_SEPARATOR)
+				}
+				entity := snapshot.add(actionTaskID(actionName), kind, actionName)
+				entity.digest = actionDigest(records[name].Action)
+			}
+		}
+	}
+
+	for _, name := range sortedTriggerNames(deployer.Deployment.Triggers) {
+		trigger := deployer.Deployment.Triggers[name]
+		entity := snapshot.add(taskID(parsers.YAML_KEY_TRIGGER, trigger.Name), parsers.YAML_KEY_TRIGGER, trigger.Name)
+		entity.feed, _ = utils.IsFeedAction(trigger)
+		entity.localTrigger = trigger
+		// the feed of a trigger may be updated while the trigger itself is not
+		if len(entity.feed) == 0 {
+			entity.digest = triggerDigest(trigger)
+		}
+	}
+
+	for _, name := range sortedRuleNames(deployer.Deployment.Rules) {
+		rule := deployer.Deployment.Rules[name]
+		snapshot.add(taskID(parsers.YAML_KEY_RULE, rule.Name), parsers.YAML_KEY_RULE, rule.Name)
+	}
+
+	if deployer.Deployment.SwaggerApi != nil && deployer.Deployment.SwaggerApiOptions != nil {
+		entity := snapshot.add(taskID(parsers.YAML_KEY_API, parsers.YAML_KEY_API), parsers.YAML_KEY_API, deployer.Deployment.SwaggerApiOptions.ApiBasePath)
+		entity.api = deployer.Deployment.SwaggerApi
+	} else {
+		for apiPath, api := range deployer.Deployment.Apis {
+			entity := snapshot.add(taskID(parsers.YAML_KEY_API, apiPath), parsers.YAML_KEY_API, apiPath)
+			entity.api = api
+		}
+	}
+	return nil
+}
+
+// collectDependencySnapshot adds the entities of the manifest of a dependency to the snapshot,
+// they are all touched by the deployment of the dependencies, see DeployDependencies
+func (deployer *ServiceDeployer) collectDependencySnapshot(snapshot *deploymentSnapshot, seen map[string]bool, depName string, depRecord dependencies.DependencyRecord) error {
+	depServiceDeployer, err := deployer.getDependentDeployer(depName, depRecord)
+	if err != nil {
+		return err
+	}
+	if err := depServiceDeployer.ConstructDeploymentPlan(); err != nil {
+		return err
+	}
+
+	first := len(snapshot.entities)
+	if err := depServiceDeployer.collectSnapshot(snapshot, seen); err != nil {
+		return err
+	}
+	for _, entity := range snapshot.entities[first:] {
+		entity.task = TASK_DEPENDENCIES
+		if entity.owner == nil {
+			entity.owner = depServiceDeployer
+		}
+	}
+
+	// a dependency named after another package than the one of its manifest is bound to it
+	if _, ok := depServiceDeployer.Deployment.Packages[depName]; !ok {
+		snapshot.add(TASK_DEPENDENCIES, parsers.YAML_KEY_PACKAGE, depName)
+	}
+	return nil
+}
+
+// collectManagedOrphans adds the entities carrying the managed annotation of the project
+// which are not part of the deployment plan, RefreshManagedEntities deletes them
+func (deployer *ServiceDeployer) collectManagedOrphans(snapshot *deploymentSnapshot) error {
+	planned := make(map[string]bool)
+	for _, entity := range snapshot.entities {
+		kind := entity.kind
+		if kind == parsers.YAML_KEY_SEQUENCE {
+			kind = parsers.YAML_KEY_ACTION
+		}
+		planned[planKey(kind, entity.name)] = true
+	}
+
+	plan := new(ServerPlan)
+	if err := deployer.planManagedOrphans(plan, planned); err != nil {
+		return err
+	}
+	for _, orphan := range plan.Entries {
+		entity := snapshot.add(TASK_MANAGED, orphan.Kind, orphan.Name)
+		entity.orphan = true
+	}
+	return nil
+}
+
+func (snapshot *deploymentSnapshot) add(task string, kind string, name string) *entitySnapshot {
+	entity := &entitySnapshot{task: task, kind: kind, name: name}
+	snapshot.entities = append(snapshot.entities, entity)
+	return entity
+}
+
+func (deployer *ServiceDeployer) fetchSnapshot(entity *entitySnapshot) error {
+	var err error
+	switch entity.kind {
+	case parsers.YAML_KEY_PACKAGE:
+		entity.pkg, err = deployer.getRemotePackage(entity.name)
+		entity.existed = entity.pkg != nil
+		entity.unchanged = entity.existed &&
+			deployer.isLeftUnchanged(entity, entity.pkg.Annotations, entity.pkg.Namespace, entity.pkg.Name, entity.pkg.Updated)
+	case parsers.YAML_KEY_ACTION, parsers.YAML_KEY_SEQUENCE:
+		// the code is only downloaded for the actions the deployment is going to change
+		entity.action, err = deployer.lookupRemoteAction(entity.name, false)
+		entity.existed = entity.action != nil
+		entity.unchanged = entity.existed &&
+			deployer.isLeftUnchanged(entity, entity.action.Annotations, entity.action.Namespace, entity.action.Name, entity.action.Updated)
+		if entity.existed && !entity.unchanged {
+			entity.action, err = deployer.getRemoteAction(entity.name)
+			entity.existed = entity.action != nil
+		}
+	case parsers.YAML_KEY_TRIGGER:
+		entity.trigger, err = deployer.getRemoteTrigger(entity.name)
+		entity.existed = entity.trigger != nil
+		if !entity.existed {
+			break
+		}
+		entity.unchanged = deployer.isLeftUnchanged(entity, entity.trigger.Annotations, entity.trigger.Namespace, entity.trigger.Name, entity.trigger.Updated)
+		// the configuration of a feed is not stored with the trigger, the feed is asked for it
+		if feedName, isFeed := utils.IsFeedAction(entity.trigger); isFeed {
+			entity.deployedFeed = feedName
+			entity.feedConfig, entity.feedActive = deployer.readFeed(entity.name, feedName)
+		}
+	case parsers.YAML_KEY_RULE:
+		entity.rule, err = deployer.getRemoteRule(entity.name)
+		entity.existed = entity.rule != nil
+	case parsers.YAML_KEY_API:
+		options := &whisk.ApiGetRequestOptions{ApiBasePath: entity.name}
+		if entity.api.ApiDoc != nil && len(entity.api.ApiDoc.GatewayBasePath) > 0 {
+			options.ApiBasePath = entity.api.ApiDoc.GatewayBasePath
+			options.ApiRelPath = entity.api.ApiDoc.GatewayRelPath
+			options.ApiVerb = entity.api.ApiDoc.GatewayMethod
+		}
+		var apis []whisk.ApiItem
+		apis, err = deployer.getRemoteApis(options)
+		for _, item := range apis {
+			if item.ApiValue != nil && item.ApiValue.Swagger != nil {
+				entity.apiDoc = item.ApiValue.Swagger
+				entity.existed = true
+				break
+			}
+		}
+	}
+	return err
+}
+
+// isLeftUnchanged tells whether the deployment leaves a deployed entity as it is: the entity
+// carries the digest of the deployment plan and was not updated since the last deployment.
+// Only the managed annotation of such an entity is updated, see createAction.
+func (deployer *ServiceDeployer) isLeftUnchanged(entity *entitySnapshot, annotations whisk.KeyValueArr, namespace string, name string, updated int64) bool {
+	kind := entity.kind
+	if kind == parsers.YAML_KEY_SEQUENCE {
+		kind = parsers.YAML_KEY_ACTION
+	}
+	return len(entity.digest) > 0 && hasDigest(annotations, entity.digest) &&
+		deployer.isUnchanged(kind, namespace, name, updated, func() bool { return false })
+}
+
+// readFeed returns the configuration of the feed of a deployed trigger and whether the feed
+// is active, as answered by the feed to the READ lifecycle event. The configuration is nil
+// when the feed cannot tell.
+func (deployer *ServiceDeployer) readFeed(triggerName string, feedName string) (map[string]interface{}, bool) {
+	if _, supported := deployer.feedHandler(feedName, parsers.FEED_OPERATION_READ); !supported {
+		return nil, true
+	}
+	result, err := deployer.invokeFeedAction(triggerName, feedName, parsers.FEED_OPERATION_READ, deployer.feedInputs(feedName, nil))
+	if err != nil {
+		return nil, true
+	}
+	config, _ := result[FEED_RESULT_CONFIG].(map[string]interface{})
+	status, _ := result[FEED_RESULT_STATUS].(map[string]interface{})
+	active, ok := status[FEED_RESULT_ACTIVE].(bool)
+	return config, !ok || active
+}
+
+// rollback brings every entity touched by the failed deployment back to its prior state:
+// entities which were created are deleted, then entities which existed are restored.
+// Entities which were never touched, or left unchanged, by the deployment are left alone.
+func (deployer *ServiceDeployer) rollback(snapshot *deploymentSnapshot, touched map[string]bool) {
+	wskprint.PrintlnOpenWhiskInfo(wski18n.T(wski18n.ID_MSG_ROLLBACK_STARTED))
+
+	var created, existed []*entitySnapshot
+	for _, entity := range snapshot.entities {
+		if !touched[entity.task] || entity.unchanged {
+			continue
+		}
+		if entity.existed {
+			existed = append(existed, entity)
+		} else {
+			created = append(created, entity)
+		}
+	}
+	sort.SliceStable(created, func(i, j int) bool {
+		return rollbackOrder[created[i].kind] < rollbackOrder[created[j].kind]
+	})
+	sort.SliceStable(existed, func(i, j int) bool {
+		return rollbackOrder[existed[i].kind] > rollbackOrder[existed[j].kind]
+	})
+
+	failed := false
+	for _, entity := range append(created, existed...) {
+		owner := entity.owner
+		if owner == nil {
+			owner = deployer
+		}
+		var err error
+		switch {
+		case !entity.existed:
+			err = owner.deleteEntity(entity)
+		case entity.orphan:
+			err = owner.restoreOrphan(entity)
+		default:
+			err = owner.restoreEntity(entity)
+		}
+		if err != nil {
+			failed = true
+			wskprint.PrintOpenWhiskError(wski18n.T(wski18n.ID_ERR_ROLLBACK_ENTITY_X_key_X_name_X_err_X,
+				map[string]interface{}{
+					wski18n.KEY_KEY:  entity.kind,
+					wski18n.KEY_NAME: entity.name,
+					wski18n.KEY_ERR:  err.Error()}))
+		}
+	}
+
+	if failed {
+		wskprint.PrintOpenWhiskError(wski18n.T(wski18n.ID_MSG_ROLLBACK_FAILED))
+	} else {
+		wskprint.PrintlnOpenWhiskInfo(wski18n.T(wski18n.ID_MSG_ROLLBACK_SUCCEEDED))
+	}
+}
+
+func (deployer *ServiceDeployer) restoreEntity(entity *entitySnapshot) error {
+	var err error
+	var response *http.Response
+
+	switch entity.kind {
+	case parsers.YAML_KEY_PACKAGE:
+		pkg := *entity.pkg
+		pkg.Actions = nil
+		pkg.Feeds = nil
+		if pkg.Binding != nil && len(pkg.Binding.Name) == 0 {
+			pkg.Binding = nil
+		}
+		err = retry(deployer.Retry, func() (*http.Response, error) {
+			_, response, err = deployer.Client.Packages.Insert(&pkg, true)
+			return response, err
+		})
+	case parsers.YAML_KEY_ACTION, parsers.YAML_KEY_SEQUENCE:
+		action := *entity.action
+		action.Name = entity.name
+		action.Namespace = ""
+		err = retry(deployer.Retry, func() (*http.Response, error) {
+			_, response, err = deployer.Client.Actions.Insert(&action, true)
+			return response, err
+		})
+	case parsers.YAML_KEY_TRIGGER:
+		return deployer.restoreTrigger(entity)
+	case parsers.YAML_KEY_RULE:
+		rule := &whisk.Rule{
+			Name:        entity.name,
+			Annotations: entity.rule.Annotations,
+			Trigger:     parsers.PATH_SEPARATOR + ruleEntityPath(entity.rule.Trigger),
+			Action:      parsers.PATH_SEPARATOR + ruleEntityPath(entity.rule.Action),
+			Publish:     entity.rule.Publish,
+		}
+		err = retry(deployer.Retry, func() (*http.Response, error) {
+			_, response, err = deployer.Client.Rules.Insert(rule, true)
+			return response, err
+		})
+		if err == nil && len(entity.rule.Status) > 0 {
+			_, response, err = deployer.Client.Rules.SetState(entity.name, entity.rule.Status)
+		}
+	case parsers.YAML_KEY_API:
+		swagger, e := json.Marshal(entity.apiDoc)
+		if e != nil {
+			return e
+		}
+		options := &whisk.ApiCreateRequestOptions{AccessToken: deployer.Client.Config.ApigwAccessToken}
+		if len(deployer.Client.Config.ApigwTenantId) > 0 {
+			options.SpaceGuid = deployer.Client.Config.ApigwTenantId
+		} else {
+			options.SpaceGuid = strings.Split(deployer.Client.Config.AuthToken, ":")[0]
+		}
+		api := &whisk.ApiCreateRequest{ApiDoc: &whisk.Api{Namespace: deployer.Client.Namespace, Swagger: string(swagger)}}
+		err = retry(deployer.Retry, func() (*http.Response, error) {
+			_, response, err = deployer.Client.Apis.Insert(api, options, true)
+			return response, err
+		})
+	}
+
+	if err != nil {
+		return planLookupError(err, response)
+	}
+	return nil
+}
+
+// restoreTrigger brings a trigger back to its prior state. Feeds do not honor updates: the
+// current feed of the trigger is deleted, then the prior feed is created again with the
+// configuration and the status the feed reported when the snapshot was taken.
+func (deployer *ServiceDeployer) restoreTrigger(entity *entitySnapshot) error {
+	if len(entity.deployedFeed) > 0 && entity.feedConfig == nil {
+		// the feed was updated or re-created with the parameters of the failed deployment
+		wskprint.PrintOpenWhiskWarning(wski18n.T(wski18n.ID_WARN_ROLLBACK_FEED_X_name_X_feed_X,
+			map[string]interface{}{wski18n.KEY_NAME: entity.name, wski18n.KEY_TRIGGER_FEED: entity.deployedFeed}))
+		return nil
+	}
+
+	current, err := deployer.getRemoteTrigger(entity.name)
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		if feedName, isFeed := utils.IsFeedAction(current); isFeed {
+			if err := deployer.deleteFeedAction(current, feedName); err != nil {
+				return err
+			}
+		}
+	}
+
+	trigger := *entity.trigger
+	trigger.Rules = nil
+	var response *http.Response
+	err = retry(deployer.Retry, func() (*http.Response, error) {
+		_, response, err = deployer.Client.Triggers.Insert(&trigger, true)
+		return response, err
+	})
+	if err != nil {
+		return planLookupError(err, response)
+	}
+	if len(entity.deployedFeed) == 0 {
+		return nil
+	}
+
+	inputs := make(map[string]interface{})
+	for key, value := range entity.feedConfig {
+		if !isFeedLifecycleParam(key) {
+			inputs[key] = value
+		}
+	}
+	if _, err := deployer.invokeFeedAction(entity.name, entity.deployedFeed, parsers.FEED_OPERATION_CREATE, inputs); err != nil {
+		return err
+	}
+	if !entity.feedActive {
+		_, err = deployer.invokeFeedAction(entity.name, entity.deployedFeed, parsers.FEED_OPERATION_PAUSE, inputs)
+	}
+	return err
+}
+
+// restoreOrphan creates again an entity deleted by a managed deployment, entities
+// the deployment did not get to delete are left alone
+func (deployer *ServiceDeployer) restoreOrphan(entity *entitySnapshot) error {
+	remote, err := deployer.getRemoteEntity(entity.kind, entity.name)
+	if err != nil || remote != nil {
+		return err
+	}
+	return deployer.restoreEntity(entity)
+}
+
+func (deployer *ServiceDeployer) deleteEntity(entity *entitySnapshot) error {
+	switch entity.kind {
+	case parsers.YAML_KEY_PACKAGE:
+		return deployer.deletePackage(&whisk.Package{Name: entity.name})
+	case parsers.YAML_KEY_ACTION, parsers.YAML_KEY_SEQUENCE:
+		return deployer.deleteAction(parsers.DEFAULT_PACKAGE, &whisk.Action{Name: entity.name})
+	case parsers.YAML_KEY_TRIGGER:
+		if len(entity.feed) > 0 {
+			if err := deployer.deleteFeedAction(entity.localTrigger, entity.feed); err != nil {
+				return err
+			}
+		}
+		return deployer.deleteTrigger(&whisk.Trigger{Name: entity.name})
+	case parsers.YAML_KEY_RULE:
+		return deployer.deleteRule(&whisk.Rule{Name: entity.name})
+	case parsers.YAML_KEY_API:
+		if entity.api == deployer.Deployment.SwaggerApi {
+			return deployer.deleteSwaggerApi(entity.api)
+		}
+		return deployer.deleteApi(entity.api)
+	}
+	return nil
+}
+
+// ruleEntityPath returns ns/name of the trigger or action of a rule read from the server
+func ruleEntityPath(entity interface{}) string {
+	if e, ok := entity.(map[string]interface{}); ok {
+		path, _ := e["path"].(string)
+		name, _ := e["name"].(string)
+		return path + parsers.PATH_SEPARATOR + name
+	}
+	if e, ok := entity.(string); ok {
+		return strings.TrimPrefix(e, parsers.PATH_SEPARATOR)
+	}
+	return ""
+}
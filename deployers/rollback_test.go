@@ -0,0 +1,288 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/dependencies"
+	"github.com/apache/openwhisk-wskdeploy/parsers"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// newRecordingDeployer returns a deployer whose client records every request but GET
+func newRecordingDeployer(t *testing.T) (*ServiceDeployer, *[]string, func()) {
+	return newRecordingDeployerWithStatus(t, http.StatusOK)
+}
+
+// newRecordingDeployerWithStatus returns a recording deployer whose client gets the given
+// status code for every GET request
+func newRecordingDeployerWithStatus(t *testing.T, getStatus int) (*ServiceDeployer, *[]string, func()) {
+	var mu sync.Mutex
+	requests := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method != http.MethodGet {
+			path := r.URL.Path[strings.Index(r.URL.Path, "/namespaces/"):]
+			requests = append(requests, r.Method+" "+path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			w.WriteHeader(getStatus)
+		}
+		w.Write([]byte("{}"))
+	}))
+
+	client, err := whisk.NewClient(http.DefaultClient, &whisk.Config{Host: server.URL, AuthToken: "user:pass", Namespace: "guest"})
+	assert.Nil(t, err)
+
+	deployer := NewServiceDeployer()
+	deployer.Client = client
+	deployer.ClientConfig = client.Config
+	return deployer, &requests, server.Close
+}
+
+func TestRollback_RestoresAndDeletesTouchedEntities(t *testing.T) {
+	deployer, requests, closeServer := newRecordingDeployer(t)
+	defer closeServer()
+
+	code := "function main() {}"
+	snapshot := new(deploymentSnapshot)
+	action := snapshot.add("action:p/a", parsers.YAML_KEY_ACTION, "p/a")
+	action.existed = true
+	action.action = &whisk.Action{Namespace: "guest/p", Name: "a", Exec: &whisk.Exec{Kind: "nodejs:10", Code: &code}}
+	snapshot.add("package:p", parsers.YAML_KEY_PACKAGE, "p").existed = true
+	snapshot.entities[1].pkg = &whisk.Package{Name: "p", Binding: &whisk.Binding{}}
+	snapshot.add("rule:r", parsers.YAML_KEY_RULE, "r")
+	snapshot.add("trigger:t", parsers.YAML_KEY_TRIGGER, "t")
+
+	touched := map[string]bool{"action:p/a": true, "package:p": true, "rule:r": true}
+	deployer.rollback(snapshot, touched)
+
+	// created entities are deleted first, existing entities are restored in dependency order
+	assert.Equal(t, []string{
+		"DELETE /namespaces/guest/rules/r",
+		"PUT /namespaces/guest/packages/p",
+		"PUT /namespaces/guest/actions/p/a",
+	}, *requests)
+}
+
+func TestRollback_LeavesUnchangedEntities(t *testing.T) {
+	deployer, requests, closeServer := newRecordingDeployer(t)
+	defer closeServer()
+
+	snapshot := new(deploymentSnapshot)
+	action := snapshot.add("action:p/a", parsers.YAML_KEY_ACTION, "p/a")
+	action.existed = true
+	action.unchanged = true
+	deployer.rollback(snapshot, map[string]bool{"action:p/a": true})
+	assert.Empty(t, *requests)
+}
+
+func TestRollback_SnapshotsCodeOfChangedActions(t *testing.T) {
+	var mu sync.Mutex
+	codeFetches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.URL.Query().Get("code") == "true" {
+			codeFetches++
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(whisk.Action{Namespace: "guest/p", Name: "a", Updated: 42,
+			Annotations: whisk.KeyValueArr{{Key: DIGEST_ANNOTATION, Value: "sha256:a"}}})
+	}))
+	defer server.Close()
+	client, err := whisk.NewClient(http.DefaultClient, &whisk.Config{Host: server.URL, AuthToken: "user:pass", Namespace: "guest"})
+	assert.Nil(t, err)
+	deployer := NewServiceDeployer()
+	deployer.Client = client
+	deployer.ClientConfig = client.Config
+
+	// the action carries the digest of the plan and was not updated since the last deployment
+	deployer.deployed = map[entityName]int64{{parsers.YAML_KEY_ACTION, "/guest/p/a"}: 42}
+	entity := &entitySnapshot{kind: parsers.YAML_KEY_ACTION, name: "p/a", digest: "sha256:a"}
+	assert.Nil(t, deployer.fetchSnapshot(entity))
+	assert.True(t, entity.existed)
+	assert.True(t, entity.unchanged)
+	assert.Equal(t, 0, codeFetches)
+
+	entity = &entitySnapshot{kind: parsers.YAML_KEY_ACTION, name: "p/a", digest: "sha256:b"}
+	assert.Nil(t, deployer.fetchSnapshot(entity))
+	assert.False(t, entity.unchanged)
+	assert.Equal(t, 1, codeFetches)
+}
+
+func TestRollback_RestoresFeedTrigger(t *testing.T) {
+	deployer, requests, closeServer := newRecordingDeployer(t)
+	defer closeServer()
+
+	feed := "/whisk.system/alarms/alarm"
+	snapshot := new(deploymentSnapshot)
+	trigger := snapshot.add("trigger:t", parsers.YAML_KEY_TRIGGER, "t")
+	trigger.existed = true
+	trigger.trigger = &whisk.Trigger{Name: "t", Annotations: whisk.KeyValueArr{{Key: parsers.YAML_KEY_FEED, Value: feed}}}
+	trigger.deployedFeed = feed
+	trigger.feedConfig = map[string]interface{}{"cron": "* * * * *", FEED_PARAM_LIFECYCLE_EVENT: "READ"}
+	trigger.feedActive = false
+
+	deployer.rollback(snapshot, map[string]bool{"trigger:t": true})
+
+	// the feed is created again, then paused as it was
+	assert.Equal(t, []string{
+		"PUT /namespaces/guest/triggers/t",
+		"POST /namespaces/whisk.system/actions/alarms/alarm",
+		"POST /namespaces/whisk.system/actions/alarms/alarm",
+	}, *requests)
+}
+
+func TestRollback_RestoresDeletedOrphans(t *testing.T) {
+	deployer, requests, closeServer := newRecordingDeployerWithStatus(t, http.StatusNotFound)
+	defer closeServer()
+
+	code := "function main() {}"
+	snapshot := new(deploymentSnapshot)
+	action := snapshot.add(TASK_MANAGED, parsers.YAML_KEY_ACTION, "p/old")
+	action.existed = true
+	action.orphan = true
+	action.action = &whisk.Action{Namespace: "guest/p", Name: "old", Exec: &whisk.Exec{Kind: "nodejs:10", Code: &code}}
+
+	deployer.rollback(snapshot, map[string]bool{})
+	assert.Empty(t, *requests)
+
+	deployer.rollback(snapshot, map[string]bool{TASK_MANAGED: true})
+	assert.Equal(t, []string{"PUT /namespaces/guest/actions/p/old"}, *requests)
+}
+
+func TestRollback_DeletesCreatedFeedTrigger(t *testing.T) {
+	deployer, requests, closeServer := newRecordingDeployer(t)
+	defer closeServer()
+
+	feed := "/whisk.system/alarms/alarm"
+	snapshot := new(deploymentSnapshot)
+	trigger := snapshot.add("trigger:t", parsers.YAML_KEY_TRIGGER, "t")
+	trigger.feed = feed
+	trigger.localTrigger = &whisk.Trigger{Name: "t",
+		Annotations: whisk.KeyValueArr{{Key: parsers.YAML_KEY_FEED, Value: feed}}}
+
+	deployer.rollback(snapshot, map[string]bool{"trigger:t": true})
+
+	assert.Equal(t, []string{
+		"POST /namespaces/whisk.system/actions/alarms/alarm",
+		"DELETE /namespaces/guest/triggers/t",
+	}, *requests)
+}
+
+func TestRollback_SnapshotsDependencies(t *testing.T) {
+	deployer, cleanup := newResolverProject(t, map[string]string{
+		".": `packages:
+  p:
+    dependencies:
+      utils:
+        location: /whisk.system/utils
+      greetings:
+        location: ./hello
+`,
+		"hello": `packages:
+  hello:
+    triggers:
+      ticks:
+`,
+	})
+	defer cleanup()
+	recorder, _, closeServer := newRecordingDeployer(t)
+	defer closeServer()
+	deployer.Client, deployer.ClientConfig = recorder.Client, recorder.ClientConfig
+	deployer.DependencyMaster = make(map[string]dependencies.DependencyRecord)
+	assert.Nil(t, deployer.ConstructDeploymentPlan())
+
+	snapshot, err := deployer.takeSnapshot()
+	assert.Nil(t, err)
+	names := make([]string, 0, len(snapshot.entities))
+	for _, entity := range snapshot.entities {
+		names = append(names, entity.task+" "+entity.kind+":"+entity.name)
+	}
+	// the package of the dependency is named otherwise, it is bound to "greetings"
+	assert.Equal(t, []string{
+		"package:p package:p",
+		TASK_DEPENDENCIES + " package:hello",
+		TASK_DEPENDENCIES + " trigger:ticks",
+		TASK_DEPENDENCIES + " package:greetings",
+		TASK_DEPENDENCIES + " package:utils",
+	}, names)
+	assert.NotEqual(t, deployer, snapshot.entities[1].owner)
+}
+
+func TestRollback_SnapshotsManagedOrphans(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		managed := whisk.KeyValueArr{{Key: utils.MANAGED, Value: map[string]interface{}{utils.OW_PROJECT_NAME: "demo"}}}
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/actions"):
+			json.NewEncoder(w).Encode([]whisk.Action{
+				{Namespace: "guest/p", Name: "a", Annotations: managed},
+				{Namespace: "guest/p", Name: "old", Annotations: managed},
+				{Namespace: "guest", Name: "other"}})
+		case strings.HasSuffix(r.URL.Path, "/packages") || strings.HasSuffix(r.URL.Path, "/triggers") ||
+			strings.HasSuffix(r.URL.Path, "/rules"):
+			w.Write([]byte("[]"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"The requested resource does not exist."}`))
+		}
+	}))
+	defer server.Close()
+	client, err := whisk.NewClient(http.DefaultClient, &whisk.Config{Host: server.URL, AuthToken: "user:pass", Namespace: "guest"})
+	assert.Nil(t, err)
+	deployer := NewServiceDeployer()
+	deployer.Client = client
+	deployer.ClientConfig = client.Config
+	deployer.ProjectName = "demo"
+
+	code := "function main() {}"
+	pack := NewDeploymentPackage()
+	pack.Package = &whisk.Package{Name: "p"}
+	pack.Actions["a"] = utils.ActionRecord{Action: &whisk.Action{Name: "a", Exec: &whisk.Exec{Kind: "nodejs:10", Code: &code}}}
+	deployer.Deployment.Packages["p"] = pack
+
+	utils.Flags.Managed = true
+	defer func() { utils.Flags.Managed = false }()
+	snapshot, err := deployer.takeSnapshot()
+	assert.Nil(t, err)
+	names := make([]string, 0, len(snapshot.entities))
+	for _, entity := range snapshot.entities {
+		names = append(names, entity.task+" "+entity.kind+":"+entity.name)
+	}
+	assert.Equal(t, []string{"package:p package:p", "action:p/a action:p/a", TASK_MANAGED + " action:p/old"}, names)
+	assert.True(t, snapshot.entities[2].orphan)
+}
+
+func TestRollback_RuleEntityPath(t *testing.T) {
+	assert.Equal(t, "guest/pkg/a", ruleEntityPath(map[string]interface{}{"path": "guest/pkg", "name": "a"}))
+	assert.Equal(t, "guest/t", ruleEntityPath("/guest/t"))
+}
@@ -0,0 +1,75 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wsksecret"
+)
+
+/*
+ * Parameters and annotations can refer to secrets, secret://<provider>/<path>, which are
+ * resolved once the deployment plan is complete so that the values from the manifest file,
+ * the deployment file and the command line are all resolved the same way.
+ */
+
+// resolveSecrets replaces the secret references of the deployment plan by their values
+func (deployer *ServiceDeployer) resolveSecrets() error {
+	var keyValues []whisk.KeyValueArr
+	for _, pack := range deployer.Deployment.Packages {
+		if pack.Package != nil {
+			keyValues = append(keyValues, pack.Package.Parameters, pack.Package.Annotations)
+		}
+		for _, dependency := range pack.Dependencies {
+			keyValues = append(keyValues, dependency.Parameters, dependency.Annotations)
+		}
+		for _, records := range []map[string]utils.ActionRecord{pack.Actions, pack.Sequences} {
+			for _, record := range records {
+				if record.Action != nil {
+					keyValues = append(keyValues, record.Action.Parameters, record.Action.Annotations)
+				}
+			}
+		}
+	}
+	for _, trigger := range deployer.Deployment.Triggers {
+		keyValues = append(keyValues, trigger.Parameters, trigger.Annotations)
+	}
+	for _, rule := range deployer.Deployment.Rules {
+		keyValues = append(keyValues, rule.Annotations)
+	}
+
+	for _, kvs := range keyValues {
+		for i := range kvs {
+			value, err := wsksecret.Resolve(kvs[i].Value)
+			if err != nil {
+				return err
+			}
+			kvs[i].Value = value
+		}
+	}
+
+	// the inputs of the feeds of the manifest are passed to the feed actions along with the
+	// parameters of their triggers
+	for _, feed := range deployer.Deployment.Feeds {
+		if _, err := wsksecret.Resolve(feed.Inputs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
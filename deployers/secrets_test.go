@@ -0,0 +1,73 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wskprint"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveSecrets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wskdeploy-secrets")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "db-password"), []byte("s3cr3t"), 0600))
+	utils.Flags.SecretsDir = dir
+	defer func() { utils.Flags.SecretsDir = "" }()
+
+	deployer := NewServiceDeployer()
+	pack := NewDeploymentPackage()
+	pack.Package = &whisk.Package{Name: "db",
+		Parameters: whisk.KeyValueArr{{Key: "password", Value: "secret://dir/db-password"}}}
+	pack.Actions["query"] = utils.ActionRecord{Action: &whisk.Action{Name: "query",
+		Parameters:  whisk.KeyValueArr{{Key: "host", Value: "localhost"}},
+		Annotations: whisk.KeyValueArr{{Key: "auth", Value: map[string]interface{}{"password": "secret://dir/db-password"}}}}}
+	deployer.Deployment.Packages["db"] = pack
+	deployer.Deployment.Triggers["changes"] = &whisk.Trigger{Name: "changes",
+		Parameters: whisk.KeyValueArr{{Key: "password", Value: "secret://dir/db-password"}}}
+
+	assert.Nil(t, deployer.resolveSecrets())
+	assert.Equal(t, "s3cr3t", pack.Package.Parameters[0].Value)
+	assert.Equal(t, "localhost", pack.Actions["query"].Action.Parameters[0].Value)
+	assert.Equal(t, "s3cr3t", pack.Actions["query"].Action.Annotations[0].Value.(map[string]interface{})["password"])
+	assert.Equal(t, "s3cr3t", deployer.Deployment.Triggers["changes"].Parameters[0].Value)
+
+	deployer.Deployment.Feeds["db/changes"] = &utils.FeedRecord{Name: "changes", Packagename: "db", Action: "db/changes",
+		Inputs: map[string]interface{}{"dbname": "orders", "password": "secret://dir/db-password"}}
+	assert.Nil(t, deployer.resolveSecrets())
+	assert.Equal(t, "s3cr3t", deployer.Deployment.Feeds["db/changes"].Inputs["password"])
+	assert.Equal(t, "orders", deployer.Deployment.Feeds["db/changes"].Inputs["dbname"])
+	assert.Equal(t, "password: "+wskprint.MASKED_VALUE, wskprint.MaskSensitiveValues("password: s3cr3t"))
+
+	deployer.Deployment.Feeds["db/changes"].Inputs["password"] = "secret://dir/missing"
+	assert.NotNil(t, deployer.resolveSecrets(), "A missing secret in a feed input should fail the deployment plan.")
+	delete(deployer.Deployment.Feeds, "db/changes")
+
+	deployer.Deployment.Rules["missing"] = &whisk.Rule{Name: "missing",
+		Annotations: whisk.KeyValueArr{{Key: "password", Value: "secret://dir/missing"}}}
+	assert.NotNil(t, deployer.resolveSecrets(), "A missing secret should fail the deployment plan.")
+}
@@ -0,0 +1,320 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"path"
+	"strings"
+
+	"github.com/apache/openwhisk-wskdeploy/dependencies"
+	"github.com/apache/openwhisk-wskdeploy/parsers"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+)
+
+// kinds of entities an EntitySelector may select
+var SELECTOR_KINDS = []string{
+	parsers.YAML_KEY_PACKAGE,
+	parsers.YAML_KEY_ACTION,
+	parsers.YAML_KEY_SEQUENCE,
+	parsers.YAML_KEY_TRIGGER,
+	parsers.YAML_KEY_RULE,
+	parsers.YAML_KEY_API,
+}
+
+// EntitySelector selects entities of a deployment by kind and name, e.g. action:pkg/name.
+// The name is a pattern as accepted by path.Match, a lone * matches every name.
+// A package selector also selects the actions, sequences and dependencies of the package.
+type EntitySelector struct {
+	Kind string
+	Name string
+}
+
+// ParseEntitySelector parses <kind>:<name>, ok is false when the selector is malformed
+func ParseEntitySelector(selector string) (s EntitySelector, ok bool) {
+	parts := strings.SplitN(selector, ":", 2)
+	if len(parts) != 2 || len(parts[1]) == 0 {
+		return s, false
+	}
+	s = EntitySelector{Kind: strings.ToLower(strings.TrimSpace(parts[0])), Name: strings.TrimSpace(parts[1])}
+	if _, err := path.Match(s.Name, ""); err != nil {
+		return s, false
+	}
+	for _, kind := range SELECTOR_KINDS {
+		if kind == s.Kind {
+			return s, true
+		}
+	}
+	return s, false
+}
+
+func (s EntitySelector) String() string {
+	return s.Kind + ":" + s.Name
+}
+
+func (s EntitySelector) matchName(names ...string) bool {
+	if s.Name == "*" {
+		return true
+	}
+	for _, name := range names {
+		if matched, _ := path.Match(s.Name, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// selectable is an entity of the deployment plan as seen by the selectors
+type selectable struct {
+	id       string
+	kind     string
+	names    []string // the names a selector may match
+	pkg      string   // enclosing package of an action or a sequence, empty in the default package
+	requires []string // ids of the entities which must be deployed first
+}
+
+func (e *selectable) matches(s EntitySelector) bool {
+	if s.Kind == parsers.YAML_KEY_PACKAGE && len(e.pkg) != 0 {
+		return s.matchName(e.pkg)
+	}
+	return s.Kind == e.kind && s.matchName(e.names...)
+}
+
+// IsSelective tests if the deployment is restricted by --only or --exclude
+func (deployer *ServiceDeployer) IsSelective() bool {
+	return len(deployer.Only) != 0 || len(deployer.Exclude) != 0
+}
+
+// selectableEntities lists the entities of the deployment plan with the entities each one requires
+func (deployer *ServiceDeployer) selectableEntities() []*selectable {
+	var entities []*selectable
+	for _, pkgName := range sortedPackageNames(deployer.Deployment.Packages) {
+		pack := deployer.Deployment.Packages[pkgName]
+		pkg := pack.Package.Name
+		isDefault := strings.ToLower(pkg) == parsers.DEFAULT_PACKAGE
+		var requires []string
+		if !isDefault {
+			id := taskID(parsers.YAML_KEY_PACKAGE, pkg)
+			entities = append(entities, &selectable{id: id, kind: parsers.YAML_KEY_PACKAGE, names: []string{pkg}})
+			requires = []string{id}
+		}
+		for _, kind := range []string{parsers.YAML_KEY_ACTION, parsers.YAML_KEY_SEQUENCE} {
+			records := pack.Actions
+			if kind == parsers.YAML_KEY_SEQUENCE {
+				records = pack.Sequences
+			}
+			for _, name := range sortedActionNames(records) {
+				action := records[name].Action
+				entity := &selectable{kind: kind, names: []string{action.Name}, requires: requires}
+				if !isDefault {
+					entity.pkg = pkg
+					entity.names[0] = strings.Join([]string{pkg, action.Name}, parsers.PATH_SEPARATOR)
+				}
+				entity.id = actionTaskID(entity.names[0])
+				if action.Exec != nil {
+					for _, component := range action.Exec.Components {
+						entity.requires = append(entity.requires, actionTaskID(component))
+					}
+				}
+				entities = append(entities, entity)
+			}
+		}
+	}
+
+	for _, name := range sortedTriggerNames(deployer.Deployment.Triggers) {
+		trigger := deployer.Deployment.Triggers[name]
+		entity := &selectable{id: taskID(parsers.YAML_KEY_TRIGGER, trigger.Name),
+			kind: parsers.YAML_KEY_TRIGGER, names: []string{trigger.Name}}
+		// the trigger of a feed of the manifest requires the actions of the feed
+		if feedname, isFeed := utils.IsFeedAction(trigger); isFeed {
+			if feed, ok := deployer.Deployment.Feeds[feedname]; ok {
+				entity.requires = utils.SortedKeys(feedActionTasks(feed))
+			}
+		}
+		entities = append(entities, entity)
+	}
+
+	for _, name := range sortedRuleNames(deployer.Deployment.Rules) {
+		rule := deployer.Deployment.Rules[name]
+		entity := &selectable{id: taskID(parsers.YAML_KEY_RULE, rule.Name), kind: parsers.YAML_KEY_RULE, names: []string{rule.Name}}
+		if trigger, ok := rule.Trigger.(string); ok {
+			entity.requires = append(entity.requires, taskID(parsers.YAML_KEY_TRIGGER, shortName(trigger)))
+		}
+		if action, ok := rule.Action.(string); ok {
+			entity.requires = append(entity.requires, actionTaskID(action))
+		}
+		entities = append(entities, entity)
+	}
+
+	// APIs are matched by their path (the key of the plan), their name or their relative path
+	for apiPath, api := range deployer.Deployment.Apis {
+		entity := &selectable{id: taskID(parsers.YAML_KEY_API, apiPath), kind: parsers.YAML_KEY_API, names: []string{apiPath}}
+		if api.ApiDoc != nil {
+			entity.names = append(entity.names, api.ApiDoc.ApiName,
+				strings.TrimPrefix(api.ApiDoc.GatewayBasePath+api.ApiDoc.GatewayRelPath, parsers.PATH_SEPARATOR))
+			if api.ApiDoc.Action != nil {
+				entity.requires = append(entity.requires, actionTaskID(api.ApiDoc.Action.Name))
+			}
+		}
+		entities = append(entities, entity)
+	}
+	if deployer.Deployment.SwaggerApi != nil {
+		entities = append(entities, &selectable{id: taskID(parsers.YAML_KEY_API, parsers.YAML_KEY_API),
+			kind: parsers.YAML_KEY_API, names: []string{parsers.YAML_KEY_API}})
+	}
+	return entities
+}
+
+// selectEntities restricts the deployment plan to the entities selected by --only, minus
+// the entities selected by --exclude. On deploy the entities a selected entity requires
+// are added, e.g. the package of an action, the actions of a sequence or the trigger and
+// action of a rule; on undeploy the entities which require a selected entity are added
+// instead, e.g. the rules of a trigger. Excluded entities are never added, they are
+// expected to exist already. The dependencies of a package are only kept when the
+// package itself is selected.
+func (deployer *ServiceDeployer) selectEntities(undeploy bool) error {
+	if !deployer.IsSelective() {
+		return nil
+	}
+
+	entities := deployer.selectableEntities()
+	index := make(map[string]*selectable)
+	dependents := make(map[string][]string)
+	for _, e := range entities {
+		index[e.id] = e
+		for _, r := range e.requires {
+			dependents[r] = append(dependents[r], e.id)
+		}
+	}
+
+	selected := make(map[string]bool)
+	excluded := make(map[string]bool)
+	for _, s := range deployer.Only {
+		found := false
+		for _, e := range entities {
+			if e.matches(s) {
+				selected[e.id], found = true, true
+			}
+		}
+		if !found {
+			command := wski18n.CMD_DEPLOY
+			if undeploy {
+				command = wski18n.CMD_UNDEPLOY
+			}
+			return wskderrors.NewCommandError(command, wski18n.T(wski18n.ID_ERR_ENTITY_SELECTOR_NO_MATCH_X_selector_X,
+				map[string]interface{}{wski18n.KEY_SELECTOR: s.String()}))
+		}
+	}
+	for _, e := range entities {
+		if len(deployer.Only) == 0 {
+			selected[e.id] = true
+		}
+		for _, s := range deployer.Exclude {
+			if e.matches(s) {
+				excluded[e.id] = true
+				delete(selected, e.id)
+			}
+		}
+	}
+
+	// packages selected for themselves, not only because one of their entities is
+	explicit := make(map[string]bool)
+	for id := range selected {
+		explicit[id] = true
+	}
+
+	var visit func(id string)
+	visit = func(id string) {
+		next := index[id].requires
+		if undeploy {
+			next = dependents[id]
+		}
+		for _, n := range next {
+			if _, ok := index[n]; ok && !selected[n] && !excluded[n] {
+				selected[n] = true
+				visit(n)
+			}
+		}
+	}
+	for id := range explicit {
+		visit(id)
+	}
+
+	deployer.pruneDeployment(selected, explicit)
+	return nil
+}
+
+// pruneDeployment removes the entities which are not selected from the deployment plan.
+// A package which is not selected but holds selected actions or sequences stays in the
+// plan as a container only: it is neither deployed nor undeployed.
+func (deployer *ServiceDeployer) pruneDeployment(selected map[string]bool, explicit map[string]bool) {
+	deployment := deployer.Deployment
+	deployer.containers = make(map[string]bool)
+	for pkgName, pack := range deployment.Packages {
+		isDefault := strings.ToLower(pack.Package.Name) == parsers.DEFAULT_PACKAGE
+		for _, records := range []map[string]utils.ActionRecord{pack.Actions, pack.Sequences} {
+			for name, record := range records {
+				actionName := record.Action.Name
+				if !isDefault {
+					actionName = strings.Join([]string{pack.Package.Name, actionName}, parsers.PATH_SEPARATOR)
+				}
+				if !selected[actionTaskID(actionName)] {
+					delete(records, name)
+				}
+			}
+		}
+		id := taskID(parsers.YAML_KEY_PACKAGE, pack.Package.Name)
+		if !explicit[id] {
+			pack.Dependencies = make(map[string]dependencies.DependencyRecord)
+		}
+		if isDefault || selected[id] {
+			continue
+		}
+		if len(pack.Actions) == 0 && len(pack.Sequences) == 0 {
+			delete(deployment.Packages, pkgName)
+		} else {
+			deployer.containers[pack.Package.Name] = true
+		}
+	}
+	for name, trigger := range deployment.Triggers {
+		if !selected[taskID(parsers.YAML_KEY_TRIGGER, trigger.Name)] {
+			delete(deployment.Triggers, name)
+		}
+	}
+	for name, rule := range deployment.Rules {
+		if !selected[taskID(parsers.YAML_KEY_RULE, rule.Name)] {
+			delete(deployment.Rules, name)
+		}
+	}
+	for apiPath := range deployment.Apis {
+		if !selected[taskID(parsers.YAML_KEY_API, apiPath)] {
+			delete(deployment.Apis, apiPath)
+			delete(deployment.ApiOptions, apiPath)
+		}
+	}
+	if deployment.SwaggerApi != nil && !selected[taskID(parsers.YAML_KEY_API, parsers.YAML_KEY_API)] {
+		deployment.SwaggerApi = nil
+		deployment.SwaggerApiOptions = nil
+	}
+}
+
+// isContainer tests if a package is only part of the plan because it holds selected entities
+func (deployer *ServiceDeployer) isContainer(packageName string) bool {
+	return deployer.containers[packageName]
+}
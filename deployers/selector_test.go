@@ -0,0 +1,147 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package deployers
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/dependencies"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/stretchr/testify/assert"
+)
+
+// newSelectiveDeployer returns a deployer of two packages:
+//
+//	orders: actions query and store, sequence pipeline (query, store), dependency lib
+//	billing: action charge
+//	trigger tick, rule onTick (tick -> orders/pipeline)
+func newSelectiveDeployer(only []string, exclude []string) *ServiceDeployer {
+	deployer := NewServiceDeployer()
+	orders := NewDeploymentPackage()
+	orders.Package = &whisk.Package{Name: "orders"}
+	orders.Actions["query"] = utils.ActionRecord{Action: &whisk.Action{Name: "query"}}
+	orders.Actions["store"] = utils.ActionRecord{Action: &whisk.Action{Name: "store"}}
+	orders.Sequences["pipeline"] = utils.ActionRecord{Action: &whisk.Action{Name: "pipeline",
+		Exec: &whisk.Exec{Kind: "sequence", Components: []string{"/_/orders/query", "/_/orders/store"}}}}
+	orders.Dependencies["lib"] = dependencies.DependencyRecord{}
+	billing := NewDeploymentPackage()
+	billing.Package = &whisk.Package{Name: "billing"}
+	billing.Actions["charge"] = utils.ActionRecord{Action: &whisk.Action{Name: "charge"}}
+	deployer.Deployment.Packages["orders"] = orders
+	deployer.Deployment.Packages["billing"] = billing
+	deployer.Deployment.Triggers["tick"] = &whisk.Trigger{Name: "tick"}
+	deployer.Deployment.Rules["onTick"] = &whisk.Rule{Name: "onTick", Trigger: "tick", Action: "orders/pipeline"}
+
+	for _, s := range only {
+		selector, _ := ParseEntitySelector(s)
+		deployer.Only = append(deployer.Only, selector)
+	}
+	for _, s := range exclude {
+		selector, _ := ParseEntitySelector(s)
+		deployer.Exclude = append(deployer.Exclude, selector)
+	}
+	return deployer
+}
+
+// planned lists the ids of the entities left in the plan, container packages excluded
+func planned(deployer *ServiceDeployer) []string {
+	var ids []string
+	for _, e := range deployer.selectableEntities() {
+		if e.kind != "package" || !deployer.isContainer(e.names[0]) {
+			ids = append(ids, e.id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func TestParseEntitySelector(t *testing.T) {
+	selector, ok := ParseEntitySelector("Action:orders/*")
+	assert.True(t, ok)
+	assert.Equal(t, EntitySelector{Kind: "action", Name: "orders/*"}, selector)
+	assert.Equal(t, "action:orders/*", selector.String())
+
+	for _, invalid := range []string{"orders", "action:", "feed:tick", "action:[orders"} {
+		_, ok := ParseEntitySelector(invalid)
+		assert.False(t, ok, invalid)
+	}
+}
+
+func TestSelectEntities_Deploy(t *testing.T) {
+	deployer := newSelectiveDeployer(nil, nil)
+	assert.Nil(t, deployer.selectEntities(false))
+	assert.Equal(t, 8, len(planned(deployer)), "Without selectors the plan is left untouched.")
+
+	deployer = newSelectiveDeployer([]string{"action:orders/query"}, nil)
+	assert.Nil(t, deployer.selectEntities(false))
+	assert.Equal(t, []string{"action:orders/query", "package:orders"}, planned(deployer),
+		"The package of a selected action is deployed.")
+	assert.Empty(t, deployer.Deployment.Packages["orders"].Dependencies,
+		"The dependencies of a package are only deployed with the package itself.")
+
+	deployer = newSelectiveDeployer([]string{"rule:*"}, []string{"trigger:*"})
+	assert.Nil(t, deployer.selectEntities(false))
+	assert.Equal(t, []string{"action:orders/pipeline", "action:orders/query", "action:orders/store",
+		"package:orders", "rule:onTick"}, planned(deployer), "Excluded entities are never deployed.")
+
+	deployer = newSelectiveDeployer([]string{"package:billing"}, nil)
+	assert.Nil(t, deployer.selectEntities(false))
+	assert.Equal(t, []string{"action:billing/charge", "package:billing"}, planned(deployer))
+
+	deployer = newSelectiveDeployer([]string{"package:orders"}, []string{"sequence:*"})
+	assert.Nil(t, deployer.selectEntities(false))
+	assert.Equal(t, []string{"action:orders/query", "action:orders/store", "package:orders"}, planned(deployer))
+	assert.Contains(t, deployer.Deployment.Packages["orders"].Dependencies, "lib")
+}
+
+func TestSelectEntities_Undeploy(t *testing.T) {
+	deployer := newSelectiveDeployer([]string{"action:orders/store"}, nil)
+	assert.Nil(t, deployer.selectEntities(true))
+	assert.Equal(t, []string{"action:orders/pipeline", "action:orders/store", "rule:onTick"}, planned(deployer),
+		"The entities depending on an undeployed entity are undeployed first.")
+	assert.True(t, deployer.isContainer("orders"), "The package of an undeployed action is kept.")
+	assert.NotContains(t, deployer.Deployment.Packages, "billing")
+}
+
+func TestSelectEntities_FeedTrigger(t *testing.T) {
+	deployer := newSelectiveDeployer([]string{"trigger:onChange"}, nil)
+	billing := deployer.Deployment.Packages["billing"]
+	billing.Actions["changes"] = utils.ActionRecord{Action: &whisk.Action{Name: "changes"}}
+	billing.Actions["stopChanges"] = utils.ActionRecord{Action: &whisk.Action{Name: "stopChanges"}}
+	deployer.Deployment.Feeds["billing/changes"] = &utils.FeedRecord{Name: "changes", Packagename: "billing",
+		Action: "billing/changes", Operations: map[string]string{"CREATE": "billing/changes", "DELETE": "billing/stopChanges"}}
+	deployer.Deployment.Triggers["onChange"] = &whisk.Trigger{Name: "onChange",
+		Annotations: whisk.KeyValueArr{{Key: "feed", Value: "billing/changes"}}}
+
+	assert.Nil(t, deployer.selectEntities(false))
+	assert.Equal(t, []string{"action:billing/changes", "action:billing/stopChanges", "package:billing", "trigger:onChange"},
+		planned(deployer), "The actions of the feed of a selected trigger are deployed.")
+}
+
+func TestSelectEntities_NoMatch(t *testing.T) {
+	deployer := newSelectiveDeployer([]string{"action:orders/missing"}, nil)
+	err := deployer.selectEntities(false)
+	assert.NotNil(t, err)
+	assert.Equal(t, wskderrors.ERROR_COMMAND_FAILED, wskderrors.GetErrorType(err))
+}
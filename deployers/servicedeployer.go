@@ -23,6 +23,7 @@ import (
 	"github.com/apache/openwhisk-wskdeploy/webaction"
 	"net/http"
 	"path"
+	"path/filepath"
 	"reflect"
 	"strconv"
 	"strings"
@@ -41,24 +42,37 @@ import (
 const (
 	CONFLICT_MESSAGE = "Concurrent modification to resource detected"
 	CONFLICT_CODE    = 153
-	DEFAULT_ATTEMPTS = 3
-	DEFAULT_INTERVAL = 1 * time.Second
+)
+
+// parameters of the lifecycle events of feeds, and keys of the result of the READ event
+const (
+	FEED_PARAM_AUTH_KEY        = "authKey"
+	FEED_PARAM_LIFECYCLE_EVENT = "lifecycleEvent"
+	FEED_PARAM_TRIGGER_NAME    = "triggerName"
+	FEED_RESULT_CONFIG         = "config"
+	FEED_RESULT_STATUS         = "status"
+	FEED_RESULT_ACTIVE         = "active"
 )
 
 type DeploymentProject struct {
-	Packages          map[string]*DeploymentPackage
-	Triggers          map[string]*whisk.Trigger
-	Rules             map[string]*whisk.Rule
-	Apis              map[string]*whisk.ApiCreateRequest
-	ApiOptions        map[string]*whisk.ApiCreateRequestOptions
-	SwaggerApi        *whisk.ApiCreateRequest
-	SwaggerApiOptions *whisk.ApiCreateRequestOptions
+	Packages          map[string]*DeploymentPackage             `json:"packages"`
+	Triggers          map[string]*whisk.Trigger                 `json:"triggers"`
+	TriggerStatus     map[string]string                         `json:"triggerStatus,omitempty"` // status of the feed of each feed trigger
+	Feeds             map[string]*utils.FeedRecord              `json:"feeds,omitempty"`         // by qualified name of the feed action
+	Rules             map[string]*whisk.Rule                    `json:"rules"`
+	Apis              map[string]*whisk.ApiCreateRequest        `json:"apis"`
+	ApiOptions        map[string]*whisk.ApiCreateRequestOptions `json:"apiOptions,omitempty"`
+	SwaggerApi        *whisk.ApiCreateRequest                   `json:"swaggerApi,omitempty"`
+	SwaggerApiOptions *whisk.ApiCreateRequestOptions            `json:"swaggerApiOptions,omitempty"`
+	ParameterSchemas  []parsers.ParameterSchema                 `json:"-"` // schemas of the input parameters
 }
 
 func NewDeploymentProject() *DeploymentProject {
 	var dep DeploymentProject
 	dep.Packages = make(map[string]*DeploymentPackage)
 	dep.Triggers = make(map[string]*whisk.Trigger)
+	dep.TriggerStatus = make(map[string]string)
+	dep.Feeds = make(map[string]*utils.FeedRecord)
 	dep.Rules = make(map[string]*whisk.Rule)
 	dep.Apis = make(map[string]*whisk.ApiCreateRequest)
 	dep.ApiOptions = make(map[string]*whisk.ApiCreateRequestOptions)
@@ -66,11 +80,11 @@ func NewDeploymentProject() *DeploymentProject {
 }
 
 type DeploymentPackage struct {
-	Package      *whisk.Package
-	Dependencies map[string]dependencies.DependencyRecord
-	Actions      map[string]utils.ActionRecord
-	Sequences    map[string]utils.ActionRecord
-	Inputs       parsers.PackageInputs
+	Package      *whisk.Package                           `json:"package"`
+	Dependencies map[string]dependencies.DependencyRecord `json:"dependencies"`
+	Actions      map[string]utils.ActionRecord            `json:"actions"`
+	Sequences    map[string]utils.ActionRecord            `json:"sequences"`
+	Inputs       parsers.PackageInputs                    `json:"-"` // bound to the package parameters
 }
 
 func NewDeploymentPackage() *DeploymentPackage {
@@ -89,19 +103,34 @@ func NewDeploymentPackage() *DeploymentPackage {
 //   3. Collect information about the source code files in the working directory
 //   4. Create a deployment plan to create OpenWhisk service
 type ServiceDeployer struct {
-	ProjectName       string
-	ProjectInputs     map[string]parsers.Parameter
-	Deployment        *DeploymentProject
-	Client            *whisk.Client
-	mt                sync.RWMutex
-	Preview           bool
-	Report            bool
-	ManifestPath      string
-	ProjectPath       string
-	DeploymentPath    string
-	ClientConfig      *whisk.Config
-	DependencyMaster  map[string]dependencies.DependencyRecord
-	ManagedAnnotation whisk.KeyValue
+	ProjectName        string
+	ProjectInputs      map[string]parsers.Parameter
+	Deployment         *DeploymentProject
+	Client             *whisk.Client
+	mt                 sync.RWMutex
+	Preview            bool
+	Report             bool
+	Plan               bool
+	Parallelism        int
+	NoRollback         bool
+	Retry              RetryPolicy      // retries of server calls failing with a transient error
+	Only               []EntitySelector // restrict the deployment to these entities, see selectEntities
+	Exclude            []EntitySelector // never deploy or undeploy these entities
+	ManifestPath       string
+	ProjectPath        string
+	DeploymentPath     string
+	DeploymentOverlays []string // deployment files merged over DeploymentPath, in order
+	ClientConfig       *whisk.Config
+	DependencyMaster   map[string]dependencies.DependencyRecord
+	Lockfile           *dependencies.Lockfile // commits of the remote dependencies, read on first use
+	DependencyGraph    *DependencyGraph       // every dependency of the project, see ResolveDependencies
+	ManagedAnnotation  whisk.KeyValue
+	touched            map[string]bool      // deployment tasks started by the last deployAssets()
+	inputSources       map[string]string    // file or command line each input value was read from
+	annotationSources  map[string]string    // deployment file each annotation was read from
+	results            *entityResults       // outcome of each entity deployed or undeployed
+	containers         map[string]bool      // packages only holding the selected entities
+	deployed           map[entityName]int64 // update time of the entities recorded by the last deployment
 }
 
 // NewServiceDeployer is a Factory to create a new ServiceDeployer
@@ -109,21 +138,20 @@ func NewServiceDeployer() *ServiceDeployer {
 	var dep ServiceDeployer
 	dep.Deployment = NewDeploymentProject()
 	dep.Preview = true
+	dep.Parallelism = DEFAULT_PARALLELISM
+	dep.Retry = DefaultRetryPolicy
 	dep.DependencyMaster = make(map[string]dependencies.DependencyRecord)
 	dep.ProjectInputs = make(map[string]parsers.Parameter, 0)
+	dep.inputSources = make(map[string]string)
+	dep.annotationSources = make(map[string]string)
+	dep.results = newEntityResults()
 	return &dep
 }
 
-// Check if the manifest yaml could be parsed by Manifest Parser.
-// Check if the deployment yaml could be parsed by Manifest Parser.
-func (deployer *ServiceDeployer) Check() {
-	ps := parsers.NewYAMLParser()
-	if utils.FileExists(deployer.DeploymentPath) {
-		ps.ParseDeployment(deployer.DeploymentPath)
-	}
-	ps.ParseManifest(deployer.ManifestPath)
-	// add more schema check or manifest/deployment consistency checks here if
-	// necessary
+// Check if the manifest yaml and the deployment yaml could be parsed and composed
+// by the Manifest Parser, see Validate.
+func (deployer *ServiceDeployer) Check() error {
+	return deployer.Validate()
 }
 
 func (deployer *ServiceDeployer) setProjectInputs(manifest *parsers.YAML) error {
@@ -164,6 +192,16 @@ func (deployer *ServiceDeployer) ConstructDeploymentPlan() error {
 		return err
 	}
 
+	// read deployment file and its overlays
+	var deploymentReader = NewDeploymentReader(deployer)
+	if utils.FileExists(deployer.DeploymentPath) {
+		err = deploymentReader.HandleYaml()
+		if err != nil {
+			return err
+		}
+		deploymentReader.BindProjectInputs()
+	}
+
 	// Generate Managed Annotations if its marked as a Managed Deployment
 	// Managed deployments are the ones when OpenWhisk entities are deployed with command line flag --managed.
 	// Which results in a hidden annotation in every OpenWhisk entity in manifest file.
@@ -195,13 +233,7 @@ func (deployer *ServiceDeployer) ConstructDeploymentPlan() error {
 	}
 
 	// process deployment file
-	var deploymentReader = NewDeploymentReader(deployer)
 	if utils.FileExists(deployer.DeploymentPath) {
-		err = deploymentReader.HandleYaml()
-		if err != nil {
-			return err
-		}
-
 		// compare the name of the project
 		if len(deploymentReader.DeploymentDescriptor.GetProject().Packages) != 0 && len(projectName) != 0 {
 			projectNameDeploy := deploymentReader.DeploymentDescriptor.GetProject().Name
@@ -238,7 +270,26 @@ func (deployer *ServiceDeployer) ConstructDeploymentPlan() error {
 		}
 	}
 
-	return err
+	// fetch the dependencies of the project, dependent deployers share the resolved graph;
+	// preview, report, plan and validate neither download dependencies nor change the lockfile
+	if deployer.DependencyGraph == nil && !deployer.IsReadOnly() && !utils.Flags.Offline {
+		if _, err := deployer.ResolveDependencies(); err != nil {
+			return err
+		}
+	}
+
+	// restrict the plan to the selected entities
+	if err := deployer.selectEntities(false); err != nil {
+		return err
+	}
+
+	// resolve secret references
+	if err := deployer.resolveSecrets(); err != nil {
+		return err
+	}
+
+	// validate the parameters against their schemas once their values are final
+	return deployer.validateParameterSchemas()
 }
 
 func (deployer *ServiceDeployer) ConstructUnDeploymentPlan() (*DeploymentProject, error) {
@@ -263,6 +314,9 @@ func (deployer *ServiceDeployer) ConstructUnDeploymentPlan() (*DeploymentProject
 	if len(manifest.GetProject().Packages) != 0 {
 		projectName = manifest.GetProject().Name
 	}
+	if len(deployer.ProjectName) == 0 {
+		deployer.ProjectName = manifest.GetProject().Name
+	}
 
 	// process deployment file
 	if utils.FileExists(deployer.DeploymentPath) {
@@ -292,6 +346,10 @@ func (deployer *ServiceDeployer) ConstructUnDeploymentPlan() (*DeploymentProject
 		}
 	}
 
+	if err := deployer.selectEntities(true); err != nil {
+		return deployer.Deployment, err
+	}
+
 	verifiedPlan := deployer.Deployment
 
 	return verifiedPlan, err
@@ -302,51 +360,69 @@ func (deployer *ServiceDeployer) ConstructUnDeploymentPlan() (*DeploymentProject
 func (deployer *ServiceDeployer) Deploy() error {
 
 	if deployer.Preview {
+		if wskprint.IsStructuredOutput() {
+			return wskprint.PrintOpenWhiskDocument(deployer.previewResult(wski18n.CMD_DEPLOY, deployer.Deployment))
+		}
 		deployer.printDeploymentAssets(deployer.Deployment)
 		return nil
 	}
 
 	if deployer.Report {
-		deployer.reportInputs()
-		return nil
+		return deployer.reportInputs()
 	}
 
-	if err := deployer.deployAssets(); err != nil {
-		wskprint.PrintOpenWhiskError(wski18n.T(wski18n.ID_MSG_DEPLOYMENT_FAILED))
-		return err
+	if deployer.Plan {
+		return deployer.PlanDeployment()
 	}
 
-	wskprint.PrintOpenWhiskSuccess(wski18n.T(wski18n.T(wski18n.ID_MSG_DEPLOYMENT_SUCCEEDED)))
-	return nil
-}
-
-func (deployer *ServiceDeployer) deployAssets() error {
-
-	if err := deployer.DeployPackages(); err != nil {
+	// record the dependencies which were not locked yet
+	if err := deployer.WriteLockfile(); err != nil {
 		return err
 	}
 
-	if err := deployer.DeployDependencies(); err != nil {
-		return err
-	}
+	// entities updated outside of wskdeploy since the last deployment are deployed again
+	deployer.deployed = deployedEntities(deployer.ProjectPath)
 
-	if err := deployer.DeployActions(); err != nil {
-		return err
+	// remember the state of every entity about to be touched so that
+	// a failed deployment does not leave the namespace half updated
+	start := time.Now()
+	var snapshot *deploymentSnapshot
+	if !deployer.NoRollback {
+		var err error
+		if snapshot, err = deployer.takeSnapshot(); err != nil {
+			return err
+		}
 	}
 
-	if err := deployer.DeploySequences(); err != nil {
+	if err := deployer.deployAssets(); err != nil {
+		wskprint.PrintOpenWhiskError(wski18n.T(wski18n.ID_MSG_DEPLOYMENT_FAILED))
+		if snapshot != nil {
+			deployer.rollback(snapshot, deployer.touched)
+		}
+		deployer.printDeploymentResult(wski18n.CMD_DEPLOY, start, err)
 		return err
 	}
 
-	if err := deployer.DeployTriggers(); err != nil {
-		return err
-	}
+	// remember what was deployed so that "wskdeploy status" can detect later changes
+	deployer.recordState()
 
-	if err := deployer.DeployRules(); err != nil {
-		return err
-	}
+	wskprint.PrintOpenWhiskSuccess(wski18n.T(wski18n.T(wski18n.ID_MSG_DEPLOYMENT_SUCCEEDED)))
+	return deployer.printDeploymentResult(wski18n.CMD_DEPLOY, start, nil)
+}
+
+// IsReadOnly tests if the deployment plan is only previewed, reported or compared with the
+// namespace (--preview, --report, --plan) rather than deployed
+func (deployer *ServiceDeployer) IsReadOnly() bool {
+	return deployer.Preview || deployer.Report || deployer.Plan
+}
+
+func (deployer *ServiceDeployer) deployAssets() error {
 
-	if err := deployer.DeployApis(); err != nil {
+	// packages, dependencies, actions, sequences, triggers, rules and apis are deployed
+	// following their dependencies, independent entities are deployed concurrently
+	g := deployer.buildDeployGraph()
+	deployer.touched = g.started
+	if err := g.run(deployer.Parallelism); err != nil {
 		return err
 	}
 
@@ -354,7 +430,9 @@ func (deployer *ServiceDeployer) deployAssets() error {
 	// refresh previously deployed project entities, delete the assets which is no longer part of the project
 	// i.e. in a subsequent managed deployment of the same project minus few OpenWhisk entities
 	// from the manifest file must result in undeployment of those deleted entities
-	if utils.Flags.Managed || utils.Flags.Sync {
+	// A selective deployment (--only, --exclude) leaves the entities which are not selected alone.
+	if (utils.Flags.Managed || utils.Flags.Sync) && !deployer.IsSelective() {
+		deployer.touched[TASK_MANAGED] = true
 		if err := deployer.RefreshManagedEntities(deployer.ManagedAnnotation); err != nil {
 			errString := wski18n.T(wski18n.ID_MSG_MANAGED_UNDEPLOYMENT_FAILED)
 			whisk.Debug(whisk.DbgError, errString)
@@ -398,6 +476,10 @@ func (deployer *ServiceDeployer) DeployDependencies() error {
 				}
 
 			} else {
+				// a dependency requested by several packages is deployed once
+				if !deployer.DependencyGraph.markDeployed(depName) {
+					continue
+				}
 				depServiceDeployer, err := deployer.getDependentDeployer(depName, depRecord)
 				if err != nil {
 					return err
@@ -521,9 +603,9 @@ func (deployer *ServiceDeployer) RefreshManagedActions(packageName string, ma ma
 				wskprint.PrintOpenWhiskWarning(output)
 
 				var err error
-				err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
-					_, err := deployer.Client.Actions.Delete(actionName)
-					return err
+				err = retry(deployer.Retry, func() (*http.Response, error) {
+					response, err := deployer.Client.Actions.Delete(actionName)
+					return response, err
 				})
 
 				if err != nil {
@@ -561,10 +643,17 @@ func (deployer *ServiceDeployer) RefreshManagedTriggers(ma map[string]interface{
 						wski18n.KEY_PROJECT: ma[utils.OW_PROJECT_NAME]})
 				wskprint.PrintOpenWhiskWarning(output)
 
+				// the feed provider, e.g. alarms, is told to stop firing the trigger first
+				if feedname, isFeed := utils.IsFeedAction(&trigger); isFeed {
+					if err := deployer.deleteFeedAction(&trigger, feedname); err != nil {
+						return err
+					}
+				}
+
 				var err error
-				err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
-					_, _, err := deployer.Client.Triggers.Delete(trigger.Name)
-					return err
+				err = retry(deployer.Retry, func() (*http.Response, error) {
+					_, response, err := deployer.Client.Triggers.Delete(trigger.Name)
+					return response, err
 				})
 
 				if err != nil {
@@ -603,9 +692,9 @@ func (deployer *ServiceDeployer) RefreshManagedRules(ma map[string]interface{})
 				wskprint.PrintOpenWhiskWarning(output)
 
 				var err error
-				err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
-					_, err := deployer.Client.Rules.Delete(rule.Name)
-					return err
+				err = retry(deployer.Retry, func() (*http.Response, error) {
+					response, err := deployer.Client.Rules.Delete(rule.Name)
+					return response, err
 				})
 
 				if err != nil {
@@ -649,9 +738,9 @@ func (deployer *ServiceDeployer) RefreshManagedPackages(ma map[string]interface{
 				wskprint.PrintOpenWhiskWarning(output)
 
 				var err error
-				err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
-					_, err := deployer.Client.Packages.Delete(pkg.Name)
-					return err
+				err = retry(deployer.Retry, func() (*http.Response, error) {
+					response, err := deployer.Client.Packages.Delete(pkg.Name)
+					return response, err
 				})
 
 				if err != nil {
@@ -725,22 +814,13 @@ func (deployer *ServiceDeployer) RefreshManagedPackagesWithDependencies(ma map[s
 		}
 		p.Package.Annotations.AddOrReplace(&updatedAnnotation)
 	}
-	if err := deployer.DeployPackages(); err != nil {
-		return err
-	}
-	return nil
-}
-
-func (deployer *ServiceDeployer) DeployPackages() error {
 	for _, pack := range deployer.Deployment.Packages {
 		// "default" package is a reserved package name
 		// all openwhisk entities will be deployed under
 		// /<namespace> instead of /<namespace>/<package> and
-		// therefore skip creating a new package and set
-		// deployer.DeployActionInPackage to false which is set to true by default
+		// therefore skip creating a new package
 		if strings.ToLower(pack.Package.Name) != parsers.DEFAULT_PACKAGE {
-			err := deployer.createPackage(pack.Package)
-			if err != nil {
+			if err := deployer.createPackage(pack.Package); err != nil {
 				return err
 			}
 		}
@@ -748,139 +828,112 @@ func (deployer *ServiceDeployer) DeployPackages() error {
 	return nil
 }
 
-// Deploy Sequences into OpenWhisk
-func (deployer *ServiceDeployer) DeploySequences() error {
+func (deployer *ServiceDeployer) createBinding(packa *whisk.BindingPackage) error {
 
-	for _, pack := range deployer.Deployment.Packages {
-		for _, action := range pack.Sequences {
-			err := deployer.createAction(pack.Package.Name, action.Action)
-			if err != nil {
-				return err
-			}
-		}
-	}
-	return nil
-}
+	displayPreprocessingInfo(wski18n.PACKAGE_BINDING, packa.Name, true)
 
-// Deploy Actions into OpenWhisk
-func (deployer *ServiceDeployer) DeployActions() error {
+	var err error
+	var response *http.Response
+	err = retry(deployer.Retry, func() (*http.Response, error) {
+		_, response, err = deployer.Client.Packages.Insert(packa, true)
+		return response, err
+	})
 
-	for _, pack := range deployer.Deployment.Packages {
-		for _, action := range pack.Actions {
-			err := deployer.createAction(pack.Package.Name, action.Action)
-			if err != nil {
-				return err
-			}
-		}
+	if err != nil {
+		return createWhiskClientError(err, response, wski18n.PACKAGE_BINDING, true)
 	}
+
+	displayPostprocessingInfo(wski18n.PACKAGE_BINDING, packa.Name, true)
 	return nil
 }
 
-// Deploy Triggers into OpenWhisk
-func (deployer *ServiceDeployer) DeployTriggers() error {
-	for _, trigger := range deployer.Deployment.Triggers {
+func (deployer *ServiceDeployer) createPackage(packa *whisk.Package) error {
 
-		if feedname, isFeed := utils.IsFeedAction(trigger); isFeed {
-			err := deployer.createFeedAction(trigger, feedname)
-			if err != nil {
-				return err
-			}
-		} else {
-			err := deployer.createTrigger(trigger)
-			if err != nil {
+	displayPreprocessingInfo(parsers.YAML_KEY_PACKAGE, packa.Name, true)
+
+	digest := packageDigest(packa)
+	packa.Annotations = withDigest(packa.Annotations, digest)
+	id := taskID(parsers.YAML_KEY_PACKAGE, packa.Name)
+	remote, _, err := deployer.Client.Packages.Get(packa.Name)
+	if err == nil && hasDigest(remote.Annotations, digest) &&
+		deployer.isUnchanged(parsers.YAML_KEY_PACKAGE, remote.Namespace, remote.Name, remote.Updated, func() bool {
+			return len(diffPackage(packa, remote)) == 0
+		}) {
+		deployer.setOperation(id, OPERATION_UNCHANGED)
+		// the managed annotation still holds the project hash of an earlier deployment
+		if hasStaleProjectHash(remote.Annotations, packa.Annotations) {
+			if err := deployer.insertPackage(packa); err != nil {
 				return err
 			}
 		}
-
+		displayUnchangedInfo(parsers.YAML_KEY_PACKAGE, packa.Name)
+		return nil
 	}
-	return nil
-
-}
+	deployer.setOperation(id, deployOperation(err == nil))
 
-// Deploy Rules into OpenWhisk
-func (deployer *ServiceDeployer) DeployRules() error {
-	for _, rule := range deployer.Deployment.Rules {
-		err := deployer.createRule(rule)
-		if err != nil {
-			return err
-		}
+	if err := deployer.insertPackage(packa); err != nil {
+		return err
 	}
-	return nil
-}
 
-// Deploy Apis into OpenWhisk
-func (deployer *ServiceDeployer) DeployApis() error {
-	var err error
-	// NOTE: Only deploy either swagger or manifest defined api, but not both
-	// NOTE: Swagger API takes precedence
-	if deployer.Deployment.SwaggerApi != nil && deployer.Deployment.SwaggerApiOptions != nil {
-		err = deployer.createSwaggerApi(deployer.Deployment.SwaggerApi)
-		if err != nil {
-			return err
-		}
-	} else {
-		for _, api := range deployer.Deployment.Apis {
-			err = deployer.createApi(api)
-			if err != nil {
-				return err
-			}
-		}
-	}
+	displayPostprocessingInfo(parsers.YAML_KEY_PACKAGE, packa.Name, true)
 	return nil
 }
 
-func (deployer *ServiceDeployer) createBinding(packa *whisk.BindingPackage) error {
-
-	displayPreprocessingInfo(wski18n.PACKAGE_BINDING, packa.Name, true)
-
+func (deployer *ServiceDeployer) insertPackage(packa *whisk.Package) error {
 	var err error
 	var response *http.Response
-	err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
+	err = retry(deployer.Retry, func() (*http.Response, error) {
 		_, response, err = deployer.Client.Packages.Insert(packa, true)
-		return err
+		return response, err
 	})
-
 	if err != nil {
-		return createWhiskClientError(err.(*whisk.WskError), response, wski18n.PACKAGE_BINDING, true)
+		return createWhiskClientError(err, response, parsers.YAML_KEY_PACKAGE, true)
 	}
-
-	displayPostprocessingInfo(wski18n.PACKAGE_BINDING, packa.Name, true)
 	return nil
 }
 
-func (deployer *ServiceDeployer) createPackage(packa *whisk.Package) error {
+func (deployer *ServiceDeployer) createTrigger(trigger *whisk.Trigger) error {
 
-	displayPreprocessingInfo(parsers.YAML_KEY_PACKAGE, packa.Name, true)
+	displayPreprocessingInfo(parsers.YAML_KEY_TRIGGER, trigger.Name, true)
 
-	var err error
-	var response *http.Response
-	err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
-		_, response, err = deployer.Client.Packages.Insert(packa, true)
+	digest := triggerDigest(trigger)
+	trigger.Annotations = withDigest(trigger.Annotations, digest)
+	id := taskID(parsers.YAML_KEY_TRIGGER, trigger.Name)
+	remote, _, err := deployer.Client.Triggers.Get(trigger.Name)
+	if err == nil && hasDigest(remote.Annotations, digest) &&
+		deployer.isUnchanged(parsers.YAML_KEY_TRIGGER, remote.Namespace, remote.Name, remote.Updated, func() bool {
+			return len(diffTrigger(trigger, remote)) == 0
+		}) {
+		deployer.setOperation(id, OPERATION_UNCHANGED)
+		// the managed annotation still holds the project hash of an earlier deployment
+		if hasStaleProjectHash(remote.Annotations, trigger.Annotations) {
+			if err := deployer.insertTrigger(trigger); err != nil {
+				return err
+			}
+		}
+		displayUnchangedInfo(parsers.YAML_KEY_TRIGGER, trigger.Name)
+		return nil
+	}
+	deployer.setOperation(id, deployOperation(err == nil))
+
+	if err := deployer.insertTrigger(trigger); err != nil {
 		return err
-	})
-	if err != nil {
-		return createWhiskClientError(err.(*whisk.WskError), response, parsers.YAML_KEY_PACKAGE, true)
 	}
 
-	displayPostprocessingInfo(parsers.YAML_KEY_PACKAGE, packa.Name, true)
+	displayPostprocessingInfo(parsers.YAML_KEY_TRIGGER, trigger.Name, true)
 	return nil
 }
 
-func (deployer *ServiceDeployer) createTrigger(trigger *whisk.Trigger) error {
-
-	displayPreprocessingInfo(parsers.YAML_KEY_TRIGGER, trigger.Name, true)
-
+func (deployer *ServiceDeployer) insertTrigger(trigger *whisk.Trigger) error {
 	var err error
 	var response *http.Response
-	err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
+	err = retry(deployer.Retry, func() (*http.Response, error) {
 		_, response, err = deployer.Client.Triggers.Insert(trigger, true)
-		return err
+		return response, err
 	})
 	if err != nil {
-		return createWhiskClientError(err.(*whisk.WskError), response, parsers.YAML_KEY_TRIGGER, true)
+		return createWhiskClientError(err, response, parsers.YAML_KEY_TRIGGER, true)
 	}
-
-	displayPostprocessingInfo(parsers.YAML_KEY_TRIGGER, trigger.Name, true)
 	return nil
 }
 
@@ -888,18 +941,7 @@ func (deployer *ServiceDeployer) createFeedAction(trigger *whisk.Trigger, feedNa
 
 	displayPreprocessingInfo(wski18n.TRIGGER_FEED, trigger.Name, true)
 
-	// to hold and modify trigger parameters, not passed by ref?
-	params := make(map[string]interface{})
-
-	// check for strings that are JSON
-	for _, keyVal := range trigger.Parameters {
-		params[keyVal.Key] = keyVal.Value
-	}
-
-	// TODO() define keys and lifecycle operation names as const
-	params["authKey"] = deployer.ClientConfig.AuthToken
-	params["lifecycleEvent"] = "CREATE"
-	params["triggerName"] = "/" + deployer.Client.Namespace + "/" + trigger.Name
+	inputs := deployer.feedInputs(feedName, trigger.Parameters)
 
 	pub := true
 	t := &whisk.Trigger{
@@ -912,46 +954,122 @@ func (deployer *ServiceDeployer) createFeedAction(trigger *whisk.Trigger, feedNa
 	// does not honor UPDATE or overwrite=true with CREATE
 	// wskdeploy is designed such that, it updates trigger feeds if they exists
 	// or creates new in case they are missing
-	// To address trigger feed UPDATE issue, we are checking here if trigger feed
-	// exists, if so, delete it and recreate it
+	// To address trigger feed UPDATE issue, the configuration of an existing trigger
+	// feed is read and only updated when it changed, feeds which cannot be read or
+	// updated are deleted and recreated
 	_, r, _ := deployer.Client.Triggers.Get(trigger.Name)
-	if r.StatusCode == 200 {
+	exists := r != nil && r.StatusCode == 200
+	if exists {
+		updated, err := deployer.updateFeedAction(t, feedName, inputs)
+		if err != nil {
+			return err
+		}
+		if updated {
+			displayPostprocessingInfo(wski18n.TRIGGER_FEED, trigger.Name, true)
+			return nil
+		}
 		// trigger feed already exists so first lets delete it and then recreate it
-		deployer.deleteFeedAction(trigger, feedName)
+		if err := deployer.deleteFeedAction(trigger, feedName); err != nil {
+			wskderrors.SetEntity(err, taskID(parsers.YAML_KEY_TRIGGER, trigger.Name))
+			return err
+		}
 	}
 
-	var err error
-	var response *http.Response
-	if err = deployer.createTrigger(t); err != nil {
+	if err := deployer.createTrigger(t); err != nil {
 		return err
 	}
-	qName, err := utils.ParseQualifiedName(feedName, deployer.ClientConfig.Namespace)
+	deployer.setOperation(taskID(parsers.YAML_KEY_TRIGGER, trigger.Name), deployOperation(exists))
+
+	_, err := deployer.invokeFeedAction(trigger.Name, feedName, parsers.FEED_OPERATION_CREATE, inputs)
+	if err == nil && deployer.Deployment.TriggerStatus[trigger.Name] == parsers.YAML_VALUE_INACTIVE {
+		_, err = deployer.invokeFeedAction(trigger.Name, feedName, parsers.FEED_OPERATION_PAUSE, inputs)
+	}
 	if err != nil {
+		// Remove the created trigger
+		retry(deployer.Retry, func() (*http.Response, error) {
+			_, response, err := deployer.Client.Triggers.Delete(trigger.Name)
+			return response, err
+		})
+
 		return err
 	}
 
-	namespace := deployer.Client.Namespace
-	deployer.Client.Namespace = qName.Namespace
-	err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
-		_, response, err = deployer.Client.Actions.Invoke(qName.EntityName, params, true, false)
-		return err
-	})
-	deployer.Client.Namespace = namespace
+	displayPostprocessingInfo(wski18n.TRIGGER_FEED, trigger.Name, true)
+	return nil
+}
+
+// updateFeedAction reads the configuration and the status of the feed of an existing trigger,
+// then updates, pauses or unpauses the feed only if they differ from the manifest. It returns
+// false when the feed cannot be read or updated, i.e. has to be deleted and created again.
+func (deployer *ServiceDeployer) updateFeedAction(trigger *whisk.Trigger, feedName string, inputs map[string]interface{}) (bool, error) {
+	if _, supported := deployer.feedHandler(feedName, parsers.FEED_OPERATION_UPDATE); !supported {
+		return false, nil
+	}
+	if _, supported := deployer.feedHandler(feedName, parsers.FEED_OPERATION_READ); !supported {
+		return false, nil
+	}
 
+	// feeds which predate the READ lifecycle event fail to answer it
+	result, err := deployer.invokeFeedAction(trigger.Name, feedName, parsers.FEED_OPERATION_READ, inputs)
 	if err != nil {
-		// Remove the created trigger
-		deployer.Client.Triggers.Delete(trigger.Name)
+		wskprint.PrintlnOpenWhiskVerbose(utils.Flags.Verbose, wski18n.T(wski18n.ID_MSG_FEED_READ_FAILED_X_trigger_X_err_X,
+			map[string]interface{}{wski18n.KEY_TRIGGER: trigger.Name, wski18n.KEY_ERR: err.Error()}))
+		return false, nil
+	}
 
-		retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
-			_, _, err := deployer.Client.Triggers.Delete(trigger.Name)
-			return err
-		})
+	// the trigger itself only holds annotations, it is updated on its own
+	if err := deployer.createTrigger(trigger); err != nil {
+		return true, err
+	}
 
-		return createWhiskClientError(err.(*whisk.WskError), response, wski18n.TRIGGER_FEED, false)
+	id := taskID(parsers.YAML_KEY_TRIGGER, trigger.Name)
+	if config, _ := result[FEED_RESULT_CONFIG].(map[string]interface{}); feedConfigChanged(config, inputs) {
+		deployer.setOperation(id, OPERATION_UPDATE)
+		if _, err := deployer.invokeFeedAction(trigger.Name, feedName, parsers.FEED_OPERATION_UPDATE, inputs); err != nil {
+			return true, err
+		}
 	}
 
-	displayPostprocessingInfo(wski18n.TRIGGER_FEED, trigger.Name, true)
-	return nil
+	// the status of feeds which do not report it is left alone
+	status, _ := result[FEED_RESULT_STATUS].(map[string]interface{})
+	active, ok := status[FEED_RESULT_ACTIVE].(bool)
+	if inactive := deployer.Deployment.TriggerStatus[trigger.Name] == parsers.YAML_VALUE_INACTIVE; ok && active == inactive {
+		operation := parsers.FEED_OPERATION_UNPAUSE
+		if inactive {
+			operation = parsers.FEED_OPERATION_PAUSE
+		}
+		if _, supported := deployer.feedHandler(feedName, operation); supported {
+			deployer.setOperation(id, OPERATION_UPDATE)
+		}
+		if _, err := deployer.invokeFeedAction(trigger.Name, feedName, operation, inputs); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+// feedConfigChanged tells whether any of the inputs differs from, or is missing in, the
+// configuration the feed returned for the READ lifecycle event, or whether the configuration
+// holds inputs which were removed since
+func feedConfigChanged(config map[string]interface{}, inputs map[string]interface{}) bool {
+	for key, value := range inputs {
+		current, ok := config[key]
+		if !ok || canonicalJSON(current) != canonicalJSON(value) {
+			return true
+		}
+	}
+	for key := range config {
+		if _, ok := inputs[key]; !ok && !isFeedLifecycleParam(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// isFeedLifecycleParam tells whether a parameter is one of the lifecycle event parameters
+// wskdeploy passes to feed actions, which feeds may return along with their configuration
+func isFeedLifecycleParam(key string) bool {
+	return key == FEED_PARAM_AUTH_KEY || key == FEED_PARAM_LIFECYCLE_EVENT || key == FEED_PARAM_TRIGGER_NAME
 }
 
 func (deployer *ServiceDeployer) createRule(rule *whisk.Rule) error {
@@ -964,22 +1082,55 @@ func (deployer *ServiceDeployer) createRule(rule *whisk.Rule) error {
 	// otherwise action should include the namespace with pattern /namespace/action
 	rule.Action = deployer.getQualifiedName(rule.Action.(string))
 
-	var err error
-	var response *http.Response
-	err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
-		_, response, err = deployer.Client.Rules.Insert(rule, true)
-		return err
-	})
+	// the status is not part of the digest, it is enabled or disabled on its own
+	status := rule.Status
+	if len(status) == 0 {
+		status = parsers.YAML_VALUE_ACTIVE
+	}
+
+	digest := ruleDigest(rule)
+	rule.Annotations = withDigest(rule.Annotations, digest)
+	id := taskID(parsers.YAML_KEY_RULE, rule.Name)
+	remote, _, err := deployer.Client.Rules.Get(rule.Name)
+	if err == nil && hasDigest(remote.Annotations, digest) &&
+		deployer.isUnchanged(parsers.YAML_KEY_RULE, remote.Namespace, remote.Name, remote.Updated, func() bool {
+			// the status is compared below
+			for _, diff := range diffRule(rule, remote) {
+				if diff.Field != PLAN_FIELD_STATUS {
+					return false
+				}
+			}
+			return true
+		}) {
+		// the managed annotation still holds the project hash of an earlier deployment
+		if hasStaleProjectHash(remote.Annotations, rule.Annotations) {
+			if err := deployer.insertRule(rule); err != nil {
+				return err
+			}
+		}
+		// the rule may still have been disabled, e.g. when its trigger was recreated
+		if remote.Status == status {
+			deployer.setOperation(id, OPERATION_UNCHANGED)
+			displayUnchangedInfo(parsers.YAML_KEY_RULE, rule.Name)
+			return nil
+		}
+		deployer.setOperation(id, OPERATION_UPDATE)
+		if err := deployer.setRuleStatus(rule.Name, status); err != nil {
+			return err
+		}
+		displayPostprocessingInfo(parsers.YAML_KEY_RULE, rule.Name, true)
+		return nil
+	}
+	deployer.setOperation(id, deployOperation(err == nil))
 
-	if err != nil {
-		return createWhiskClientError(err.(*whisk.WskError), response, parsers.YAML_KEY_RULE, true)
+	if err := deployer.insertRule(rule); err != nil {
+		return err
 	}
 
 	// Consecutive deployments of manifest containing trigger with feed action (and rule) result in inactive
 	// rule. The rule seems to become inactive when its trigger get deleted (part of the wskdeploy feed action update)
-	// Currently simply always setting rule status to active in case not specified implicitly
-	_, _, err = deployer.Client.Rules.SetState(rule.Name, "active")
-	if err != nil {
+	// Currently simply always setting the rule status, active in case not specified explicitly
+	if err := deployer.setRuleStatus(rule.Name, status); err != nil {
 		return err
 	}
 
@@ -987,6 +1138,33 @@ func (deployer *ServiceDeployer) createRule(rule *whisk.Rule) error {
 	return nil
 }
 
+func (deployer *ServiceDeployer) insertRule(rule *whisk.Rule) error {
+	var err error
+	var response *http.Response
+	err = retry(deployer.Retry, func() (*http.Response, error) {
+		_, response, err = deployer.Client.Rules.Insert(rule, true)
+		return response, err
+	})
+	if err != nil {
+		return createWhiskClientError(err, response, parsers.YAML_KEY_RULE, true)
+	}
+	return nil
+}
+
+// setRuleStatus enables or disables the rule
+func (deployer *ServiceDeployer) setRuleStatus(name string, status string) error {
+	var err error
+	var response *http.Response
+	err = retry(deployer.Retry, func() (*http.Response, error) {
+		_, response, err = deployer.Client.Rules.SetState(name, status)
+		return response, err
+	})
+	if err != nil {
+		return createWhiskClientError(err, response, parsers.YAML_KEY_RULE, true)
+	}
+	return nil
+}
+
 // Utility function to call go-whisk framework to make action
 func (deployer *ServiceDeployer) createAction(pkgname string, action *whisk.Action) error {
 	// call ActionService through the Client
@@ -997,21 +1175,65 @@ func (deployer *ServiceDeployer) createAction(pkgname string, action *whisk.Acti
 
 	displayPreprocessingInfo(parsers.YAML_KEY_ACTION, action.Name, true)
 
+	// the action code is only fetched when the action was updated since the last deployment
+	digest := actionDigest(action)
+	action.Annotations = withDigest(action.Annotations, digest)
+	id := actionTaskID(action.Name)
+	remote, _, err := deployer.Client.Actions.Get(action.Name, false)
+	if err == nil && hasDigest(remote.Annotations, digest) &&
+		deployer.isUnchanged(parsers.YAML_KEY_ACTION, remote.Namespace, remote.Name, remote.Updated, func() bool {
+			return deployer.isActionUnchanged(pkgname, action)
+		}) {
+		deployer.setOperation(id, OPERATION_UNCHANGED)
+		// the managed annotation still holds the project hash of an earlier deployment,
+		// only the annotations are updated and the code is not sent again
+		if hasStaleProjectHash(remote.Annotations, action.Annotations) {
+			annotated := &whisk.Action{Namespace: action.Namespace, Name: action.Name, Annotations: action.Annotations}
+			if err := deployer.insertAction(annotated); err != nil {
+				return err
+			}
+		}
+		displayUnchangedInfo(parsers.YAML_KEY_ACTION, action.Name)
+		return nil
+	}
+	deployer.setOperation(id, deployOperation(err == nil))
+
+	if err := deployer.insertAction(action); err != nil {
+		return err
+	}
+
+	displayPostprocessingInfo(parsers.YAML_KEY_ACTION, action.Name, true)
+	return nil
+}
+
+func (deployer *ServiceDeployer) insertAction(action *whisk.Action) error {
 	var err error
 	var response *http.Response
-	err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
+	err = retry(deployer.Retry, func() (*http.Response, error) {
 		_, response, err = deployer.Client.Actions.Insert(action, true)
-		return err
+		return response, err
 	})
-
 	if err != nil {
-		return createWhiskClientError(err.(*whisk.WskError), response, parsers.YAML_KEY_ACTION, true)
+		return createWhiskClientError(err, response, parsers.YAML_KEY_ACTION, true)
 	}
-
-	displayPostprocessingInfo(parsers.YAML_KEY_ACTION, action.Name, true)
 	return nil
 }
 
+// isActionUnchanged compares the action with its deployed version, code included
+func (deployer *ServiceDeployer) isActionUnchanged(pkgname string, action *whisk.Action) bool {
+	remote, err := deployer.getRemoteAction(action.Name)
+	if err != nil || remote == nil {
+		return false
+	}
+	var remotePkg *whisk.Package
+	if strings.ToLower(pkgname) != parsers.DEFAULT_PACKAGE {
+		if remotePkg, err = deployer.getRemotePackage(pkgname); err != nil {
+			return false
+		}
+	}
+	return len(diffAction(action, remote, remotePkg)) == 0
+}
+
 func (deployer *ServiceDeployer) getAnnotationsFromPackageActionOrSequence(packageActionName string) *whisk.KeyValueArr {
 
 	if len(packageActionName) != 0 {
@@ -1069,13 +1291,13 @@ func (deployer *ServiceDeployer) createApi(api *whisk.ApiCreateRequest) error {
 
 	apiCreateReqOptions.AccessToken = deployer.Client.Config.ApigwAccessToken
 
-	err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
+	err = retry(deployer.Retry, func() (*http.Response, error) {
 		_, response, err = deployer.Client.Apis.Insert(api, apiCreateReqOptions, true)
-		return err
+		return response, err
 	})
 
 	if err != nil {
-		return createWhiskClientError(err.(*whisk.WskError), response, parsers.YAML_KEY_API, true)
+		return createWhiskClientError(err, response, parsers.YAML_KEY_API, true)
 	}
 
 	displayPostprocessingInfo(parsers.YAML_KEY_API, apiPath, true)
@@ -1097,13 +1319,13 @@ func (deployer *ServiceDeployer) createSwaggerApi(api *whisk.ApiCreateRequest) e
 		apiCreateReqOptions.SpaceGuid = strings.Split(deployer.Client.Config.AuthToken, ":")[0]
 	}
 
-	err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
+	err = retry(deployer.Retry, func() (*http.Response, error) {
 		_, response, err = deployer.Client.Apis.Insert(api, apiCreateReqOptions, true)
-		return err
+		return response, err
 	})
 
 	if err != nil {
-		return createWhiskClientError(err.(*whisk.WskError), response, parsers.YAML_KEY_API, true)
+		return createWhiskClientError(err, response, parsers.YAML_KEY_API, true)
 	}
 
 	return nil
@@ -1111,17 +1333,22 @@ func (deployer *ServiceDeployer) createSwaggerApi(api *whisk.ApiCreateRequest) e
 
 func (deployer *ServiceDeployer) UnDeploy(verifiedPlan *DeploymentProject) error {
 	if deployer.Preview == true {
+		if wskprint.IsStructuredOutput() {
+			return wskprint.PrintOpenWhiskDocument(deployer.previewResult(wski18n.CMD_UNDEPLOY, verifiedPlan))
+		}
 		deployer.printDeploymentAssets(verifiedPlan)
 		return nil
 	}
 
+	start := time.Now()
 	if err := deployer.unDeployAssets(verifiedPlan); err != nil {
 		wskprint.PrintOpenWhiskError(wski18n.T(wski18n.T(wski18n.ID_MSG_UNDEPLOYMENT_FAILED)))
+		deployer.printDeploymentResult(wski18n.CMD_UNDEPLOY, start, err)
 		return err
 	}
 
 	wskprint.PrintOpenWhiskSuccess(wski18n.T(wski18n.T(wski18n.ID_MSG_UNDEPLOYMENT_SUCCEEDED)))
-	return nil
+	return deployer.printDeploymentResult(wski18n.CMD_UNDEPLOY, start, nil)
 }
 
 func (deployer *ServiceDeployer) UnDeployProject() error {
@@ -1159,7 +1386,12 @@ func (deployer *ServiceDeployer) unDeployAssets(verifiedPlan *DeploymentProject)
 		return err
 	}
 
-	if err := deployer.UnDeployDependencies(); err != nil {
+	unDeployDependencies := deployer.UnDeployDependencies
+	if names := deployer.dependencyNames(); len(names) != 0 {
+		unDeployDependencies = deployer.tracked(TASK_DEPENDENCIES, TASK_DEPENDENCIES, strings.Join(names, ", "),
+			OPERATION_DELETE, unDeployDependencies)
+	}
+	if err := unDeployDependencies(); err != nil {
 		return err
 	}
 
@@ -1175,9 +1407,9 @@ func (deployer *ServiceDeployer) UnDeployDependencies() error {
 
 			if depRecord.IsBinding {
 				var err error
-				err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
-					_, err := deployer.Client.Packages.Delete(depName)
-					return err
+				err = retry(deployer.Retry, func() (*http.Response, error) {
+					response, err := deployer.Client.Packages.Delete(depName)
+					return response, err
 				})
 				if err != nil {
 					return err
@@ -1204,12 +1436,12 @@ func (deployer *ServiceDeployer) UnDeployDependencies() error {
 					if _, _, ok := deployer.Client.Packages.Get(depName); ok == nil {
 						var err error
 						var response *http.Response
-						err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
+						err = retry(deployer.Retry, func() (*http.Response, error) {
 							response, err = deployer.Client.Packages.Delete(depName)
-							return err
+							return response, err
 						})
 						if err != nil {
-							return createWhiskClientError(err.(*whisk.WskError), response, wski18n.PACKAGE_BINDING, false)
+							return createWhiskClientError(err, response, wski18n.PACKAGE_BINDING, false)
 						}
 					}
 				}
@@ -1236,8 +1468,10 @@ func (deployer *ServiceDeployer) UnDeployPackages(deployment *DeploymentProject)
 		// all openwhisk entities were deployed under
 		// /<namespace> instead of /<namespace>/<package> and
 		// therefore skip deleting default package during undeployment
-		if strings.ToLower(pack.Package.Name) != parsers.DEFAULT_PACKAGE {
-			err := deployer.deletePackage(pack.Package)
+		if strings.ToLower(pack.Package.Name) != parsers.DEFAULT_PACKAGE && !deployer.isContainer(pack.Package.Name) {
+			packa := pack.Package
+			err := deployer.tracked(taskID(parsers.YAML_KEY_PACKAGE, packa.Name), parsers.YAML_KEY_PACKAGE, packa.Name,
+				OPERATION_DELETE, func() error { return deployer.deletePackage(packa) })()
 			if err != nil {
 				return err
 			}
@@ -1250,7 +1484,7 @@ func (deployer *ServiceDeployer) UnDeploySequences(deployment *DeploymentProject
 
 	for _, pack := range deployment.Packages {
 		for _, action := range pack.Sequences {
-			err := deployer.deleteAction(pack.Package.Name, action.Action)
+			err := deployer.trackedDeleteAction(parsers.YAML_KEY_SEQUENCE, pack.Package.Name, action.Action)
 			if err != nil {
 				return err
 			}
@@ -1264,7 +1498,7 @@ func (deployer *ServiceDeployer) UnDeployActions(deployment *DeploymentProject)
 
 	for _, pack := range deployment.Packages {
 		for _, action := range pack.Actions {
-			err := deployer.deleteAction(pack.Package.Name, action.Action)
+			err := deployer.trackedDeleteAction(parsers.YAML_KEY_ACTION, pack.Package.Name, action.Action)
 			if err != nil {
 				return err
 			}
@@ -1277,13 +1511,16 @@ func (deployer *ServiceDeployer) UnDeployActions(deployment *DeploymentProject)
 func (deployer *ServiceDeployer) UnDeployTriggers(deployment *DeploymentProject) error {
 
 	for _, trigger := range deployment.Triggers {
-		if feedname, isFeed := utils.IsFeedAction(trigger); isFeed {
-			err := deployer.deleteFeedAction(trigger, feedname)
-			if err != nil {
-				return err
-			}
-		}
-		err := deployer.deleteTrigger(trigger)
+		trigger := trigger
+		err := deployer.tracked(taskID(parsers.YAML_KEY_TRIGGER, trigger.Name), parsers.YAML_KEY_TRIGGER, trigger.Name,
+			OPERATION_DELETE, func() error {
+				if feedname, isFeed := utils.IsFeedAction(trigger); isFeed {
+					if err := deployer.deleteFeedAction(trigger, feedname); err != nil {
+						return err
+					}
+				}
+				return deployer.deleteTrigger(trigger)
+			})()
 		if err != nil {
 			return err
 		}
@@ -1297,7 +1534,9 @@ func (deployer *ServiceDeployer) UnDeployTriggers(deployment *DeploymentProject)
 func (deployer *ServiceDeployer) UnDeployRules(deployment *DeploymentProject) error {
 
 	for _, rule := range deployment.Rules {
-		err := deployer.deleteRule(rule)
+		rule := rule
+		err := deployer.tracked(taskID(parsers.YAML_KEY_RULE, rule.Name), parsers.YAML_KEY_RULE, rule.Name,
+			OPERATION_DELETE, func() error { return deployer.deleteRule(rule) })()
 		if err != nil {
 			return err
 		}
@@ -1305,10 +1544,22 @@ func (deployer *ServiceDeployer) UnDeployRules(deployment *DeploymentProject) er
 	return nil
 }
 
+// trackedDeleteAction deletes an action or a sequence and records its outcome
+func (deployer *ServiceDeployer) trackedDeleteAction(kind string, pkgname string, action *whisk.Action) error {
+	name := action.Name
+	if pkgname != parsers.DEFAULT_PACKAGE {
+		name = strings.Join([]string{pkgname, action.Name}, parsers.PATH_SEPARATOR)
+	}
+	return deployer.tracked(actionTaskID(name), kind, name, OPERATION_DELETE,
+		func() error { return deployer.deleteAction(pkgname, action) })()
+}
+
 func (deployer *ServiceDeployer) UnDeployApis(deployment *DeploymentProject) error {
 
-	for _, api := range deployment.Apis {
-		err := deployer.deleteApi(api)
+	for apiPath, api := range deployment.Apis {
+		api := api
+		err := deployer.tracked(taskID(parsers.YAML_KEY_API, apiPath), parsers.YAML_KEY_API, apiPath,
+			OPERATION_DELETE, func() error { return deployer.deleteApi(api) })()
 		if err != nil {
 			return err
 		}
@@ -1318,11 +1569,11 @@ func (deployer *ServiceDeployer) UnDeployApis(deployment *DeploymentProject) err
 
 func (deployer *ServiceDeployer) UndeploySwaggerApis(deployment *DeploymentProject) error {
 	api := deployment.SwaggerApi
-	err := deployer.deleteSwaggerApi(api)
-	if err != nil {
-		return err
+	if api == nil {
+		return nil
 	}
-	return nil
+	return deployer.tracked(taskID(parsers.YAML_KEY_API, parsers.YAML_KEY_API), parsers.YAML_KEY_API, parsers.YAML_KEY_API,
+		OPERATION_DELETE, func() error { return deployer.deleteSwaggerApi(api) })()
 }
 
 func (deployer *ServiceDeployer) deletePackage(packa *whisk.Package) error {
@@ -1332,13 +1583,13 @@ func (deployer *ServiceDeployer) deletePackage(packa *whisk.Package) error {
 	if _, _, ok := deployer.Client.Packages.Get(packa.Name); ok == nil {
 		var err error
 		var response *http.Response
-		err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
+		err = retry(deployer.Retry, func() (*http.Response, error) {
 			response, err = deployer.Client.Packages.Delete(packa.Name)
-			return err
+			return response, err
 		})
 
 		if err != nil {
-			return createWhiskClientError(err.(*whisk.WskError), response, parsers.YAML_KEY_PACKAGE, false)
+			return createWhiskClientError(err, response, parsers.YAML_KEY_PACKAGE, false)
 		}
 	}
 	displayPostprocessingInfo(parsers.YAML_KEY_PACKAGE, packa.Name, false)
@@ -1352,13 +1603,13 @@ func (deployer *ServiceDeployer) deleteTrigger(trigger *whisk.Trigger) error {
 	if _, _, ok := deployer.Client.Triggers.Get(trigger.Name); ok == nil {
 		var err error
 		var response *http.Response
-		err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
+		err = retry(deployer.Retry, func() (*http.Response, error) {
 			_, response, err = deployer.Client.Triggers.Delete(trigger.Name)
-			return err
+			return response, err
 		})
 
 		if err != nil {
-			return createWhiskClientError(err.(*whisk.WskError), response, parsers.YAML_KEY_TRIGGER, false)
+			return createWhiskClientError(err, response, parsers.YAML_KEY_TRIGGER, false)
 		}
 	}
 
@@ -1370,47 +1621,91 @@ func (deployer *ServiceDeployer) deleteFeedAction(trigger *whisk.Trigger, feedNa
 
 	displayPreprocessingInfo(parsers.YAML_KEY_FEED, trigger.Name, false)
 
-	params := make(whisk.KeyValueArr, 0)
-	// TODO() define keys and operations as const
-	params = append(params, whisk.KeyValue{Key: "authKey", Value: deployer.ClientConfig.AuthToken})
-	params = append(params, whisk.KeyValue{Key: "lifecycleEvent", Value: "DELETE"})
-	params = append(params, whisk.KeyValue{Key: "triggerName", Value: "/" + deployer.Client.Namespace + "/" + trigger.Name})
+	if _, _, ok := deployer.Client.Triggers.Get(trigger.Name); ok != nil {
+		displayPostprocessingInfo(parsers.YAML_KEY_FEED, trigger.Name, false)
+		return nil
+	}
 
-	parameters := make(map[string]interface{})
-	for _, keyVal := range params {
-		parameters[keyVal.Key] = keyVal.Value
+	if _, err := deployer.invokeFeedAction(trigger.Name, feedName, parsers.FEED_OPERATION_DELETE, deployer.feedInputs(feedName, nil)); err != nil {
+		return err
 	}
+	displayPostprocessingInfo(parsers.YAML_KEY_FEED, trigger.Name, false)
+	return nil
+}
 
-	qName, err := utils.ParseQualifiedName(feedName, deployer.ClientConfig.Namespace)
+// feedInputs returns the parameters of the trigger along with the inputs of its feed, if the
+// feed is a feed of the manifest, which the trigger does not override
+func (deployer *ServiceDeployer) feedInputs(feedName string, parameters whisk.KeyValueArr) map[string]interface{} {
+	inputs := make(map[string]interface{})
+	for _, keyVal := range parameters {
+		inputs[keyVal.Key] = keyVal.Value
+	}
+	if feed, ok := deployer.Deployment.Feeds[feedName]; ok {
+		for key, value := range feed.Inputs {
+			if _, exists := inputs[key]; !exists {
+				inputs[key] = value
+			}
+		}
+	}
+	return inputs
+}
+
+// feedHandler returns the action handling the lifecycle operation of the feed, and whether
+// the feed supports the operation. Feeds deployed outside of the manifest support them all.
+func (deployer *ServiceDeployer) feedHandler(feedName string, operation string) (string, bool) {
+	feed, ok := deployer.Deployment.Feeds[feedName]
+	if !ok {
+		return feedName, true
+	}
+	action, supported := feed.Operations[strings.ToUpper(operation)]
+	return action, supported
+}
+
+// invokeFeedAction invokes the action handling the lifecycle operation of the feed for the
+// trigger, with the inputs and the lifecycle event parameters, and returns its result.
+// Operations the feed does not support are skipped, and reported as such.
+func (deployer *ServiceDeployer) invokeFeedAction(triggerName string, feedName string, operation string, inputs map[string]interface{}) (map[string]interface{}, error) {
+	feedAction, supported := deployer.feedHandler(feedName, operation)
+	if !supported {
+		wskprint.PrintlnOpenWhiskVerbose(utils.Flags.Verbose, wski18n.T(wski18n.ID_MSG_FEED_OPERATION_UNSUPPORTED_X_feed_X_operation_X,
+			map[string]interface{}{wski18n.KEY_TRIGGER_FEED: feedName, wski18n.KEY_OPERATION: operation}))
+		return nil, nil
+	}
+	qName, err := utils.ParseQualifiedName(feedAction, deployer.ClientConfig.Namespace)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if _, _, ok := deployer.Client.Triggers.Get(trigger.Name); ok != nil {
-		displayPostprocessingInfo(parsers.YAML_KEY_FEED, trigger.Name, false)
-		return nil
+	params := make(map[string]interface{})
+	for key, value := range inputs {
+		params[key] = value
 	}
+	params[FEED_PARAM_AUTH_KEY] = deployer.ClientConfig.AuthToken
+	params[FEED_PARAM_LIFECYCLE_EVENT] = strings.ToUpper(operation)
+	params[FEED_PARAM_TRIGGER_NAME] = "/" + deployer.Client.Namespace + "/" + triggerName
 
-	namespace := deployer.Client.Namespace
-	deployer.Client.Namespace = qName.Namespace
+	// the feed action is invoked through its own client as entities
+	// may be deployed concurrently using the deployer's client
+	feedClient, err := deployer.getNamespaceClient(qName.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var result interface{}
 	var response *http.Response
-	err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
-		_, response, err = deployer.Client.Actions.Invoke(qName.EntityName, parameters, true, false)
-		return err
+	// feed actions register the trigger, they are only invoked again when they did not run
+	err = retryIf(deployer.Retry, isRetryableInvocation, func() (*http.Response, error) {
+		result, response, err = feedClient.Actions.Invoke(qName.EntityName, params, true, true)
+		return response, err
 	})
 
-	deployer.Client.Namespace = namespace
-
 	if err != nil {
-		wskErr := err.(*whisk.WskError)
 		errString := wski18n.T(wski18n.ID_ERR_FEED_INVOKE_X_err_X_code_X,
-			map[string]interface{}{wski18n.KEY_ERR: wskErr.Error(), wski18n.KEY_CODE: strconv.Itoa(wskErr.ExitCode)})
+			map[string]interface{}{wski18n.KEY_ERR: err.Error(), wski18n.KEY_CODE: strconv.Itoa(whiskErrorCode(err))})
 		whisk.Debug(whisk.DbgError, errString)
-		return wskderrors.NewWhiskClientError(wskErr.Error(), wskErr.ExitCode, response)
-
+		return nil, wskderrors.NewWhiskClientError(err.Error(), whiskErrorCode(err), response)
 	}
-	displayPostprocessingInfo(parsers.YAML_KEY_FEED, trigger.Name, false)
-	return nil
+	fields, _ := result.(map[string]interface{})
+	return fields, nil
 }
 
 func (deployer *ServiceDeployer) deleteRule(rule *whisk.Rule) error {
@@ -1420,13 +1715,13 @@ func (deployer *ServiceDeployer) deleteRule(rule *whisk.Rule) error {
 	if _, _, ok := deployer.Client.Rules.Get(rule.Name); ok == nil {
 		var err error
 		var response *http.Response
-		err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
+		err = retry(deployer.Retry, func() (*http.Response, error) {
 			response, err = deployer.Client.Rules.Delete(rule.Name)
-			return err
+			return response, err
 		})
 
 		if err != nil {
-			return createWhiskClientError(err.(*whisk.WskError), response, parsers.YAML_KEY_RULE, false)
+			return createWhiskClientError(err, response, parsers.YAML_KEY_RULE, false)
 		}
 	}
 	displayPostprocessingInfo(parsers.YAML_KEY_RULE, rule.Name, false)
@@ -1484,13 +1779,13 @@ func (deployer *ServiceDeployer) deleteApi(api *whisk.ApiCreateRequest) error {
 
 		a := new(whisk.ApiDeleteRequest)
 
-		err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
+		err = retry(deployer.Retry, func() (*http.Response, error) {
 			response, err = deployer.Client.Apis.Delete(a, apiDeleteReqOptions)
-			return err
+			return response, err
 		})
 
 		if err != nil {
-			return createWhiskClientError(err.(*whisk.WskError), response, parsers.YAML_KEY_API, false)
+			return createWhiskClientError(err, response, parsers.YAML_KEY_API, false)
 		}
 	}
 	displayPostprocessingInfo(parsers.YAML_KEY_API, apiPath, false)
@@ -1527,13 +1822,13 @@ func (deployer *ServiceDeployer) deleteSwaggerApi(api *whisk.ApiCreateRequest) e
 	a := new(whisk.ApiDeleteRequest)
 	a.Swagger = swaggerString
 
-	err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
+	err = retry(deployer.Retry, func() (*http.Response, error) {
 		response, err = deployer.Client.Apis.Delete(a, apiDeleteReqOptions)
-		return err
+		return response, err
 	})
 
 	if err != nil {
-		return createWhiskClientError(err.(*whisk.WskError), response, parsers.YAML_KEY_API, true)
+		return createWhiskClientError(err, response, parsers.YAML_KEY_API, true)
 	}
 
 	return nil
@@ -1552,13 +1847,13 @@ func (deployer *ServiceDeployer) deleteAction(pkgname string, action *whisk.Acti
 	if _, _, ok := deployer.Client.Actions.Get(action.Name, false); ok == nil {
 		var err error
 		var response *http.Response
-		err = retry(DEFAULT_ATTEMPTS, DEFAULT_INTERVAL, func() error {
+		err = retry(deployer.Retry, func() (*http.Response, error) {
 			response, err = deployer.Client.Actions.Delete(action.Name)
-			return err
+			return response, err
 		})
 
 		if err != nil {
-			return createWhiskClientError(err.(*whisk.WskError), response, parsers.YAML_KEY_ACTION, false)
+			return createWhiskClientError(err, response, parsers.YAML_KEY_ACTION, false)
 
 		}
 	}
@@ -1566,30 +1861,12 @@ func (deployer *ServiceDeployer) deleteAction(pkgname string, action *whisk.Acti
 	return nil
 }
 
-func retry(attempts int, sleep time.Duration, callback func() error) error {
-	var err error
-	for i := 0; ; i++ {
-		err = callback()
-		if i >= (attempts - 1) {
-			break
-		}
-		if err != nil {
-			wskErr := err.(*whisk.WskError)
-			if wskErr.ExitCode == CONFLICT_CODE && strings.Contains(wskErr.Error(), CONFLICT_MESSAGE) {
-				time.Sleep(sleep)
-				warningMsg := wski18n.T(wski18n.ID_WARN_COMMAND_RETRY,
-					map[string]interface{}{
-						wski18n.KEY_CMD: strconv.Itoa(i + 1),
-						wski18n.KEY_ERR: err.Error()})
-				wskprint.PrintlnOpenWhiskWarning(warningMsg)
-			} else {
-				return err
-			}
-		} else {
-			return err
-		}
-	}
-	return err
+// getNamespaceClient returns a client sharing the deployer's configuration but
+// targeting the given namespace, e.g. to invoke feed actions of other namespaces
+func (deployer *ServiceDeployer) getNamespaceClient(namespace string) (*whisk.Client, error) {
+	config := *deployer.Client.Config
+	config.Namespace = namespace
+	return CreateNewClient(&config)
 }
 
 //  getQualifiedName(name) returns a fully qualified name given a
@@ -1619,15 +1896,15 @@ func (deployer *ServiceDeployer) printDeploymentAssets(assets *DeploymentProject
 		for _, p := range pack.Package.Parameters {
 			jsonValue, err := utils.PrettyJSON(p.Value)
 			if err != nil {
-				fmt.Printf("        - %s : %s\n", p.Key, wskderrors.STR_UNKNOWN_VALUE)
+				wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("        - %s : %s", p.Key, wskderrors.STR_UNKNOWN_VALUE))
 			} else {
-				fmt.Printf("        - %s : %v\n", p.Key, jsonValue)
+				wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("        - %s : %v", p.Key, jsonValue))
 			}
 		}
 
 		wskprint.PrintlnOpenWhiskOutput("    " + parsers.YAML_KEY_ANNOTATION + ": ")
 		for _, p := range pack.Package.Annotations {
-			fmt.Printf("        - %s : %v\n", p.Key, p.Value)
+			wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("        - %s : %v", p.Key, p.Value))
 
 		}
 
@@ -1649,28 +1926,28 @@ func (deployer *ServiceDeployer) printDeploymentAssets(assets *DeploymentProject
 				if reflect.TypeOf(p.Value).Kind() == reflect.Map {
 					if _, ok := p.Value.(map[interface{}]interface{}); ok {
 						var temp map[string]interface{} = utils.ConvertInterfaceMap(p.Value.(map[interface{}]interface{}))
-						fmt.Printf("        - %s : %v\n", p.Key, temp)
+						wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("        - %s : %v", p.Key, temp))
 					} else {
 						jsonValue, err := utils.PrettyJSON(p.Value)
 						if err != nil {
-							fmt.Printf("        - %s : %s\n", p.Key, wskderrors.STR_UNKNOWN_VALUE)
+							wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("        - %s : %s", p.Key, wskderrors.STR_UNKNOWN_VALUE))
 						} else {
-							fmt.Printf("        - %s : %v\n", p.Key, jsonValue)
+							wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("        - %s : %v", p.Key, jsonValue))
 						}
 					}
 				} else {
 					jsonValue, err := utils.PrettyJSON(p.Value)
 					if err != nil {
-						fmt.Printf("        - %s : %s\n", p.Key, wskderrors.STR_UNKNOWN_VALUE)
+						wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("        - %s : %s", p.Key, wskderrors.STR_UNKNOWN_VALUE))
 					} else {
-						fmt.Printf("        - %s : %v\n", p.Key, jsonValue)
+						wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("        - %s : %v", p.Key, jsonValue))
 					}
 				}
 
 			}
 			wskprint.PrintlnOpenWhiskOutput("    " + parsers.YAML_KEY_ANNOTATION + ": ")
 			for _, p := range action.Action.Annotations {
-				fmt.Printf("        - %s : %v\n", p.Key, p.Value)
+				wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("        - %s : %v", p.Key, p.Value))
 
 			}
 		}
@@ -1680,7 +1957,7 @@ func (deployer *ServiceDeployer) printDeploymentAssets(assets *DeploymentProject
 			wskprint.PrintlnOpenWhiskOutput("  * " + parsers.YAML_KEY_SEQUENCE + ": " + action.Action.Name)
 			wskprint.PrintlnOpenWhiskOutput("    " + parsers.YAML_KEY_ANNOTATION + ": ")
 			for _, p := range action.Action.Annotations {
-				fmt.Printf("        - %s : %v\n", p.Key, p.Value)
+				wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("        - %s : %v", p.Key, p.Value))
 
 			}
 		}
@@ -1696,15 +1973,15 @@ func (deployer *ServiceDeployer) printDeploymentAssets(assets *DeploymentProject
 		for _, p := range trigger.Parameters {
 			jsonValue, err := utils.PrettyJSON(p.Value)
 			if err != nil {
-				fmt.Printf("        - %s : %s\n", p.Key, wskderrors.STR_UNKNOWN_VALUE)
+				wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("        - %s : %s", p.Key, wskderrors.STR_UNKNOWN_VALUE))
 			} else {
-				fmt.Printf("        - %s : %v\n", p.Key, jsonValue)
+				wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("        - %s : %v", p.Key, jsonValue))
 			}
 		}
 
 		wskprint.PrintlnOpenWhiskOutput("    " + parsers.YAML_KEY_ANNOTATION + ": ")
 		for _, p := range trigger.Annotations {
-			fmt.Printf("        - %s : %v\n", p.Key, p.Value)
+			wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("        - %s : %v", p.Key, p.Value))
 
 		}
 	}
@@ -1714,7 +1991,7 @@ func (deployer *ServiceDeployer) printDeploymentAssets(assets *DeploymentProject
 		wskprint.PrintlnOpenWhiskOutput("* " + parsers.YAML_KEY_RULE + ": " + rule.Name)
 		wskprint.PrintlnOpenWhiskOutput("    " + parsers.YAML_KEY_ANNOTATION + ": ")
 		for _, p := range rule.Annotations {
-			fmt.Printf("        - %s : %v\n", p.Key, p.Value)
+			wskprint.PrintlnOpenWhiskOutput(fmt.Sprintf("        - %s : %v", p.Key, p.Value))
 
 		}
 		if reflect.TypeOf(rule.Trigger).Kind() == reflect.String {
@@ -1726,6 +2003,9 @@ func (deployer *ServiceDeployer) printDeploymentAssets(assets *DeploymentProject
 			actionName := action["path"].(string) + parsers.PATH_SEPARATOR + action["name"].(string)
 			wskprint.PrintlnOpenWhiskOutput("    - " + parsers.YAML_KEY_TRIGGER + ": " + triggerName + "\n    - " + parsers.YAML_KEY_ACTION + ": " + actionName)
 		}
+		if len(rule.Status) > 0 {
+			wskprint.PrintlnOpenWhiskOutput("    - " + parsers.YAML_KEY_STATUS + ": " + rule.Status)
+		}
 
 	}
 
@@ -1735,22 +2015,23 @@ func (deployer *ServiceDeployer) printDeploymentAssets(assets *DeploymentProject
 
 func (deployer *ServiceDeployer) getDependentDeployer(depName string, depRecord dependencies.DependencyRecord) (*ServiceDeployer, error) {
 	depServiceDeployer := NewServiceDeployer()
-	projectPath := path.Join(depRecord.ProjectPath, depName+"-"+depRecord.Version)
-	if len(depRecord.SubFolder) > 0 {
-		projectPath = path.Join(projectPath, depRecord.SubFolder)
-	}
+	projectPath := dependencyProjectPath(depName, deployer.DependencyGraph.record(depName, depRecord))
 	manifestPath := utils.GetManifestFilePath(projectPath)
 	deploymentPath := utils.GetDeploymentFilePath(projectPath)
 	depServiceDeployer.ProjectPath = projectPath
 	depServiceDeployer.ManifestPath = manifestPath
 	depServiceDeployer.DeploymentPath = deploymentPath
 	depServiceDeployer.Preview = true
+	depServiceDeployer.Parallelism = deployer.Parallelism
+	depServiceDeployer.Retry = deployer.Retry
 
 	depServiceDeployer.Client = deployer.Client
 	depServiceDeployer.ClientConfig = deployer.ClientConfig
 
-	// share the master dependency list
+	// share the master dependency list, the lockfile and the resolved dependencies of the project
 	depServiceDeployer.DependencyMaster = deployer.DependencyMaster
+	depServiceDeployer.Lockfile = deployer.Lockfile
+	depServiceDeployer.DependencyGraph = deployer.DependencyGraph
 
 	return depServiceDeployer, nil
 }
@@ -1785,7 +2066,15 @@ func displayPostprocessingInfo(entity string, name string, onDeploy bool) {
 	wskprint.PrintlnOpenWhiskVerbose(utils.Flags.Verbose, msg)
 }
 
-func createWhiskClientError(err *whisk.WskError, response *http.Response, entity string, onCreate bool) *wskderrors.WhiskClientError {
+func displayUnchangedInfo(entity string, name string) {
+	msg := wski18n.T(wski18n.ID_MSG_ENTITY_UNCHANGED_X_key_X_name_X,
+		map[string]interface{}{
+			wski18n.KEY_KEY:  entity,
+			wski18n.KEY_NAME: name})
+	wskprint.PrintlnOpenWhiskVerbose(utils.Flags.Verbose, msg)
+}
+
+func createWhiskClientError(err error, response *http.Response, entity string, onCreate bool) *wskderrors.WhiskClientError {
 
 	var msgKey string
 	if onCreate {
@@ -1797,94 +2086,162 @@ func createWhiskClientError(err *whisk.WskError, response *http.Response, entity
 		map[string]interface{}{
 			wski18n.KEY_KEY:  entity,
 			wski18n.KEY_ERR:  err.Error(),
-			wski18n.KEY_CODE: strconv.Itoa(err.ExitCode)})
+			wski18n.KEY_CODE: strconv.Itoa(whiskErrorCode(err))})
 	wskprint.PrintOpenWhiskVerbose(utils.Flags.Verbose, errString)
 
 	// TODO() add errString as an AppendDetail() to WhiskClientError
-	return wskderrors.NewWhiskClientError(err.Error(), err.ExitCode, response)
+	return wskderrors.NewWhiskClientError(err.Error(), whiskErrorCode(err), response)
 }
 
 func (deployer *ServiceDeployer) reportInputs() error {
+	inputs := deployer.displayInputs()
+	if wskprint.IsStructuredOutput() {
+		result := deployer.previewResult(wski18n.CMD_REPORT, deployer.Deployment)
+		result.Inputs = inputs
+		return wskprint.PrintOpenWhiskDocument(result)
+	}
+	for _, i := range inputs {
+		j, err := json.MarshalIndent(i, "", " ")
+		if err != nil {
+			return err
+		}
+		wskprint.PrintlnOpenWhiskOutput(string(j))
+	}
+	return nil
+}
+
+// displayInputs returns the inputs of the project and of each of its packages, dependencies,
+// actions, sequences and triggers along with the file each input was read from, and the
+// annotations of the packages, actions and triggers read from a deployment file
+func (deployer *ServiceDeployer) displayInputs() []parsers.DisplayInputs {
+	var inputs []parsers.DisplayInputs
+
 	// display project level inputs
 	i := make(map[string]interface{}, 0)
 	for name, param := range deployer.ProjectInputs {
 		i[name] = param.Value
 	}
-	projectInputs := parsers.DisplayInputs{Name: deployer.ProjectName, Inputs: i}
-	j, err := json.MarshalIndent(projectInputs, "", " ")
-	if err != nil {
-		return err
-	}
-	wskprint.PrintlnOpenWhiskOutput(string(j))
+	inputs = append(inputs, parsers.DisplayInputs{Name: deployer.ProjectName, Inputs: i,
+		Sources: deployer.sourcesOf(parsers.YAML_KEY_PROJECT, "", i)})
 
 	// display package level inputs
 	// iterate over each package and print inputs section of each package
-	for _, pkg := range deployer.Deployment.Packages {
+	// package parameters hold the inputs of the package bound from the deployment files
+	for pkgName, pkg := range deployer.Deployment.Packages {
 		i := make(map[string]interface{}, 0)
-		for name, param := range pkg.Inputs.Inputs {
-			if _, ok := deployer.ProjectInputs[name]; !ok {
-				i[name] = param.Value
+		for _, param := range pkg.Package.Parameters {
+			if _, ok := deployer.ProjectInputs[param.Key]; !ok {
+				i[param.Key] = param.Value
 			}
 		}
-		packageInputs := parsers.DisplayInputs{Name: pkg.Package.Name, Inputs: i}
-		j, err := json.MarshalIndent(packageInputs, "", "  ")
-		if err != nil {
-			return err
-		}
-		wskprint.PrintlnOpenWhiskOutput(string(j))
+		display := parsers.DisplayInputs{Name: pkg.Package.Name, Inputs: i,
+			Sources: deployer.sourcesOf(parsers.YAML_KEY_PACKAGE, pkgName, i)}
+		display.Annotations, display.AnnotationSources = deployer.annotationsOf(parsers.YAML_KEY_PACKAGE, pkgName, pkg.Package.Annotations)
+		inputs = append(inputs, display)
 
 		for _, d := range pkg.Dependencies {
 			i := make(map[string]interface{}, 0)
 			for _, param := range d.Parameters {
 				i[param.Key] = param.Value
 			}
-			depInputs := parsers.DisplayInputs{Name: d.Location, Inputs: i}
-			j, err := json.MarshalIndent(depInputs, "", " ")
-			if err != nil {
-				return err
-			}
-			wskprint.PrintlnOpenWhiskOutput(string(j))
+			inputs = append(inputs, parsers.DisplayInputs{Name: d.Location, Inputs: i,
+				Sources: deployer.sourcesOf(parsers.YAML_KEY_DEPENDENCY, d.Location, i)})
 		}
 
-		for _, a := range pkg.Actions {
+		for actionName, a := range pkg.Actions {
 			i := make(map[string]interface{}, 0)
 			for _, param := range a.Action.Parameters {
 				i[param.Key] = param.Value
 			}
-
-			actionInputs := parsers.DisplayInputs{Name: a.Action.Name, Inputs: i}
-			j, err := json.MarshalIndent(actionInputs, "", " ")
-			if err != nil {
-				return err
-			}
-			wskprint.PrintlnOpenWhiskOutput(string(j))
+			display := parsers.DisplayInputs{Name: a.Action.Name, Inputs: i,
+				Sources: deployer.sourcesOf(parsers.YAML_KEY_ACTION, path.Join(pkgName, actionName), i)}
+			display.Annotations, display.AnnotationSources = deployer.annotationsOf(parsers.YAML_KEY_ACTION, path.Join(pkgName, actionName), a.Action.Annotations)
+			inputs = append(inputs, display)
 		}
 
-		for _, s := range pkg.Sequences {
+		for sequenceName, s := range pkg.Sequences {
 			i := make(map[string]interface{}, 0)
 			for _, param := range s.Action.Parameters {
 				i[param.Key] = param.Value
 			}
-			seqInputs := parsers.DisplayInputs{Name: s.Action.Name, Inputs: i}
-			j, err := json.MarshalIndent(seqInputs, "", " ")
-			if err != nil {
-				return err
-			}
-			wskprint.PrintlnOpenWhiskOutput(string(j))
+			inputs = append(inputs, parsers.DisplayInputs{Name: s.Action.Name, Inputs: i,
+				Sources: deployer.sourcesOf(parsers.YAML_KEY_SEQUENCE, path.Join(pkgName, sequenceName), i)})
 		}
 	}
 
-	for _, trigger := range deployer.Deployment.Triggers {
+	for triggerName, trigger := range deployer.Deployment.Triggers {
 		i := make(map[string]interface{}, 0)
 		for _, param := range trigger.Parameters {
 			i[param.Key] = param.Value
 		}
-		triggerInputs := parsers.DisplayInputs{Name: trigger.Name, Inputs: i}
-		j, err := json.MarshalIndent(triggerInputs, "", " ")
-		if err != nil {
-			return err
+		display := parsers.DisplayInputs{Name: trigger.Name, Inputs: i,
+			Sources: deployer.sourcesOf(parsers.YAML_KEY_TRIGGER, triggerName, i)}
+		display.Annotations, display.AnnotationSources = deployer.annotationsOf(parsers.YAML_KEY_TRIGGER, triggerName, trigger.Annotations)
+		inputs = append(inputs, display)
+	}
+	return inputs
+}
+
+// setInputSource records the deployment file, or the command line, an input value of an
+// entity was read from
+func (deployer *ServiceDeployer) setInputSource(kind string, name string, input string, source string) {
+	if len(source) == 0 {
+		return
+	}
+	if deployer.inputSources == nil {
+		deployer.inputSources = make(map[string]string)
+	}
+	deployer.inputSources[inputSourceKey(kind, name, input)] = source
+}
+
+func inputSourceKey(kind string, name string, input string) string {
+	return kind + ":" + name + "." + input
+}
+
+// setAnnotationSource records the deployment file an annotation of an entity was read from
+func (deployer *ServiceDeployer) setAnnotationSource(kind string, name string, annotation string, source string) {
+	if len(source) == 0 {
+		return
+	}
+	if deployer.annotationSources == nil {
+		deployer.annotationSources = make(map[string]string)
+	}
+	deployer.annotationSources[inputSourceKey(kind, name, annotation)] = source
+}
+
+// sourcesOf returns the source of each input of an entity, the inputs which were not read
+// from a deployment file or the command line come from the manifest file
+func (deployer *ServiceDeployer) sourcesOf(kind string, name string, inputs map[string]interface{}) map[string]string {
+	sources := make(map[string]string, len(inputs))
+	for input := range inputs {
+		source, ok := deployer.inputSources[inputSourceKey(kind, name, input)]
+		if !ok {
+			source = deployer.ManifestPath
 		}
-		wskprint.PrintlnOpenWhiskOutput(string(j))
+		sources[input] = deployer.relativeSource(source)
 	}
-	return nil
+	return sources
+}
+
+// annotationsOf returns the annotations of an entity which were read from a deployment file,
+// along with the file each of them was read from
+func (deployer *ServiceDeployer) annotationsOf(kind string, name string, annotations whisk.KeyValueArr) (map[string]interface{}, map[string]string) {
+	values := make(map[string]interface{})
+	sources := make(map[string]string)
+	for _, annotation := range annotations {
+		if source, ok := deployer.annotationSources[inputSourceKey(kind, name, annotation.Key)]; ok {
+			values[annotation.Key] = annotation.Value
+			sources[annotation.Key] = deployer.relativeSource(source)
+		}
+	}
+	return values, sources
+}
+
+// relativeSource shows the files under the project path relative to it
+func (deployer *ServiceDeployer) relativeSource(source string) string {
+	if rel, err := filepath.Rel(deployer.ProjectPath, source); err == nil && !strings.HasPrefix(rel, "..") &&
+		filepath.IsAbs(source) {
+		return rel
+	}
+	return source
 }
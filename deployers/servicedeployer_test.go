@@ -0,0 +1,84 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/parsers"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateFeedAction_DeleteFailure(t *testing.T) {
+	requests := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path[strings.Index(r.URL.Path, "/namespaces/"):])
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error": "feed unavailable"}`))
+			return
+		}
+		w.Write([]byte(`{"name": "t"}`))
+	}))
+	defer server.Close()
+
+	client, err := whisk.NewClient(http.DefaultClient, &whisk.Config{Host: server.URL, AuthToken: "user:pass", Namespace: "guest"})
+	assert.Nil(t, err)
+	deployer := NewServiceDeployer()
+	deployer.Client = client
+	deployer.ClientConfig = client.Config
+	// a feed which can not be read is deleted and created again
+	deployer.Deployment.Feeds["p/changes"] = &utils.FeedRecord{Name: "changes", Packagename: "p", Action: "p/changes",
+		Operations: map[string]string{"CREATE": "p/changes", "DELETE": "p/changes"}}
+
+	trigger := &whisk.Trigger{Name: "t", Annotations: whisk.KeyValueArr{{Key: parsers.YAML_KEY_FEED, Value: "p/changes"}}}
+	err = deployer.createFeedAction(trigger, "p/changes")
+	assert.NotNil(t, err, "A failure to delete the feed should fail the deployment of the trigger.")
+	assert.Equal(t, "trigger:t", wskderrors.NewErrorDocument(err).Entity)
+	assert.Equal(t, []string{
+		"GET /namespaces/guest/triggers/t",
+		"GET /namespaces/guest/triggers/t",
+		"POST /namespaces/guest/actions/p/changes",
+	}, requests, "The trigger should not be created again.")
+}
+
+func TestFeedConfigChanged(t *testing.T) {
+	inputs := map[string]interface{}{"topic": "documents", "filter": map[string]interface{}{"kind": "created"}}
+	config := map[string]interface{}{"topic": "documents", "filter": map[string]interface{}{"kind": "created"}}
+	assert.False(t, feedConfigChanged(config, inputs))
+
+	config["triggerName"] = "/guest/t"
+	assert.False(t, feedConfigChanged(config, inputs), "Lifecycle event parameters are not inputs.")
+
+	config["filter"] = map[string]interface{}{"kind": "deleted"}
+	assert.True(t, feedConfigChanged(config, inputs))
+
+	delete(inputs, "filter")
+	delete(config, "filter")
+	config["interval"] = 10
+	assert.True(t, feedConfigChanged(config, inputs), "An input removed from the manifest should update the feed.")
+}
@@ -0,0 +1,403 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/apache/openwhisk-wskdeploy/parsers"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+	"github.com/apache/openwhisk-wskdeploy/wskprint"
+)
+
+// Location of the deployment state, relative to the project path
+const (
+	STATE_DIR     = ".wskdeploy"
+	STATE_FILE    = "state.json"
+	STATE_VERSION = 1
+)
+
+// Possible outcomes for an entity of the deployment state once compared
+// against what is currently deployed in the namespace
+const (
+	STATUS_IN_SYNC  = "in-sync"
+	STATUS_MODIFIED = "modified"
+	STATUS_DELETED  = "deleted"
+)
+
+// EntityState is an entity as it was left on the server by the last successful deployment
+type EntityState struct {
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Digest  string `json:"digest"`
+	Updated int64  `json:"updated"`
+}
+
+// DeploymentState is the content of .wskdeploy/state.json
+type DeploymentState struct {
+	Version   int           `json:"version"`
+	Project   string        `json:"project,omitempty"`
+	Namespace string        `json:"namespace"`
+	Deployed  string        `json:"deployed"`
+	Entities  []EntityState `json:"entities"`
+}
+
+// StatusEntry describes whether an entity recorded in the deployment state changed since
+type StatusEntry struct {
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Updated int64  `json:"updated,omitempty"`
+}
+
+// DeploymentStatus is the deployment state compared against the namespace contents
+type DeploymentStatus struct {
+	State   *DeploymentState `json:"state"`
+	Entries []StatusEntry    `json:"entries"`
+}
+
+// Count returns the number of entries with the given status
+func (status *DeploymentStatus) Count(s string) int {
+	count := 0
+	for _, entry := range status.Entries {
+		if entry.Status == s {
+			count++
+		}
+	}
+	return count
+}
+
+// StatePath returns the location of the deployment state of the project under projectPath
+func StatePath(projectPath string) string {
+	return filepath.Join(projectPath, STATE_DIR, STATE_FILE)
+}
+
+// ReadState loads the deployment state recorded for the project under projectPath
+func ReadState(projectPath string) (*DeploymentState, error) {
+	path := StatePath(projectPath)
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		errMessage := wski18n.T(wski18n.ID_ERR_STATE_FILE_NOT_FOUND_X_path_X,
+			map[string]interface{}{wski18n.KEY_PATH: path})
+		return nil, wskderrors.NewFileReadError(path, errMessage)
+	}
+	if err != nil {
+		return nil, wskderrors.NewFileReadError(path, err.Error())
+	}
+	state := new(DeploymentState)
+	if err := json.Unmarshal(content, state); err != nil {
+		return nil, wskderrors.NewFileReadError(path, err.Error())
+	}
+	return state, nil
+}
+
+// WriteState saves the deployment state for the project under projectPath
+func WriteState(projectPath string, state *DeploymentState) error {
+	path := StatePath(projectPath)
+	content, err := json.MarshalIndent(state, "", "  ")
+	if err == nil {
+		if err = os.MkdirAll(filepath.Dir(path), os.ModePerm); err == nil {
+			err = ioutil.WriteFile(path, append(content, '\n'), 0644)
+		}
+	}
+	if err != nil {
+		errMessage := wski18n.T(wski18n.ID_ERR_STATE_FILE_WRITE_X_path_X_err_X,
+			map[string]interface{}{wski18n.KEY_PATH: path, wski18n.KEY_ERR: err.Error()})
+		return wskderrors.NewFileReadError(path, errMessage)
+	}
+	return nil
+}
+
+// recordState fetches every package, action, sequence, trigger and rule of the deployment
+// plan once deployed and saves their qualified name, digest and last update time.
+// A failure to record the state does not fail the deployment, it is reported instead.
+func (deployer *ServiceDeployer) recordState() {
+	var names []entityName
+	for _, pkgName := range sortedPackageNames(deployer.Deployment.Packages) {
+		pack := deployer.Deployment.Packages[pkgName]
+		isDefault := strings.ToLower(pack.Package.Name) == parsers.DEFAULT_PACKAGE
+		if !isDefault && !deployer.isContainer(pack.Package.Name) {
+			names = append(names, entityName{parsers.YAML_KEY_PACKAGE, pack.Package.Name})
+		}
+		for _, kind := range []string{parsers.YAML_KEY_ACTION, parsers.YAML_KEY_SEQUENCE} {
+			records := pack.Actions
+			if kind == parsers.YAML_KEY_SEQUENCE {
+				records = pack.Sequences
+			}
+			for _, name := range sortedActionNames(records) {
+				actionName := records[name].Action.Name
+				if !isDefault {
+					actionName = strings.Join([]string{pack.Package.Name, actionName}, parsers.PATH_SEPARATOR)
+				}
+				names = append(names, entityName{kind, actionName})
+			}
+		}
+	}
+	for _, name := range sortedTriggerNames(deployer.Deployment.Triggers) {
+		names = append(names, entityName{parsers.YAML_KEY_TRIGGER, deployer.Deployment.Triggers[name].Name})
+	}
+	for _, name := range sortedRuleNames(deployer.Deployment.Rules) {
+		names = append(names, entityName{parsers.YAML_KEY_RULE, deployer.Deployment.Rules[name].Name})
+	}
+
+	state := &DeploymentState{
+		Version:   STATE_VERSION,
+		Project:   deployer.ProjectName,
+		Namespace: deployer.ClientConfig.Namespace,
+		Deployed:  time.Now().UTC().Format(time.RFC3339),
+		Entities:  make([]EntityState, len(names)),
+	}
+
+	g := newDeployGraph()
+	for i, name := range names {
+		entity := &state.Entities[i]
+		entity.Kind, entity.Name = name.kind, name.name
+		g.add(taskID(name.kind, name.name), func() error {
+			remote, err := deployer.getRemoteEntity(entity.Kind, entity.Name)
+			if err == nil && remote != nil {
+				entity.Name, entity.Digest, entity.Updated = remote.qualifiedName(), remote.digest(), remote.updated()
+			}
+			return err
+		})
+	}
+
+	err := g.run(deployer.Parallelism)
+	if err == nil {
+		if len(state.Entities) > 0 {
+			state.Namespace = strings.Split(strings.TrimPrefix(state.Entities[0].Name, parsers.PATH_SEPARATOR), parsers.PATH_SEPARATOR)[0]
+		}
+		if deployer.IsSelective() {
+			mergeState(state, deployer.ProjectPath)
+		}
+		err = WriteState(deployer.ProjectPath, state)
+	}
+	if err != nil {
+		wskprint.PrintOpenWhiskError(err.Error() + "\n")
+	}
+}
+
+// mergeState keeps the entities recorded by the previous deployment which were not
+// part of a selective deployment
+func mergeState(state *DeploymentState, projectPath string) {
+	previous, err := ReadState(projectPath)
+	if err != nil || previous.Namespace != state.Namespace {
+		return
+	}
+	recorded := make(map[entityName]bool)
+	for _, entity := range state.Entities {
+		recorded[entityName{entity.Kind, entity.Name}] = true
+	}
+	for _, entity := range previous.Entities {
+		if !recorded[entityName{entity.Kind, entity.Name}] {
+			state.Entities = append(state.Entities, entity)
+		}
+	}
+}
+
+// deployedEntities returns the update time of the entities recorded by the last deployment
+// of the project under projectPath, keyed by kind and fully qualified name.
+// Nothing is returned when the project was not deployed yet.
+func deployedEntities(projectPath string) map[entityName]int64 {
+	entities := make(map[entityName]int64)
+	state, err := ReadState(projectPath)
+	if err != nil {
+		return entities
+	}
+	for _, entity := range state.Entities {
+		// actions and sequences are deployed alike
+		kind := entity.Kind
+		if kind == parsers.YAML_KEY_SEQUENCE {
+			kind = parsers.YAML_KEY_ACTION
+		}
+		entities[entityName{kind, entity.Name}] = entity.Updated
+	}
+	return entities
+}
+
+// ConstructStatus compares the entities recorded by the last successful deployment
+// with what is currently deployed in the namespace
+func (deployer *ServiceDeployer) ConstructStatus(state *DeploymentState) (*DeploymentStatus, error) {
+	status := &DeploymentStatus{State: state, Entries: make([]StatusEntry, len(state.Entities))}
+
+	g := newDeployGraph()
+	for i, recorded := range state.Entities {
+		entry := &status.Entries[i]
+		entry.Kind, entry.Name = recorded.Kind, recorded.Name
+		expected := recorded
+		g.add(taskID(entry.Kind, entry.Name), func() error {
+			remote, err := deployer.getRemoteEntity(expected.Kind, shortName(expected.Name))
+			switch {
+			case err != nil:
+				return err
+			case remote == nil:
+				entry.Status = STATUS_DELETED
+			case remote.updated() != expected.Updated || remote.digest() != expected.Digest:
+				entry.Status = STATUS_MODIFIED
+				entry.Updated = remote.updated()
+			default:
+				entry.Status = STATUS_IN_SYNC
+			}
+			return nil
+		})
+	}
+
+	if err := g.run(deployer.Parallelism); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// Status reports the entities of the project changed or deleted outside of wskdeploy
+// since its last successful deployment
+func (deployer *ServiceDeployer) Status() error {
+	state, err := ReadState(deployer.ProjectPath)
+	if err != nil {
+		return err
+	}
+
+	// entities are looked up in the namespace they were deployed to
+	if len(state.Namespace) > 0 && state.Namespace != deployer.ClientConfig.Namespace {
+		if deployer.Client, err = deployer.getNamespaceClient(state.Namespace); err != nil {
+			return err
+		}
+	}
+
+	status, err := deployer.ConstructStatus(state)
+	if err != nil {
+		return err
+	}
+	printDeploymentStatus(status)
+	return nil
+}
+
+// entityName is the kind and name of an entity, without namespace
+type entityName struct {
+	kind string
+	name string
+}
+
+// remoteEntity is a package, action, trigger or rule as returned by the server
+type remoteEntity struct {
+	namespace string
+	name      string
+	timestamp int64
+	content   interface{}
+}
+
+func (e *remoteEntity) qualifiedName() string {
+	return parsers.PATH_SEPARATOR + e.namespace + parsers.PATH_SEPARATOR + e.name
+}
+
+func (e *remoteEntity) updated() int64 {
+	return e.timestamp
+}
+
+// digest identifies the deployed content of an entity, values which change on every
+// update such as the version or the update time are not part of it
+func (e *remoteEntity) digest() string {
+	return contentDigest(e.content)
+}
+
+// getRemoteEntity fetches an entity given its kind and its name without namespace,
+// nil is returned when the entity does not exist
+func (deployer *ServiceDeployer) getRemoteEntity(kind string, name string) (*remoteEntity, error) {
+	switch kind {
+	case parsers.YAML_KEY_PACKAGE:
+		pkg, err := deployer.getRemotePackage(name)
+		if pkg == nil {
+			return nil, err
+		}
+		return &remoteEntity{namespace: pkg.Namespace, name: pkg.Name, timestamp: pkg.Updated,
+			content: map[string]interface{}{
+				"binding":     pkg.Binding,
+				"publish":     pkg.Publish,
+				"parameters":  pkg.Parameters,
+				"annotations": pkg.Annotations,
+			}}, nil
+	case parsers.YAML_KEY_ACTION, parsers.YAML_KEY_SEQUENCE:
+		action, err := deployer.getRemoteAction(name)
+		if action == nil {
+			return nil, err
+		}
+		exec := map[string]interface{}{}
+		if action.Exec != nil {
+			exec["kind"] = action.Exec.Kind
+			exec["code"] = codeHash(action.Exec.Code)
+			exec["main"] = action.Exec.Main
+			exec["image"] = action.Exec.Image
+			exec["components"] = action.Exec.Components
+		}
+		return &remoteEntity{namespace: action.Namespace, name: action.Name, timestamp: action.Updated,
+			content: map[string]interface{}{
+				"exec":        exec,
+				"limits":      action.Limits,
+				"parameters":  action.Parameters,
+				"annotations": action.Annotations,
+			}}, nil
+	case parsers.YAML_KEY_TRIGGER:
+		trigger, err := deployer.getRemoteTrigger(name)
+		if trigger == nil {
+			return nil, err
+		}
+		return &remoteEntity{namespace: trigger.Namespace, name: trigger.Name, timestamp: trigger.Updated,
+			content: map[string]interface{}{
+				"parameters":  trigger.Parameters,
+				"annotations": trigger.Annotations,
+			}}, nil
+	case parsers.YAML_KEY_RULE:
+		rule, err := deployer.getRemoteRule(name)
+		if rule == nil {
+			return nil, err
+		}
+		return &remoteEntity{namespace: rule.Namespace, name: rule.Name, timestamp: rule.Updated,
+			content: map[string]interface{}{
+				"trigger":     ruleEntityName(rule.Trigger),
+				"action":      ruleEntityName(rule.Action),
+				"status":      rule.Status,
+				"annotations": rule.Annotations,
+			}}, nil
+	}
+	return nil, nil
+}
+
+func printDeploymentStatus(status *DeploymentStatus) {
+	wskprint.PrintlnOpenWhiskOutput(wski18n.T(wski18n.ID_MSG_STATUS_HEADER_X_project_X_namespace_X_time_X,
+		map[string]interface{}{
+			wski18n.KEY_PROJECT:   status.State.Project,
+			wski18n.KEY_NAMESPACE: status.State.Namespace,
+			wski18n.KEY_TIME:      status.State.Deployed}))
+	for _, entry := range status.Entries {
+		line := fmt.Sprintf("%-10s %s: %s", entry.Status, entry.Kind, entry.Name)
+		if entry.Updated > 0 {
+			line += fmt.Sprintf(" (%s)", time.Unix(0, entry.Updated*int64(time.Millisecond)).UTC().Format(time.RFC3339))
+		}
+		wskprint.PrintlnOpenWhiskOutput(line)
+	}
+	wskprint.PrintlnOpenWhiskOutput(wski18n.T(wski18n.ID_MSG_STATUS_SUMMARY_X_insync_X_modified_X_deleted_X,
+		map[string]interface{}{
+			wski18n.KEY_IN_SYNC:  status.Count(STATUS_IN_SYNC),
+			wski18n.KEY_MODIFIED: status.Count(STATUS_MODIFIED),
+			wski18n.KEY_DELETED:  status.Count(STATUS_DELETED)}))
+}
@@ -0,0 +1,114 @@
+//go:build unit
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/parsers"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestState_RecordAndStatus(t *testing.T) {
+	var mu sync.Mutex
+	entities := map[string]string{
+		"/actions/p/a": `{"namespace":"guest/p","name":"a","updated":1000,"exec":{"kind":"nodejs:10","code":"function main() {}"}}`,
+		"/actions/p/b": `{"namespace":"guest/p","name":"b","updated":1000,"exec":{"kind":"nodejs:10","code":"function main() {}"}}`,
+		"/packages/p":  `{"namespace":"guest","name":"p","updated":1000}`,
+		"/triggers/t":  `{"namespace":"guest","name":"t","updated":1000}`,
+		"/rules/r":     `{"namespace":"guest","name":"r","updated":1000,"status":"active","trigger":{"path":"guest","name":"t"},"action":{"path":"guest/p","name":"a"}}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		path := strings.TrimPrefix(r.URL.Path[strings.Index(r.URL.Path, "/namespaces/"):], "/namespaces/guest")
+		w.Header().Set("Content-Type", "application/json")
+		if body, ok := entities[path]; ok {
+			w.Write([]byte(body))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"The requested resource does not exist."}`))
+	}))
+	defer server.Close()
+
+	client, err := whisk.NewClient(http.DefaultClient, &whisk.Config{Host: server.URL, AuthToken: "user:pass", Namespace: "guest"})
+	assert.Nil(t, err)
+
+	projectPath, err := ioutil.TempDir("", "wskdeploy-state")
+	assert.Nil(t, err)
+	defer os.RemoveAll(projectPath)
+
+	deployer := NewServiceDeployer()
+	deployer.Client = client
+	deployer.ClientConfig = client.Config
+	deployer.ProjectPath = projectPath
+	deployer.ProjectName = "demo"
+	pkg := NewDeploymentPackage()
+	pkg.Package = &whisk.Package{Name: "p"}
+	pkg.Actions["a"] = utils.ActionRecord{Action: &whisk.Action{Name: "a"}}
+	pkg.Actions["b"] = utils.ActionRecord{Action: &whisk.Action{Name: "b"}}
+	deployer.Deployment.Packages["p"] = pkg
+	deployer.Deployment.Triggers["t"] = &whisk.Trigger{Name: "t"}
+	deployer.Deployment.Rules["r"] = &whisk.Rule{Name: "r"}
+
+	deployer.recordState()
+
+	state, err := ReadState(projectPath)
+	assert.Nil(t, err)
+	assert.Equal(t, "guest", state.Namespace)
+	assert.Equal(t, "demo", state.Project)
+	assert.Equal(t, 5, len(state.Entities))
+	assert.Equal(t, EntityState{Kind: parsers.YAML_KEY_PACKAGE, Name: "/guest/p", Digest: state.Entities[0].Digest, Updated: 1000}, state.Entities[0])
+	assert.Equal(t, "/guest/p/a", state.Entities[1].Name)
+
+	status, err := deployer.ConstructStatus(state)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, status.Count(STATUS_IN_SYNC))
+
+	// change an action and disable the rule out-of-band, delete the trigger
+	mu.Lock()
+	entities["/actions/p/a"] = `{"namespace":"guest/p","name":"a","updated":2000,"exec":{"kind":"nodejs:10","code":"function main() { return {}; }"}}`
+	entities["/rules/r"] = strings.Replace(entities["/rules/r"], "active", "inactive", 1)
+	delete(entities, "/triggers/t")
+	mu.Unlock()
+
+	status, err = deployer.ConstructStatus(state)
+	assert.Nil(t, err)
+	assert.Equal(t, StatusEntry{Kind: parsers.YAML_KEY_ACTION, Name: "/guest/p/a", Status: STATUS_MODIFIED, Updated: 2000}, status.Entries[1])
+	assert.Equal(t, STATUS_IN_SYNC, status.Entries[2].Status)
+	assert.Equal(t, STATUS_DELETED, status.Entries[3].Status)
+	assert.Equal(t, STATUS_MODIFIED, status.Entries[4].Status)
+}
+
+func TestState_ReadMissingState(t *testing.T) {
+	_, err := ReadState(os.TempDir() + "/wskdeploy-no-such-project")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), STATE_DIR)
+}
@@ -0,0 +1,440 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/parsers"
+	"github.com/apache/openwhisk-wskdeploy/runtimes"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+)
+
+/*
+ * Validation constructs the deployment plan the same way a deployment does, offline: the
+ * deployment files are bound, and the values of the parameters checked against their
+ * schemas and types, but the dependencies are not downloaded. It needs neither credentials
+ * nor an OpenWhisk client: the namespace defaults to "_" and the runtimes are the ones known
+ * to wskdeploy.
+ *
+ * The plan stops at the first problem, so when it fails every entity of the manifest file
+ * is composed again on its own, so that a problem with one entity does not hide the
+ * problems of the others.
+ *
+ * On top of what the plan checks, problems which a deployment only reports as warnings are
+ * reported here as well: limits that would be ignored, runtimes that are not supported or
+ * do not match the action source file, and sequences and rules referring to actions or
+ * triggers not defined in the manifest file.
+ */
+
+type validation struct {
+	deployer     *ServiceDeployer
+	parser       *parsers.YAMLParser
+	manifest     *parsers.YAML
+	config       *whisk.Config
+	problems     []error
+	actions      []utils.ActionRecord
+	sequences    []utils.ActionRecord
+	rules        []packageRule
+	triggers     map[string]bool // names of the composed triggers
+	known        map[string]bool // qualified names of the composed actions and sequences
+	failed       map[string]bool // qualified names of the actions and sequences which failed to compose
+	dependencies map[string]bool // labels of the package dependencies
+}
+
+type packageRule struct {
+	packageName string
+	filepath    string // manifest file declaring the rule
+	rule        *whisk.Rule
+}
+
+// Validate checks the manifest and deployment files without deploying them and returns
+// a ValidationError listing every problem found
+func (deployer *ServiceDeployer) Validate() error {
+	if deployer.ClientConfig == nil {
+		deployer.ClientConfig = &whisk.Config{Namespace: whisk.DEFAULT_NAMESPACE}
+	}
+	v := &validation{
+		deployer:     deployer,
+		parser:       parsers.NewYAMLParser(),
+		config:       deployer.ClientConfig,
+		triggers:     make(map[string]bool),
+		known:        make(map[string]bool),
+		failed:       make(map[string]bool),
+		dependencies: make(map[string]bool),
+	}
+
+	manifest, err := v.parser.ParseManifest(deployer.ManifestPath)
+	if err != nil {
+		return wskderrors.NewValidationError([]error{err})
+	}
+	v.manifest = manifest
+
+	packages := manifest.Packages
+	if len(packages) == 0 {
+		packages = manifest.GetProject().Packages
+	}
+
+	planErr := deployer.ConstructDeploymentPlan()
+	if planErr == nil {
+		for _, packageName := range utils.SortedKeys(packages) {
+			v.collectPackage(packageName, packages[packageName])
+		}
+		for name := range deployer.Deployment.Triggers {
+			v.triggers[name] = true
+		}
+	} else {
+		v.validateDeployment()
+		v.report(deployer.setProjectInputs(manifest))
+		for _, packageName := range utils.SortedKeys(packages) {
+			v.composePackage(packageName, packages[packageName])
+		}
+		for _, packageName := range utils.SortedKeys(packages) {
+			v.composeApis(packageName, packages[packageName])
+		}
+		_, _, err = v.parser.ComposeApiRecordsFromSwagger(v.config, manifest)
+		v.report(err)
+	}
+	for _, packageName := range utils.SortedKeys(packages) {
+		v.resolveSequences(packageName, packages[packageName])
+	}
+	v.resolveRules()
+
+	// the problem which stopped the plan, unless composing the entities found it as well,
+	// e.g. a value of the deployment file which does not conform to its schema
+	if planErr != nil && !v.reported(planErr) {
+		v.report(planErr)
+	}
+
+	if len(v.problems) > 0 {
+		return wskderrors.NewValidationError(v.problems)
+	}
+	return nil
+}
+
+func (v *validation) report(err error) {
+	if err != nil {
+		v.problems = append(v.problems, err)
+	}
+}
+
+// reported tells whether the problem was reported already, possibly wrapped by err
+func (v *validation) reported(err error) bool {
+	for _, problem := range v.problems {
+		if strings.Contains(err.Error(), problem.Error()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *validation) reportf(path string, id string, args map[string]interface{}) {
+	v.report(wskderrors.NewYAMLFileFormatError(path, strings.TrimSpace(wski18n.T(id, args))))
+}
+
+func (v *validation) validateDeployment() {
+	path := v.deployer.DeploymentPath
+	if !utils.FileExists(path) {
+		return
+	}
+	paths := append([]string{path}, v.deployer.DeploymentOverlays...)
+	deployment, _, err := v.parser.ParseDeployments(paths)
+	if err != nil {
+		v.report(err)
+		return
+	}
+
+	projectName := v.manifest.GetProject().Name
+	deploymentName := deployment.GetProject().Name
+	if len(deployment.GetProject().Packages) != 0 && len(projectName) != 0 && deploymentName != projectName {
+		v.reportf(v.manifest.Filepath, wski18n.ID_ERR_NAME_MISMATCH_X_key_X_dname_X_dpath_X_mname_X_moath_X,
+			map[string]interface{}{
+				wski18n.KEY_KEY:             parsers.YAML_KEY_PROJECT,
+				wski18n.KEY_DEPLOYMENT_NAME: deploymentName,
+				wski18n.KEY_DEPLOYMENT_PATH: path,
+				wski18n.KEY_MANIFEST_NAME:   projectName,
+				wski18n.KEY_MANIFEST_PATH:   v.manifest.Filepath})
+	}
+}
+
+// collectPackage records the actions, sequences and rules the deployment plan composed for
+// a package, and checks the limits and runtimes of its actions
+func (v *validation) collectPackage(packageName string, pkg parsers.Package) {
+	for label := range pkg.Dependencies {
+		v.dependencies[label] = true
+	}
+	plan, ok := v.deployer.Deployment.Packages[packageName]
+	if !ok {
+		return
+	}
+	for _, name := range utils.SortedKeys(pkg.Actions) {
+		record, ok := plan.Actions[name]
+		if !ok || record.Action == nil {
+			continue
+		}
+		v.known[qualifiedName(packageName, record.Action.Name)] = true
+		v.checkLimits(pkg.Filepath, qualifiedName(packageName, name), pkg.Actions[name], record.Action)
+		v.checkRuntime(pkg.Filepath, qualifiedName(packageName, name), pkg.Actions[name], record.Filepath)
+	}
+	for _, record := range plan.Sequences {
+		v.known[qualifiedName(packageName, record.Action.Name)] = true
+	}
+	for _, name := range utils.SortedKeys(pkg.Rules) {
+		if rule, ok := v.deployer.Deployment.Rules[name]; ok {
+			v.rules = append(v.rules, packageRule{packageName: packageName, filepath: pkg.Filepath, rule: rule})
+		}
+	}
+}
+
+// composePackage composes a package and each of its dependencies, actions, sequences,
+// feeds, triggers and rules on their own, problems refer to the manifest file declaring the package
+func (v *validation) composePackage(packageName string, pkg parsers.Package) {
+	path := pkg.Filepath
+	managed := whisk.KeyValue{}
+
+	_, params, err := v.parser.ComposePackage(pkg, packageName, path, managed, v.deployer.ProjectInputs)
+	v.report(err)
+	inputs := parsers.PackageInputs{PackageName: packageName, Inputs: params}
+
+	_, err = v.parser.ComposeDependencies(pkg, v.deployer.ProjectPath, path, packageName, managed, inputs)
+	v.report(err)
+	_, err = v.parser.ComposeParameterSchemas(path, pkg, packageName, inputs)
+	v.report(err)
+	for label := range pkg.Dependencies {
+		v.dependencies[label] = true
+	}
+
+	for _, name := range utils.SortedKeys(pkg.Actions) {
+		action := pkg.Actions[name]
+		records, err := v.parser.ComposeActions(path, map[string]parsers.Action{name: action}, packageName, managed, inputs)
+		if err != nil {
+			v.report(err)
+			v.failed[qualifiedName(packageName, name)] = true
+			continue
+		}
+		for _, record := range records {
+			v.actions = append(v.actions, record)
+			v.known[qualifiedName(packageName, record.Action.Name)] = true
+			v.checkLimits(path, qualifiedName(packageName, name), action, record.Action)
+			v.checkRuntime(path, qualifiedName(packageName, name), action, record.Filepath)
+		}
+	}
+
+	for _, name := range utils.SortedKeys(pkg.Sequences) {
+		sequences := map[string]parsers.Sequence{name: pkg.Sequences[name]}
+		records, err := v.parser.ComposeSequences(v.config.Namespace, sequences, packageName, path, managed, inputs)
+		if err != nil {
+			v.report(err)
+			v.failed[qualifiedName(packageName, name)] = true
+			continue
+		}
+		for _, record := range records {
+			v.sequences = append(v.sequences, record)
+			v.known[qualifiedName(packageName, record.Action.Name)] = true
+		}
+	}
+
+	for _, name := range utils.SortedKeys(pkg.Feeds) {
+		single := pkg
+		single.Feeds = map[string]parsers.Feed{name: pkg.Feeds[name]}
+		_, err := v.parser.ComposeFeeds(path, single, packageName)
+		v.report(err)
+	}
+
+	for _, name := range utils.SortedKeys(pkg.Triggers) {
+		single := pkg
+		single.Triggers = map[string]parsers.Trigger{name: pkg.Triggers[name]}
+		triggers, _, err := v.parser.ComposeTriggers(path, single, packageName, managed, inputs)
+		v.report(err)
+		for _, trigger := range triggers {
+			v.triggers[trigger.Name] = true
+		}
+	}
+
+	for _, name := range utils.SortedKeys(pkg.Rules) {
+		single := pkg
+		single.Rules = map[string]parsers.Rule{name: pkg.Rules[name]}
+		rules, err := v.parser.ComposeRules(path, single, packageName, managed, inputs)
+		v.report(err)
+		for _, rule := range rules {
+			v.rules = append(v.rules, packageRule{packageName: packageName, filepath: path, rule: rule})
+		}
+	}
+}
+
+// checkLimits reports the limits which a deployment would ignore
+func (v *validation) checkLimits(path string, actionName string, action parsers.Action, wskaction *whisk.Action) {
+	if action.Limits == nil {
+		return
+	}
+	limits := action.Limits
+	composed := wskaction.Limits
+	if composed == nil {
+		composed = new(whisk.Limits)
+	}
+
+	invalid := []struct {
+		name    string
+		ignored bool
+	}{
+		{parsers.LIMIT_VALUE_TIMEOUT, limits.Timeout != nil && composed.Timeout == nil},
+		{parsers.LIMIT_VALUE_MEMORY_SIZE, limits.Memory != nil && composed.Memory == nil},
+		{parsers.LIMIT_VALUE_LOG_SIZE, limits.Logsize != nil && composed.Logsize == nil},
+	}
+	for _, limit := range invalid {
+		if limit.ignored {
+			v.reportf(path, wski18n.ID_ERR_LIMIT_INVALID_X_limit_X_action_X,
+				map[string]interface{}{wski18n.KEY_LIMIT: limit.name, wski18n.KEY_ACTION: actionName})
+		}
+	}
+
+	unchangeable := []struct {
+		name  string
+		value *int
+	}{
+		{parsers.LIMIT_VALUE_CONCURRENT_ACTIVATIONS, limits.ConcurrentActivations},
+		{parsers.LIMIT_VALUE_USER_INVOCATION_RATE, limits.UserInvocationRate},
+		{parsers.LIMIT_VALUE_CODE_SIZE, limits.CodeSize},
+		{parsers.LIMIT_VALUE_PARAMETER_SIZE, limits.ParameterSize},
+	}
+	for _, limit := range unchangeable {
+		if limit.value != nil {
+			v.reportf(path, wski18n.ID_ERR_LIMIT_UNCHANGEABLE_X_limit_X_action_X,
+				map[string]interface{}{wski18n.KEY_LIMIT: limit.name, wski18n.KEY_ACTION: actionName})
+		}
+	}
+}
+
+// checkRuntime reports the runtimes which a deployment would replace with the default
+// runtime of the action source file, zip actions already fail to compose in that case
+func (v *validation) checkRuntime(path string, actionName string, action parsers.Action, actionFilePath string) {
+	if len(action.Runtime) == 0 || len(actionFilePath) == 0 || len(action.Docker) != 0 || action.Native {
+		return
+	}
+	ext := strings.TrimPrefix(filepath.Ext(actionFilePath), ".")
+	if ext == runtimes.ZIP_FILE_EXTENSION {
+		return
+	}
+	// a runtime family such as "python" stands for its default runtime
+	kind := action.Runtime
+	if !runtimes.CheckExistRuntime(kind, runtimes.SupportedRunTimes) && len(runtimes.DefaultRunTimes[kind]) != 0 {
+		kind = runtimes.DefaultRunTimes[kind]
+	}
+	if !runtimes.CheckExistRuntime(kind, runtimes.SupportedRunTimes) {
+		v.reportf(path, wski18n.ID_ERR_RUNTIME_INVALID_X_runtime_X_action_X,
+			map[string]interface{}{
+				wski18n.KEY_RUNTIME: action.Runtime,
+				wski18n.KEY_ACTION:  actionName})
+	} else if !runtimes.CheckRuntimeConsistencyWithFileExtension(ext, kind) {
+		v.reportf(path, wski18n.ID_ERR_RUNTIME_MISMATCH_X_runtime_X_ext_X_action_X,
+			map[string]interface{}{
+				wski18n.KEY_RUNTIME:   action.Runtime,
+				wski18n.KEY_EXTENSION: ext,
+				wski18n.KEY_ACTION:    actionName})
+	}
+}
+
+// composeApis composes each API of a package on its own, APIs exposing an action which
+// failed to compose are skipped as that action has been reported already
+func (v *validation) composeApis(packageName string, pkg parsers.Package) {
+	for _, apiName := range utils.SortedKeys(pkg.Apis) {
+		if v.exposesFailedAction(packageName, pkg.Apis[apiName]) {
+			continue
+		}
+		single := pkg
+		single.Apis = map[string]map[string]map[string]map[string]parsers.APIMethodResponse{apiName: pkg.Apis[apiName]}
+		_, _, err := v.parser.ComposeApiRecords(v.config, packageName, single, pkg.Filepath, v.actions, v.sequences)
+		v.report(err)
+	}
+}
+
+func (v *validation) exposesFailedAction(packageName string, api map[string]map[string]map[string]parsers.APIMethodResponse) bool {
+	for _, basePath := range api {
+		for _, relPath := range basePath {
+			for actionName := range relPath {
+				if v.failed[qualifiedName(packageName, actionName)] {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// resolveSequences reports the components of the sequences which are not defined in the
+// manifest file, components are qualified with the package name as done by ComposeSequences
+func (v *validation) resolveSequences(packageName string, pkg parsers.Package) {
+	for _, name := range utils.SortedKeys(pkg.Sequences) {
+		for _, component := range strings.Split(pkg.Sequences[name].Actions, ",") {
+			component = strings.TrimSpace(component)
+			if !v.resolves(qualifiedName(packageName, component)) {
+				v.reportf(pkg.Filepath, wski18n.ID_ERR_SEQUENCE_ACTION_NOT_FOUND_X_sequence_X_action_X,
+					map[string]interface{}{
+						wski18n.KEY_SEQUENCE: qualifiedName(packageName, name),
+						wski18n.KEY_ACTION:   component})
+			}
+		}
+	}
+}
+
+// resolveRules reports the triggers and actions of the rules which are not defined in the
+// manifest file, rule actions are already qualified by ComposeRules
+func (v *validation) resolveRules() {
+	for _, r := range v.rules {
+		trigger, _ := r.rule.Trigger.(string)
+		if !strings.HasPrefix(trigger, parsers.PATH_SEPARATOR) && !v.triggers[trigger] {
+			v.reportf(r.filepath, wski18n.ID_ERR_RULE_TRIGGER_NOT_FOUND_X_rule_X_trigger_X,
+				map[string]interface{}{
+					wski18n.KEY_RULE:    r.rule.Name,
+					wski18n.KEY_TRIGGER: trigger})
+		}
+		action, _ := r.rule.Action.(string)
+		if !v.resolves(action) {
+			v.reportf(r.filepath, wski18n.ID_ERR_RULE_ACTION_NOT_FOUND_X_rule_X_action_X,
+				map[string]interface{}{
+					wski18n.KEY_RULE:   r.rule.Name,
+					wski18n.KEY_ACTION: action})
+		}
+	}
+}
+
+// resolves reports whether a qualified action name refers to an action or sequence of the
+// manifest file; fully qualified names and actions of dependencies can not be checked offline
+func (v *validation) resolves(name string) bool {
+	if strings.HasPrefix(name, parsers.PATH_SEPARATOR) {
+		return true
+	}
+	parts := strings.Split(name, parsers.PATH_SEPARATOR)
+	if len(parts) > 2 || (len(parts) == 2 && v.dependencies[parts[0]]) {
+		return true
+	}
+	return v.known[name] || v.failed[name]
+}
+
+// qualifiedName returns the name of an action relative to the namespace, actions of the
+// default package are not qualified
+func qualifiedName(packageName string, actionName string) string {
+	if strings.Contains(actionName, parsers.PATH_SEPARATOR) || strings.ToLower(packageName) == parsers.DEFAULT_PACKAGE {
+		return actionName
+	}
+	return packageName + parsers.PATH_SEPARATOR + actionName
+}
@@ -0,0 +1,237 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployers
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apache/openwhisk-wskdeploy/runtimes"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func validateProject(t *testing.T, manifest string) error {
+	return validateProjectWithDeployment(t, manifest, "")
+}
+
+func validateProjectWithDeployment(t *testing.T, manifest string, deployment string) error {
+	op, err := runtimes.ParseLocalOpenWhisk()
+	assert.Nil(t, err)
+	runtimes.SupportedRunTimes = runtimes.ConvertToMap(op)
+	runtimes.DefaultRunTimes = runtimes.DefaultRuntimes(op)
+	runtimes.FileExtensionRuntimeKindMap = runtimes.FileExtensionRuntimes(op)
+	runtimes.FileRuntimeExtensionsMap = runtimes.FileRuntimeExtensions(op)
+
+	projectPath, err := ioutil.TempDir("", "wskdeploy-validate")
+	assert.Nil(t, err)
+	defer os.RemoveAll(projectPath)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(projectPath, "hello.js"), []byte("function main() {}"), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(projectPath, "manifest.yaml"), []byte(manifest), 0644))
+
+	deployer := NewServiceDeployer()
+	deployer.ProjectPath = projectPath
+	deployer.ManifestPath = filepath.Join(projectPath, "manifest.yaml")
+	if len(deployment) != 0 {
+		deployer.DeploymentPath = filepath.Join(projectPath, "deployment.yaml")
+		assert.Nil(t, ioutil.WriteFile(deployer.DeploymentPath, []byte(deployment), 0644))
+	}
+	return deployer.Validate()
+}
+
+func TestValidate_Valid(t *testing.T) {
+	manifest := `packages:
+  hello:
+    actions:
+      greet:
+        function: hello.js
+        runtime: nodejs
+        web-export: true
+    sequences:
+      greetings:
+        actions: greet, /whisk.system/utils/echo
+    triggers:
+      everyMinute:
+        feed: /whisk.system/alarms/alarm
+    rules:
+      greetEveryMinute:
+        trigger: everyMinute
+        action: greetings
+    apis:
+      hello-api:
+        hello:
+          world:
+            greet:
+              method: GET
+              response: json
+`
+	assert.Nil(t, validateProject(t, manifest))
+}
+
+func TestValidate_ReportsEveryProblem(t *testing.T) {
+	manifest := `packages:
+  hello:
+    actions:
+      greet:
+        function: hello.js
+        runtime: python:3
+        limits:
+          memorySize: 64
+          codeSize: 100
+      missing:
+        function: missing.js
+      bad-runtime:
+        function: hello.js
+        runtime: cobol
+    sequences:
+      greetings:
+        actions: greet, farewell
+    triggers:
+      everyMinute:
+    rules:
+      r1:
+        trigger: everyHour
+        action: greet
+      r2:
+        trigger: everyMinute
+        action: farewell
+    apis:
+      hello-api:
+        hello:
+          world:
+            greet:
+              method: FETCH
+`
+	err := validateProject(t, manifest)
+	validationErr, ok := err.(*wskderrors.ValidationError)
+	if !assert.True(t, ok, "Expected a validation error") {
+		return
+	}
+	assert.Equal(t, 9, len(validationErr.Problems), err.Error())
+
+	message := err.Error()
+	assert.Contains(t, message, "9 problem(s) found")
+	assert.Contains(t, message, "Invalid or missing runtime [cobol] specified in manifest for the action [hello/bad-runtime].")
+	assert.Contains(t, message, "missing.js")
+	assert.Contains(t, message, "Runtime [python:3] specified in manifest does not match with action's source file extension [js] for action [hello/greet].")
+	assert.Contains(t, message, "Limit [memorySize] of action [hello/greet] is out of range and would be ignored.")
+	assert.Contains(t, message, "Limit [codeSize] of action [hello/greet] can not be changed and would be ignored.")
+	assert.Contains(t, message, "Sequence [hello/greetings] refers to action [farewell] which is not defined in the manifest file.")
+	assert.Contains(t, message, "Rule [r1] refers to trigger [everyHour] which is not defined in the manifest file.")
+	assert.Contains(t, message, "Rule [r2] refers to action [hello/farewell] which is not defined in the manifest file.")
+	assert.Contains(t, message, "FETCH")
+}
+
+func TestValidate_PlanWarnings(t *testing.T) {
+	// the deployment plan only warns about these problems
+	manifest := `packages:
+  hello:
+    actions:
+      greet:
+        function: hello.js
+        runtime: python:3
+    sequences:
+      greetings:
+        actions: greet, farewell
+    rules:
+      r1:
+        trigger: everyHour
+        action: greet
+`
+	err := validateProject(t, manifest)
+	validationErr, ok := err.(*wskderrors.ValidationError)
+	if !assert.True(t, ok, "Expected a validation error") {
+		return
+	}
+	assert.Equal(t, 3, len(validationErr.Problems), err.Error())
+	assert.Contains(t, err.Error(), "Runtime [python:3] specified in manifest does not match")
+	assert.Contains(t, err.Error(), "Sequence [hello/greetings] refers to action [farewell]")
+	assert.Contains(t, err.Error(), "Rule [r1] refers to trigger [everyHour]")
+}
+
+func TestValidate_DeploymentValues(t *testing.T) {
+	manifest := `project:
+  name: hello
+  packages:
+    hello:
+      actions:
+        greet:
+          function: hello.js
+          runtime: nodejs
+          inputs:
+            port:
+              type: integer
+              value: 8080
+              schema:
+                minimum: 1
+            title:
+              type: string16
+              value: Hello
+`
+	assert.Nil(t, validateProject(t, manifest))
+
+	// the values of the deployment file are bound before they are checked
+	deployment := `project:
+  name: hello
+  packages:
+    hello:
+      actions:
+        greet:
+          inputs:
+            port: 0
+`
+	err := validateProjectWithDeployment(t, manifest, deployment)
+	validationErr, ok := err.(*wskderrors.ValidationError)
+	if assert.True(t, ok, "Expected a validation error") && assert.Equal(t, 1, len(validationErr.Problems), err.Error()) {
+		assert.IsType(t, &wskderrors.ParameterSchemaError{}, validationErr.Problems[0])
+	}
+
+	deployment = `project:
+  name: hello
+  packages:
+    hello:
+      actions:
+        greet:
+          inputs:
+            title: Hello from the deployment file
+`
+	err = validateProjectWithDeployment(t, manifest, deployment)
+	validationErr, ok = err.(*wskderrors.ValidationError)
+	if assert.True(t, ok, "Expected a validation error") && assert.Equal(t, 1, len(validationErr.Problems), err.Error()) {
+		assert.IsType(t, &wskderrors.ParameterTypeMismatchError{}, validationErr.Problems[0])
+	}
+}
+
+func TestValidate_ManifestParseError(t *testing.T) {
+	err := validateProject(t, "packages:\n  hello:\n    action:\n")
+	validationErr, ok := err.(*wskderrors.ValidationError)
+	if assert.True(t, ok) && assert.Equal(t, 1, len(validationErr.Problems)) {
+		_, ok = validationErr.Problems[0].(*wskderrors.SchemaValidationError)
+		assert.True(t, ok)
+	}
+}
+
+func TestValidate_QualifiedName(t *testing.T) {
+	assert.Equal(t, "p/a", qualifiedName("p", "a"))
+	assert.Equal(t, "a", qualifiedName("default", "a"))
+	assert.Equal(t, "q/a", qualifiedName("p", "q/a"))
+}
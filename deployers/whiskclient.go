@@ -124,10 +124,14 @@ func setWhiskConfig(cred string, ns string, host string, token string, source st
 func readFromDeploymentFile(deploymentPath string) {
 	if len(credential.Value) == 0 || len(namespace.Value) == 0 || len(apiHost.Value) == 0 {
 		if utils.FileExists(deploymentPath) {
-			mm := parsers.NewYAMLParser()
-			deployment, _ := mm.ParseDeployment(deploymentPath)
-			p := deployment.GetProject()
-			setWhiskConfig(p.Credential, p.Namespace, p.ApiHost, p.ApigwAccessToken, path.Base(deploymentPath))
+			// deployment overlays take precedence over the files before them
+			paths := append([]string{deploymentPath}, utils.Flags.DeploymentOverlays...)
+			for i := len(paths) - 1; i >= 0; i-- {
+				mm := parsers.NewYAMLParser()
+				deployment, _ := mm.ParseDeployment(paths[i])
+				p := deployment.GetProject()
+				setWhiskConfig(p.Credential, p.Namespace, p.ApiHost, p.ApigwAccessToken, path.Base(paths[i]))
+			}
 		}
 	}
 }
@@ -0,0 +1,213 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"strings"
+)
+
+/*
+ * A deployment file can be followed by any number of overlays, deployment files which are
+ * merged over it in order, e.g. a base deployment.yaml and a deployment.prod.yaml:
+ *
+ * - project, package, action and trigger settings such as namespace or credential, and
+ *   the status of rules, are replaced by the ones of a later file when set there
+ * - inputs and annotations are merged key by key, the value of a key in a later file
+ *   replaces the value of the same key in the earlier files, unless both values are maps
+ *   which are merged the same way, recursively
+ * - packages, actions, triggers and rules found in a later file only are added
+ *
+ * DeploymentSources records the file every merged input and annotation was read from, the
+ * last file setting it for a merged map.
+ */
+
+const (
+	YAML_KEY_INPUTS      = "inputs"
+	YAML_KEY_ANNOTATIONS = "annotations"
+)
+
+// DeploymentSources maps the SourceKey of every merged input and annotation to the path
+// of the deployment file it was read from
+type DeploymentSources map[string]string
+
+// SourceKey returns the key of a value in DeploymentSources, e.g.
+// SourceKey(YAML_KEY_PACKAGE, "hello", YAML_KEY_ACTION, "greet", YAML_KEY_INPUTS, "name")
+func SourceKey(path ...string) string {
+	return strings.Join(path, PATH_SEPARATOR)
+}
+
+// ParseDeployments parses a deployment file and its overlays and merges them in order
+func (dm *YAMLParser) ParseDeployments(paths []string) (*YAML, DeploymentSources, error) {
+	merged := new(YAML)
+	sources := make(DeploymentSources)
+	for i, path := range paths {
+		deployment, err := dm.ParseDeployment(path)
+		if err != nil {
+			return deployment, sources, err
+		}
+		if i == 0 {
+			merged.Filepath = deployment.Filepath
+		}
+		MergeDeployment(merged, deployment, sources)
+	}
+	return merged, sources, nil
+}
+
+// MergeDeployment merges an overlay deployment over a deployment
+func MergeDeployment(deployment *YAML, overlay *YAML, sources DeploymentSources) {
+	source := overlay.Filepath
+	project := &deployment.Project
+	mergeString(&project.Name, overlay.Project.Name)
+	mergeString(&project.Namespace, overlay.Project.Namespace)
+	mergeString(&project.Credential, overlay.Project.Credential)
+	mergeString(&project.ApiHost, overlay.Project.ApiHost)
+	mergeString(&project.ApigwAccessToken, overlay.Project.ApigwAccessToken)
+	mergeString(&project.Version, overlay.Project.Version)
+	project.Inputs = mergeInputs(project.Inputs, overlay.Project.Inputs, sources, source,
+		YAML_KEY_PROJECT)
+
+	// packages are either listed under project or at the top level, the deployment
+	// reader only looks at the top level when there are no packages under project
+	overlayPackages := overlay.Project.Packages
+	if len(overlayPackages) == 0 {
+		overlayPackages = overlay.Packages
+	}
+	packages := &deployment.Project.Packages
+	if len(*packages) == 0 && (len(deployment.Packages) != 0 || len(overlay.Project.Packages) == 0) {
+		packages = &deployment.Packages
+	}
+	if *packages == nil && len(overlayPackages) != 0 {
+		*packages = make(map[string]Package)
+	}
+	for name, pkg := range overlayPackages {
+		(*packages)[name] = mergePackage((*packages)[name], pkg, sources, source, name)
+	}
+}
+
+func mergePackage(pkg Package, overlay Package, sources DeploymentSources, source string, name string) Package {
+	mergeString(&pkg.Packagename, overlay.Packagename)
+	mergeString(&pkg.Version, overlay.Version)
+	mergeString(&pkg.License, overlay.License)
+	mergeString(&pkg.Namespace, overlay.Namespace)
+	mergeString(&pkg.Credential, overlay.Credential)
+	mergeString(&pkg.ApiHost, overlay.ApiHost)
+	mergeString(&pkg.ApigwAccessToken, overlay.ApigwAccessToken)
+	pkg.Inputs = mergeInputs(pkg.Inputs, overlay.Inputs, sources, source, YAML_KEY_PACKAGE, name)
+	pkg.Annotations = mergeAnnotations(pkg.Annotations, overlay.Annotations, sources, source, YAML_KEY_PACKAGE, name)
+
+	if pkg.Actions == nil && len(overlay.Actions) != 0 {
+		pkg.Actions = make(map[string]Action)
+	}
+	for actionName, o := range overlay.Actions {
+		action := pkg.Actions[actionName]
+		action.Inputs = mergeInputs(action.Inputs, o.Inputs, sources, source,
+			YAML_KEY_PACKAGE, name, YAML_KEY_ACTION, actionName)
+		action.Annotations = mergeAnnotations(action.Annotations, o.Annotations, sources, source,
+			YAML_KEY_PACKAGE, name, YAML_KEY_ACTION, actionName)
+		pkg.Actions[actionName] = action
+	}
+
+	if pkg.Triggers == nil && len(overlay.Triggers) != 0 {
+		pkg.Triggers = make(map[string]Trigger)
+	}
+	for triggerName, o := range overlay.Triggers {
+		trigger := pkg.Triggers[triggerName]
+		mergeString(&trigger.Namespace, o.Namespace)
+		mergeString(&trigger.Credential, o.Credential)
+		trigger.Inputs = mergeInputs(trigger.Inputs, o.Inputs, sources, source,
+			YAML_KEY_PACKAGE, name, YAML_KEY_TRIGGER, triggerName)
+		trigger.Annotations = mergeAnnotations(trigger.Annotations, o.Annotations, sources, source,
+			YAML_KEY_PACKAGE, name, YAML_KEY_TRIGGER, triggerName)
+		pkg.Triggers[triggerName] = trigger
+	}
+
+	if pkg.Rules == nil && len(overlay.Rules) != 0 {
+		pkg.Rules = make(map[string]Rule)
+	}
+	for ruleName, o := range overlay.Rules {
+		rule := pkg.Rules[ruleName]
+		mergeString(&rule.Status, o.Status)
+		pkg.Rules[ruleName] = rule
+	}
+	return pkg
+}
+
+func mergeString(value *string, overlay string) {
+	if len(overlay) != 0 {
+		*value = overlay
+	}
+}
+
+func mergeInputs(inputs map[string]Parameter, overlay map[string]Parameter, sources DeploymentSources, source string, path ...string) map[string]Parameter {
+	if inputs == nil && len(overlay) != 0 {
+		inputs = make(map[string]Parameter)
+	}
+	for key, value := range overlay {
+		if input, exists := inputs[key]; exists {
+			value.Value = mergeValues(input.Value, value.Value)
+		}
+		inputs[key] = value
+		sources[SourceKey(append(path, YAML_KEY_INPUTS, key)...)] = source
+	}
+	return inputs
+}
+
+func mergeAnnotations(annotations map[string]interface{}, overlay map[string]interface{}, sources DeploymentSources, source string, path ...string) map[string]interface{} {
+	if annotations == nil && len(overlay) != 0 {
+		annotations = make(map[string]interface{})
+	}
+	for key, value := range overlay {
+		annotations[key] = mergeValues(annotations[key], value)
+		sources[SourceKey(append(path, YAML_KEY_ANNOTATIONS, key)...)] = source
+	}
+	return annotations
+}
+
+// mergeValues returns the overlay value, or the keys of the overlay merged over the keys
+// of the value when both are maps, recursively
+func mergeValues(value interface{}, overlay interface{}) interface{} {
+	switch o := overlay.(type) {
+	case map[interface{}]interface{}:
+		v, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return overlay
+		}
+		merged := make(map[interface{}]interface{}, len(v)+len(o))
+		for key, item := range v {
+			merged[key] = item
+		}
+		for key, item := range o {
+			merged[key] = mergeValues(v[key], item)
+		}
+		return merged
+	case map[string]interface{}:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return overlay
+		}
+		merged := make(map[string]interface{}, len(v)+len(o))
+		for key, item := range v {
+			merged[key] = item
+		}
+		for key, item := range o {
+			merged[key] = mergeValues(v[key], item)
+		}
+		return merged
+	}
+	return overlay
+}
@@ -20,6 +20,7 @@ package parsers
 import (
 	"github.com/apache/openwhisk-wskdeploy/utils"
 	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/apache/openwhisk-wskdeploy/wskschema"
 	"gopkg.in/yaml.v2"
 )
 
@@ -39,6 +40,11 @@ func (dm *YAMLParser) ParseDeployment(deploymentPath string) (*YAML, error) {
 		return &dplyyaml, wskderrors.NewFileReadError(deploymentPath, err.Error())
 	}
 
+	err = wskschema.ValidateDeployment(deploymentPath, content)
+	if err != nil {
+		return &dplyyaml, err
+	}
+
 	err = dm.unmarshalDeployment(content, &dplyyaml)
 
 	if err != nil {
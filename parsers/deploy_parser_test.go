@@ -54,8 +54,7 @@ func TestInvalidKeyDeploymentYaml(t *testing.T) {
 	p := NewYAMLParser()
 	_, err = p.ParseDeployment(tmpfile.Name())
 	assert.NotNil(t, err)
-	// NOTE: go-yaml/yaml gets the line # wrong; testing only for the invalid key message
-	assert.Contains(t, err.Error(), "field invalidKey not found in type parsers.Project")
+	assert.Contains(t, err.Error(), tmpfile.Name()+":3:3: $.project: Unknown property [invalidKey].")
 }
 
 func TestMappingValueDeploymentYaml(t *testing.T) {
@@ -89,8 +88,7 @@ func TestMissingRootNodeDeploymentYaml(t *testing.T) {
 	p := NewYAMLParser()
 	_, err = p.ParseDeployment(tmpfile.Name())
 	assert.NotNil(t, err)
-	// go-yaml/yaml prints the wrong line number for mapping values. It should be 3.
-	assert.Contains(t, err.Error(), "line 1: field name not found in type parsers.YAML")
+	assert.Contains(t, err.Error(), tmpfile.Name()+":1:1: $: Unknown property [name].")
 }
 
 func TestParseDeploymentYAML_Project(t *testing.T) {
@@ -240,3 +238,74 @@ func TestParseDeploymentYAML_Project_Packages_Env(t *testing.T) {
 		}
 	}
 }
+
+func TestParseDeployments_Overlay(t *testing.T) {
+	base := "../tests/dat/deployment_overlay_base.yaml"
+	prod := "../tests/dat/deployment_overlay_prod.yaml"
+	mm := NewYAMLParser()
+	deployment, sources, err := mm.ParseDeployments([]string{base, prod})
+	assert.Nil(t, err)
+
+	project := deployment.GetProject()
+	assert.Equal(t, base, deployment.Filepath, "Merged deployment should keep the path of the base file.")
+	assert.Equal(t, "overlay-sample", project.Name, "Project name of the base file should be kept.")
+	assert.Equal(t, "/prod", project.Namespace, "Project namespace should be replaced by the overlay.")
+	assert.Equal(t, "us-south", project.Inputs["region"].Value)
+	assert.Equal(t, "gold", project.Inputs["tier"].Value)
+	assert.Equal(t, base, sources[SourceKey(YAML_KEY_PROJECT, YAML_KEY_INPUTS, "region")])
+	assert.Equal(t, prod, sources[SourceKey(YAML_KEY_PROJECT, YAML_KEY_INPUTS, "tier")])
+
+	assert.Equal(t, 2, len(project.Packages), "Packages of the overlay should be added.")
+	pkg := project.Packages["hello"]
+	assert.Equal(t, "Hello", pkg.Inputs["greeting"].Value)
+	assert.Equal(t, "London", pkg.Inputs["place"].Value)
+	assert.Equal(t, base, sources[SourceKey(YAML_KEY_PACKAGE, "hello", YAML_KEY_INPUTS, "greeting")])
+	assert.Equal(t, prod, sources[SourceKey(YAML_KEY_PACKAGE, "hello", YAML_KEY_INPUTS, "place")])
+
+	action := pkg.Actions["greet"]
+	assert.Equal(t, "Amy", action.Inputs["name"].Value)
+	assert.Equal(t, false, action.Inputs["debug"].Value)
+	assert.Equal(t, "dev-team", action.Annotations["owner"])
+	assert.Equal(t, prod, sources[SourceKey(YAML_KEY_PACKAGE, "hello", YAML_KEY_ACTION, "greet", YAML_KEY_INPUTS, "debug")])
+	assert.Equal(t, base, sources[SourceKey(YAML_KEY_PACKAGE, "hello", YAML_KEY_ACTION, "greet", YAML_KEY_ANNOTATIONS, "owner")])
+
+	// maps are merged key by key, recursively
+	assert.Equal(t, map[interface{}]interface{}{
+		"host": "example.com",
+		"port": 443,
+		"tls":  map[interface{}]interface{}{"enabled": true, "version": "1.2"},
+	}, action.Inputs["config"].Value)
+	assert.Equal(t, map[interface{}]interface{}{"team": "ops", "tier": "web"}, action.Annotations["labels"])
+	assert.Equal(t, prod, sources[SourceKey(YAML_KEY_PACKAGE, "hello", YAML_KEY_ACTION, "greet", YAML_KEY_ANNOTATIONS, "labels")])
+
+	trigger := pkg.Triggers["everyMinute"]
+	assert.Equal(t, "0 * * * *", trigger.Inputs["cron"].Value)
+	assert.Equal(t, "info", project.Packages["audit"].Inputs["level"].Value)
+}
+
+func TestMergeDeployment_TopLevelPackages(t *testing.T) {
+	deployment := &YAML{Packages: map[string]Package{
+		"hello": {Inputs: map[string]Parameter{"place": {Value: "Paris"}}},
+	}}
+	overlay := &YAML{Filepath: "deployment.prod.yaml", Project: Project{Packages: map[string]Package{
+		"hello": {Inputs: map[string]Parameter{"place": {Value: "London"}}},
+	}}}
+	sources := make(DeploymentSources)
+	MergeDeployment(deployment, overlay, sources)
+
+	assert.Equal(t, 0, len(deployment.Project.Packages), "Packages should stay at the top level.")
+	assert.Equal(t, "London", deployment.Packages["hello"].Inputs["place"].Value)
+	assert.Equal(t, "deployment.prod.yaml", sources[SourceKey(YAML_KEY_PACKAGE, "hello", YAML_KEY_INPUTS, "place")])
+}
+
+func TestMergeValues(t *testing.T) {
+	value := map[string]interface{}{"a": 1, "b": map[string]interface{}{"c": 2, "d": 3}}
+	overlay := map[string]interface{}{"b": map[string]interface{}{"d": 4}, "e": 5}
+	assert.Equal(t, map[string]interface{}{"a": 1, "b": map[string]interface{}{"c": 2, "d": 4}, "e": 5}, mergeValues(value, overlay))
+	assert.Equal(t, 1, value["a"], "The merged values are not modified.")
+	assert.Equal(t, 3, value["b"].(map[string]interface{})["d"])
+
+	assert.Equal(t, "replaced", mergeValues(value, "replaced"), "A value which is not a map replaces the map.")
+	assert.Equal(t, overlay, mergeValues("value", overlay), "A map replaces a value which is not a map.")
+	assert.Equal(t, []interface{}{3}, mergeValues([]interface{}{1, 2}, []interface{}{3}), "Lists are replaced.")
+}
@@ -0,0 +1,130 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"path/filepath"
+
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+)
+
+/*
+ * A manifest file can import the packages of other manifest files:
+ *
+ * imports:
+ *   - billing/manifest.yaml
+ *   - teams/*\/manifest.yaml
+ *
+ * Imports are paths or globs relative to the importing manifest file, imported files
+ * can import further files and every file is imported once. Only the packages of an
+ * imported file are merged into the project, each package keeps the path of the file
+ * declaring it so that its action code and errors refer to that file.
+ */
+
+// importManifests merges the packages of the manifest files imported by a manifest,
+// imported holds the absolute paths of the manifest files read so far
+func (dm *YAMLParser) importManifests(manifest *YAML, imported map[string]bool) error {
+	dir := filepath.Dir(manifest.Filepath)
+	for _, pattern := range manifest.Imports {
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(dir, pattern)
+		}
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return wskderrors.NewYAMLFileFormatError(manifest.Filepath, err)
+		}
+		if len(matches) == 0 {
+			errMessage := wski18n.T(wski18n.ID_ERR_IMPORT_NOT_FOUND_X_import_X,
+				map[string]interface{}{wski18n.KEY_IMPORT: pattern})
+			return wskderrors.NewYAMLFileFormatError(manifest.Filepath, errMessage)
+		}
+
+		for _, match := range matches {
+			absPath, err := filepath.Abs(match)
+			if err != nil {
+				return wskderrors.NewFileReadError(match, err.Error())
+			}
+			if imported[absPath] {
+				continue
+			}
+			imported[absPath] = true
+
+			importedManifest, err := dm.parseManifestFile(match)
+			if err != nil {
+				return err
+			}
+			if err := dm.importManifests(importedManifest, imported); err != nil {
+				return err
+			}
+			if err := mergePackages(manifest, importedManifest); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// mergePackages adds the packages of an imported manifest to the packages of a manifest,
+// a package can only be declared by one manifest file
+func mergePackages(manifest *YAML, importedManifest *YAML) error {
+	packages := manifest.Packages
+	if len(packages) == 0 {
+		if manifest.Project.Packages == nil {
+			manifest.Project.Packages = make(map[string]Package)
+		}
+		packages = manifest.Project.Packages
+	}
+	for name, pkg := range manifestPackages(importedManifest) {
+		if existing, ok := packages[name]; ok {
+			errMessage := wski18n.T(wski18n.ID_ERR_IMPORT_DUPLICATE_PACKAGE_X_package_X_path_X,
+				map[string]interface{}{
+					wski18n.KEY_PACKAGE: name,
+					wski18n.KEY_PATH:    existing.Filepath})
+			return wskderrors.NewYAMLFileFormatError(importedManifest.Filepath, errMessage)
+		}
+		packages[name] = pkg
+	}
+	return nil
+}
+
+// setPackageFilepath records the manifest file declaring each package of a manifest
+func setPackageFilepath(manifest *YAML) {
+	for _, packages := range []map[string]Package{manifest.Packages, manifest.Project.Packages} {
+		for name, pkg := range packages {
+			pkg.Filepath = manifest.Filepath
+			packages[name] = pkg
+		}
+	}
+}
+
+func manifestPackages(manifest *YAML) map[string]Package {
+	if len(manifest.Packages) != 0 {
+		return manifest.Packages
+	}
+	return manifest.GetProject().Packages
+}
+
+// packageFilepath returns the path of the manifest file declaring a package, filePath
+// for packages which were not read by ParseManifest
+func packageFilepath(pkg Package, filePath string) string {
+	if len(pkg.Filepath) != 0 {
+		return pkg.Filepath
+	}
+	return filePath
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path"
@@ -35,12 +36,14 @@ import (
 	"github.com/apache/openwhisk-wskdeploy/conductor"
 	"github.com/apache/openwhisk-wskdeploy/dependencies"
 	"github.com/apache/openwhisk-wskdeploy/runtimes"
+	"github.com/apache/openwhisk-wskdeploy/semver"
 	"github.com/apache/openwhisk-wskdeploy/utils"
 	"github.com/apache/openwhisk-wskdeploy/webaction"
 	"github.com/apache/openwhisk-wskdeploy/wskderrors"
 	"github.com/apache/openwhisk-wskdeploy/wskenv"
 	"github.com/apache/openwhisk-wskdeploy/wski18n"
 	"github.com/apache/openwhisk-wskdeploy/wskprint"
+	"github.com/apache/openwhisk-wskdeploy/wskschema"
 	yamlHelper "github.com/ghodss/yaml"
 )
 
@@ -109,7 +112,20 @@ func (dm *YAMLParser) marshal(manifest *YAML) (output []byte, err error) {
 	return data, nil
 }
 
+// ParseManifest parses a manifest file and merges the packages of the manifest files it imports
 func (dm *YAMLParser) ParseManifest(manifestPath string) (*YAML, error) {
+	manifest, err := dm.parseManifestFile(manifestPath)
+	if err != nil {
+		return manifest, err
+	}
+	imported := make(map[string]bool)
+	if absPath, err := filepath.Abs(manifestPath); err == nil {
+		imported[absPath] = true
+	}
+	return manifest, dm.importManifests(manifest, imported)
+}
+
+func (dm *YAMLParser) parseManifestFile(manifestPath string) (*YAML, error) {
 	mm := NewYAMLParser()
 	maniyaml := YAML{}
 
@@ -118,12 +134,18 @@ func (dm *YAMLParser) ParseManifest(manifestPath string) (*YAML, error) {
 		return &maniyaml, wskderrors.NewFileReadError(manifestPath, err.Error())
 	}
 
+	err = wskschema.ValidateManifest(manifestPath, content)
+	if err != nil {
+		return &maniyaml, err
+	}
+
 	err = mm.Unmarshal(content, &maniyaml)
 	if err != nil {
 		return &maniyaml, wskderrors.NewYAMLParserErr(manifestPath, err)
 	}
 	maniyaml.Filepath = manifestPath
 	manifest := ReadEnvVariable(&maniyaml)
+	setPackageFilepath(manifest)
 
 	return manifest, nil
 }
@@ -211,7 +233,7 @@ func (dm *YAMLParser) ComposeDependenciesFromAllPackages(manifest *YAML, project
 	}
 
 	for n, p := range packages {
-		d, err := dm.ComposeDependencies(p, projectPath, filePath, n, managedAnnotations, packageInputs[n])
+		d, err := dm.ComposeDependencies(p, projectPath, packageFilepath(p, filePath), n, managedAnnotations, packageInputs[n])
 		if err == nil {
 			for k, v := range d {
 				dependencies[k] = v
@@ -240,6 +262,14 @@ func (dm *YAMLParser) ComposeDependencies(pkg Package, projectPath string, fileP
 				location = PATH_SEPARATOR + dependency.Location
 			}
 			isBinding = true
+		} else if dependencies.LocationIsLocal(location) {
+			isBinding = false
+		} else if dependencies.LocationIsGit(location) {
+			// the default branch of the remote, whatever its name
+			if len(dependency.Version) == 0 {
+				version = YAML_VALUE_GIT_HEAD
+			}
+			isBinding = false
 		} else if dependencies.LocationIsGithub(location) {
 
 			// TODO() define const for the protocol prefix, etc.
@@ -267,7 +297,21 @@ func (dm *YAMLParser) ComposeDependencies(pkg Package, projectPath string, fileP
 
 		packDir := path.Join(projectPath, strings.Title(YAML_KEY_PACKAGES))
 		depName := packageName + ":" + key
-		depMap[depName] = dependencies.NewDependencyRecord(packDir, packageName, location, version, inputs, annotations, isBinding)
+		record := dependencies.NewDependencyRecord(packDir, packageName, location, version, inputs, annotations, isBinding)
+		switch record.Source {
+		case dependencies.SOURCE_LOCAL:
+			// local dependencies are relative to the manifest declaring them
+			if record.BaseRepo, err = filepath.Abs(filepath.Join(filepath.Dir(filePath), location)); err != nil {
+				return nil, err
+			}
+		case dependencies.SOURCE_GITHUB:
+			// https://github.com/<org>/<repo>
+			if len(strings.Split(location, PATH_SEPARATOR)) < 5 {
+				return nil, wskderrors.NewDependencyError(key, wski18n.T(wski18n.ID_ERR_DEPENDENCY_INVALID_LOCATION_X_location_X,
+					map[string]interface{}{wski18n.KEY_LOCATION: location}))
+			}
+		}
+		depMap[depName] = record
 	}
 
 	return depMap, nil
@@ -294,7 +338,7 @@ func (dm *YAMLParser) ComposeAllPackages(projectInputs map[string]Parameter, man
 
 	// Compose each package found in manifest
 	for n, p := range manifestPackages {
-		s, params, err := dm.ComposePackage(p, n, filePath, managedAnnotations, projectInputs)
+		s, params, err := dm.ComposePackage(p, n, packageFilepath(p, filePath), managedAnnotations, projectInputs)
 		if err != nil {
 			return nil, inputs, err
 		}
@@ -391,6 +435,19 @@ func (dm *YAMLParser) ComposePackage(pkg Package, packageName string, filePath s
 	}
 	pag.Version = wskenv.ConvertSingleName(pkg.Version)
 
+	// the version of a package must be a semantic version, e.g. 1.2.0
+	if _, err := semver.ParseVersion(pag.Version); err != nil {
+		errString := wski18n.T(wski18n.ID_ERR_PACKAGE_VERSION_INVALID_X_package_X_version_X_err_X,
+			map[string]interface{}{
+				wski18n.KEY_PACKAGE: packageName,
+				wski18n.KEY_VERSION: pag.Version,
+				wski18n.KEY_ERR:     err.Error()})
+		if utils.Flags.Strict {
+			return nil, nil, wskderrors.NewYAMLFileFormatError(filePath, errString)
+		}
+		wskprint.PrintOpenWhiskWarning(errString)
+	}
+
 	//License is a mandatory value
 	//set license to unknown if it is an empty string
 	//And print an warning message
@@ -466,7 +523,7 @@ func (dm *YAMLParser) ComposeSequencesFromAllPackages(namespace string, mani *YA
 	}
 
 	for n, p := range manifestPackages {
-		s, err := dm.ComposeSequences(namespace, p.Sequences, n, manifestFilePath, managedAnnotations, packageInputs[n])
+		s, err := dm.ComposeSequences(namespace, p.Sequences, n, packageFilepath(p, manifestFilePath), managedAnnotations, packageInputs[n])
 		if err == nil {
 			sequences = append(sequences, s...)
 		} else {
@@ -547,7 +604,7 @@ func (dm *YAMLParser) ComposeActionsFromAllPackages(manifest *YAML, filePath str
 	}
 
 	for n, p := range manifestPackages {
-		a, err := dm.ComposeActions(filePath, p.Actions, n, managedAnnotations, packageInputs[n])
+		a, err := dm.ComposeActions(packageFilepath(p, filePath), p.Actions, n, managedAnnotations, packageInputs[n])
 		if err == nil {
 			actions = append(actions, a...)
 		} else {
@@ -686,9 +743,14 @@ func (dm *YAMLParser) readActionFunction(manifestFilePath string, manifestFileNa
 	}
 	exec.Kind = kind
 
-	dat, err := utils.Read(actionFilePath)
-	if err != nil {
-		return actionFilePath, nil, err
+	// remote action source files are not read without network access
+	var dat []byte
+	if !utils.Flags.Offline || !strings.HasPrefix(actionFilePath, HTTP) {
+		var err error
+		dat, err = utils.Read(actionFilePath)
+		if err != nil {
+			return actionFilePath, nil, err
+		}
 	}
 	code := string(dat)
 	if ext == runtimes.ZIP_FILE_EXTENSION || ext == runtimes.JAR_FILE_EXTENSION {
@@ -981,8 +1043,9 @@ func (dm *YAMLParser) ComposeActions(manifestFilePath string, actions map[string
 	return listOfActions, nil
 }
 
-func (dm *YAMLParser) ComposeTriggersFromAllPackages(manifest *YAML, filePath string, managedAnnotations whisk.KeyValue, inputs map[string]PackageInputs) ([]*whisk.Trigger, error) {
+func (dm *YAMLParser) ComposeTriggersFromAllPackages(manifest *YAML, filePath string, managedAnnotations whisk.KeyValue, inputs map[string]PackageInputs) ([]*whisk.Trigger, map[string]string, error) {
 	var triggers []*whisk.Trigger = make([]*whisk.Trigger, 0)
+	statuses := make(map[string]string)
 	manifestPackages := make(map[string]Package)
 
 	if len(manifest.Packages) != 0 {
@@ -992,27 +1055,29 @@ func (dm *YAMLParser) ComposeTriggersFromAllPackages(manifest *YAML, filePath st
 	}
 
 	for packageName, pkg := range manifestPackages {
-		t, err := dm.ComposeTriggers(filePath, pkg, managedAnnotations, inputs[packageName])
+		t, s, err := dm.ComposeTriggers(packageFilepath(pkg, filePath), pkg, packageName, managedAnnotations, inputs[packageName])
 		if err == nil {
 			triggers = append(triggers, t...)
+			for name, status := range s {
+				statuses[name] = status
+			}
 		} else {
-			return nil, err
+			return nil, nil, err
 		}
 	}
-	return triggers, nil
+	return triggers, statuses, nil
 }
 
-func (dm *YAMLParser) ComposeTriggers(filePath string, pkg Package, managedAnnotations whisk.KeyValue, packageInputs PackageInputs) ([]*whisk.Trigger, error) {
+// ComposeTriggers returns the triggers of the package along with the status of the
+// feed of each of its feed triggers, active unless the trigger says otherwise
+func (dm *YAMLParser) ComposeTriggers(filePath string, pkg Package, packageName string, managedAnnotations whisk.KeyValue, packageInputs PackageInputs) ([]*whisk.Trigger, map[string]string, error) {
 	var errorParser error
 	var listOfTriggers []*whisk.Trigger = make([]*whisk.Trigger, 0)
+	statuses := make(map[string]string)
 
 	for _, trigger := range pkg.GetTriggerList() {
 		wsktrigger := new(whisk.Trigger)
-		if i, ok := packageInputs.Inputs[wskenv.GetEnvVarName(trigger.Name)]; ok {
-			wsktrigger.Name = i.Value.(string)
-		} else {
-			wsktrigger.Name = wskenv.ConvertSingleName(trigger.Name)
-		}
+		wsktrigger.Name = composeTriggerName(trigger, packageInputs)
 		wsktrigger.Namespace = trigger.Namespace
 		pub := false
 		wsktrigger.Publish = &pub
@@ -1035,6 +1100,11 @@ func (dm *YAMLParser) ComposeTriggers(filePath string, pkg Package, managedAnnot
 		// to support trigger feed with $READ_FROM_ENV_TRIGGER_FEED
 		trigger.Feed = wskenv.ConvertSingleName(trigger.Feed)
 
+		// a feed of the package is referred to by its name
+		if _, ok := pkg.Feeds[trigger.Feed]; ok {
+			trigger.Feed = feedActionName(pkg, packageName, trigger.Feed)
+		}
+
 		keyValArr := make(whisk.KeyValueArr, 0)
 		if len(trigger.Feed) != 0 {
 			var keyVal whisk.KeyValue
@@ -1044,9 +1114,21 @@ func (dm *YAMLParser) ComposeTriggers(filePath string, pkg Package, managedAnnot
 			wsktrigger.Annotations = keyValArr
 		}
 
+		// only the feed of a trigger can be paused
+		status, err := ComposeStatus(filePath, YAML_KEY_TRIGGER, wsktrigger.Name, trigger.Status)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(trigger.Feed) != 0 {
+			statuses[wsktrigger.Name] = status
+		} else if len(trigger.Status) != 0 {
+			wskprint.PrintOpenWhiskWarning(wski18n.T(wski18n.ID_WARN_TRIGGER_STATUS_IGNORED_X_trigger_X,
+				map[string]interface{}{wski18n.KEY_TRIGGER: wsktrigger.Name}))
+		}
+
 		inputs, err := dm.composeInputs(trigger.Inputs, packageInputs, filePath)
 		if err != nil {
-			return nil, errorParser
+			return nil, nil, errorParser
 		}
 		if len(inputs) > 0 {
 			wsktrigger.Parameters = inputs
@@ -1064,7 +1146,155 @@ func (dm *YAMLParser) ComposeTriggers(filePath string, pkg Package, managedAnnot
 
 		listOfTriggers = append(listOfTriggers, wsktrigger)
 	}
-	return listOfTriggers, nil
+	return listOfTriggers, statuses, nil
+}
+
+// composeTriggerName returns the name of a trigger, which can be a package input or an env. variable
+func composeTriggerName(trigger Trigger, packageInputs PackageInputs) string {
+	if i, ok := packageInputs.Inputs[wskenv.GetEnvVarName(trigger.Name)]; ok {
+		return i.Value.(string)
+	}
+	return wskenv.ConvertSingleName(trigger.Name)
+}
+
+// ComposeStatus returns the status of the trigger or rule, active by default, and fails
+// unless it is active or inactive
+func ComposeStatus(filePath string, key string, name string, status string) (string, error) {
+	switch status {
+	case "":
+		return YAML_VALUE_ACTIVE, nil
+	case YAML_VALUE_ACTIVE, YAML_VALUE_INACTIVE:
+		return status, nil
+	}
+	err := wski18n.T(wski18n.ID_ERR_STATUS_INVALID_X_key_X_name_X_status_X,
+		map[string]interface{}{
+			wski18n.KEY_KEY:    key,
+			wski18n.KEY_NAME:   name,
+			wski18n.KEY_STATUS: status})
+	return "", wskderrors.NewYAMLFileFormatError(filePath, err)
+}
+
+func (dm *YAMLParser) ComposeFeedsFromAllPackages(manifest *YAML, filePath string) ([]utils.FeedRecord, error) {
+	var feeds []utils.FeedRecord = make([]utils.FeedRecord, 0)
+	manifestPackages := make(map[string]Package)
+
+	if len(manifest.Packages) != 0 {
+		manifestPackages = manifest.Packages
+	} else {
+		manifestPackages = manifest.GetProject().Packages
+	}
+
+	for packageName, pkg := range manifestPackages {
+		f, err := dm.ComposeFeeds(packageFilepath(pkg, filePath), pkg, packageName)
+		if err == nil {
+			feeds = append(feeds, f...)
+		} else {
+			return nil, err
+		}
+	}
+	return feeds, nil
+}
+
+// ComposeFeeds returns the feeds of the package, each of them naming the actions of the
+// package which handle its lifecycle operations
+func (dm *YAMLParser) ComposeFeeds(filePath string, pkg Package, packageName string) ([]utils.FeedRecord, error) {
+	var feeds []utils.FeedRecord = make([]utils.FeedRecord, 0)
+
+	for _, feed := range pkg.GetFeedList() {
+		record := utils.FeedRecord{
+			Name:        feed.Name,
+			Packagename: packageName,
+			Action:      feedActionName(pkg, packageName, feed.Name),
+			Operations:  make(map[string]string),
+			Inputs:      make(map[string]interface{}),
+		}
+		if err := checkFeedAction(filePath, pkg, feed.Name, record.Action); err != nil {
+			return nil, err
+		}
+
+		operations := feed.Operations
+		if len(operations) == 0 {
+			operations = make(map[string]interface{})
+			for _, operation := range FEED_OPERATIONS {
+				operations[operation] = nil
+			}
+		}
+		for operation, handler := range operations {
+			if !isFeedOperation(operation) {
+				err := wski18n.T(wski18n.ID_ERR_FEED_OPERATION_INVALID_X_feed_X_operation_X_operations_X,
+					map[string]interface{}{
+						wski18n.KEY_TRIGGER_FEED: feed.Name,
+						wski18n.KEY_OPERATION:    operation,
+						wski18n.KEY_OPERATIONS:   strings.Join(FEED_OPERATIONS, ", ")})
+				return nil, wskderrors.NewYAMLFileFormatError(filePath, err)
+			}
+			action := record.Action
+			if handler != nil {
+				action = qualifiedActionName(packageName, wskenv.ConvertSingleName(fmt.Sprint(handler)))
+				if err := checkFeedAction(filePath, pkg, feed.Name, action); err != nil {
+					return nil, err
+				}
+			}
+			record.Operations[strings.ToUpper(operation)] = action
+		}
+
+		for name, value := range feed.Inputs {
+			record.Inputs[name] = wskenv.InterpolateStringWithEnvVar(value)
+		}
+		if len(feed.Location) != 0 {
+			record.Inputs[YAML_KEY_LOCATION] = wskenv.InterpolateStringWithEnvVar(feed.Location)
+		}
+		if len(feed.Credential) != 0 {
+			record.Inputs[YAML_KEY_CREDENTIAL] = wskenv.InterpolateStringWithEnvVar(feed.Credential)
+		}
+		feeds = append(feeds, record)
+	}
+	return feeds, nil
+}
+
+// isFeedOperation tells whether operation is a lifecycle operation of feeds, e.g. create
+func isFeedOperation(operation string) bool {
+	for _, known := range FEED_OPERATIONS {
+		if operation == known {
+			return true
+		}
+	}
+	return false
+}
+
+// feedActionName returns the qualified name of the action implementing the feed of the
+// package, e.g. pkg/changes
+func feedActionName(pkg Package, packageName string, feedName string) string {
+	action := pkg.Feeds[feedName].Action
+	if len(action) == 0 {
+		action = feedName
+	}
+	return qualifiedActionName(packageName, wskenv.ConvertSingleName(action))
+}
+
+// qualifiedActionName returns the name of an action of the package relative to the
+// namespace, actions of the default package are deployed directly under the namespace
+func qualifiedActionName(packageName string, action string) string {
+	if strings.ToLower(packageName) == DEFAULT_PACKAGE {
+		return action
+	}
+	return packageName + PATH_SEPARATOR + action
+}
+
+// checkFeedAction fails unless the qualified action is an action or a sequence of the package
+func checkFeedAction(filePath string, pkg Package, feedName string, action string) error {
+	name := action[strings.LastIndex(action, PATH_SEPARATOR)+1:]
+	if _, ok := pkg.Actions[name]; ok {
+		return nil
+	}
+	if _, ok := pkg.Sequences[name]; ok {
+		return nil
+	}
+	err := wski18n.T(wski18n.ID_ERR_FEED_ACTION_NOT_FOUND_X_feed_X_action_X,
+		map[string]interface{}{
+			wski18n.KEY_TRIGGER_FEED: feedName,
+			wski18n.KEY_ACTION:       name})
+	return wskderrors.NewYAMLFileFormatError(filePath, err)
 }
 
 func (dm *YAMLParser) ComposeRulesFromAllPackages(manifest *YAML, managedAnnotations whisk.KeyValue, packageInputs map[string]PackageInputs) ([]*whisk.Rule, error) {
@@ -1078,7 +1308,7 @@ func (dm *YAMLParser) ComposeRulesFromAllPackages(manifest *YAML, managedAnnotat
 	}
 
 	for n, p := range manifestPackages {
-		r, err := dm.ComposeRules(p, n, managedAnnotations, packageInputs[n])
+		r, err := dm.ComposeRules(packageFilepath(p, manifest.Filepath), p, n, managedAnnotations, packageInputs[n])
 		if err == nil {
 			rules = append(rules, r...)
 		} else {
@@ -1088,7 +1318,7 @@ func (dm *YAMLParser) ComposeRulesFromAllPackages(manifest *YAML, managedAnnotat
 	return rules, nil
 }
 
-func (dm *YAMLParser) ComposeRules(pkg Package, packageName string, managedAnnotations whisk.KeyValue, packageInputs PackageInputs) ([]*whisk.Rule, error) {
+func (dm *YAMLParser) ComposeRules(filePath string, pkg Package, packageName string, managedAnnotations whisk.KeyValue, packageInputs PackageInputs) ([]*whisk.Rule, error) {
 	var rules []*whisk.Rule = make([]*whisk.Rule, 0)
 
 	for _, rule := range pkg.GetRuleList() {
@@ -1117,6 +1347,13 @@ func (dm *YAMLParser) ComposeRules(pkg Package, packageName string, managedAnnot
 			act = path.Join(packageName, act)
 		}
 		wskrule.Action = act
+
+		status, err := ComposeStatus(filePath, YAML_KEY_RULE, wskrule.Name, rule.Status)
+		if err != nil {
+			return nil, err
+		}
+		wskrule.Status = status
+
 		listOfAnnotations := dm.composeAnnotations(rule.Annotations)
 		if len(listOfAnnotations) > 0 {
 			wskrule.Annotations = append(wskrule.Annotations, listOfAnnotations...)
@@ -1146,7 +1383,7 @@ func (dm *YAMLParser) ComposeApiRecordsFromAllPackages(client *whisk.Config, man
 	}
 
 	for packageName, p := range manifestPackages {
-		r, response, err := dm.ComposeApiRecords(client, packageName, p, manifest.Filepath,
+		r, response, err := dm.ComposeApiRecords(client, packageName, p, packageFilepath(p, manifest.Filepath),
 			actionrecords, sequencerecords)
 		if err == nil {
 			requests = append(requests, r...)
@@ -30,6 +30,7 @@ import (
 	"testing"
 
 	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/dependencies"
 	"github.com/apache/openwhisk-wskdeploy/runtimes"
 	"github.com/apache/openwhisk-wskdeploy/utils"
 	"github.com/apache/openwhisk-wskdeploy/wskderrors"
@@ -1262,6 +1263,115 @@ func TestResolveParameterForMultiLineParams(t *testing.T) {
 
 }
 
+// validate the values of the OpenWhisk parameter types
+func TestResolveParameterForTypedParams(t *testing.T) {
+	valid := map[string]interface{}{
+		TIMESTAMP:        "2018-04-01T12:30:00.5+02:00",
+		VERSION:          "v2.0.1",
+		STRING16:         strings.Repeat("é", 16),
+		STRING64:         strings.Repeat("a", 64),
+		STRING256:        strings.Repeat("a", 256),
+		SCALAR_UNIT:      "30 d",
+		SCALAR_UNIT_SIZE: "2.5GB",
+		SCALAR_UNIT_TIME: " 10 ms ",
+	}
+	for paramType, value := range valid {
+		param := Parameter{Type: paramType, Value: value, multiline: true}
+		r, err := ResolveParameter(paramType, &param, "")
+		assert.Nil(t, err, fmt.Sprintf(TEST_MSG_ACTION_PARAMETER_VALUE_MISMATCH, paramType))
+		assert.Equal(t, value, r, fmt.Sprintf(TEST_MSG_ACTION_PARAMETER_VALUE_MISMATCH, paramType))
+	}
+
+	invalid := map[string]interface{}{
+		TIMESTAMP:        "2018-04-01 12:30",
+		VERSION:          "latest",
+		STRING16:         strings.Repeat("é", 17),
+		STRING64:         strings.Repeat("a", 65),
+		STRING256:        strings.Repeat("a", 257),
+		SCALAR_UNIT:      "GB",
+		SCALAR_UNIT_SIZE: "10 s",
+		SCALAR_UNIT_TIME: "10 S",
+		NULL:             "foo",
+	}
+	for paramType, value := range invalid {
+		param := Parameter{Type: paramType, Value: value, multiline: true}
+		_, err := ResolveParameter(paramType, &param, "")
+		assert.IsType(t, &wskderrors.ParameterTypeMismatchError{}, err, fmt.Sprintf(TEST_MSG_ACTION_PARAMETER_TYPE_MISMATCH, paramType))
+	}
+
+	param := Parameter{Type: STRING64, Value: strings.Repeat("a", 70), multiline: true}
+	_, err := ResolveParameter("abstract", &param, "")
+	assert.Contains(t, err.Error(), "string(70)")
+	param = Parameter{Type: TIMESTAMP, Value: 12, multiline: true}
+	_, err = ResolveParameter("created", &param, "")
+	assert.Contains(t, err.Error(), "[int]")
+}
+
+func TestComposeActionsForTypedParams(t *testing.T) {
+	file := "../tests/dat/manifest_validate_typed_params.yaml"
+	p, m, _ := testLoadParseManifest(t, file)
+
+	actions, err := p.ComposeActionsFromAllPackages(m, m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err, fmt.Sprintf(TEST_ERROR_COMPOSE_ACTION_FAILURE, file))
+	assert.Equal(t, 1, len(actions), TEST_MSG_ACTION_NUMBER_MISMATCH)
+
+	expected := map[string]interface{}{
+		"created": "2018-04-01T12:30:00Z",
+		"expires": "",
+		"release": "1.4.2-rc.1",
+		"title":   "Hello",
+		"summary": "",
+		"memory":  "256 MB",
+		"timeout": "600s",
+	}
+	parameters := actions[0].Action.Parameters
+	for paramName, value := range expected {
+		assert.Equal(t, value, parameters.GetValue(paramName), fmt.Sprintf(TEST_MSG_ACTION_PARAMETER_VALUE_MISMATCH, paramName))
+	}
+	assert.Nil(t, parameters.GetValue("placeholder"), fmt.Sprintf(TEST_MSG_ACTION_PARAMETER_VALUE_MISMATCH, "placeholder"))
+}
+
+func TestComposeParameterSchemas(t *testing.T) {
+	file := "../tests/dat/manifest_validate_schema_params.yaml"
+	p, m, _ := testLoadParseManifest(t, file)
+
+	schemas, err := p.ComposeParameterSchemasFromAllPackages(m, m.Filepath, map[string]PackageInputs{})
+	assert.Nil(t, err, "Failed to compose the parameter schemas of "+file)
+
+	expected := []string{
+		"package:validate_schema:region",
+		"action:validate_schema_params:config",
+		"action:validate_schema_params:retry",
+		"action:validate_schema_params:title",
+		"trigger:schedule:cron",
+	}
+	actual := make([]string, 0, len(schemas))
+	for _, schema := range schemas {
+		actual = append(actual, schema.Key+":"+schema.Entity+":"+schema.Parameter)
+		assert.Equal(t, "validate_schema", schema.Package)
+		assert.Equal(t, file, schema.Filepath)
+	}
+	assert.Equal(t, expected, actual)
+	assert.Nil(t, schemas[3].Schema, "title only declares a validated type")
+	assert.Equal(t, STRING16, schemas[3].Type)
+	assert.Empty(t, schemas[1].Type, "json values are not validated by type")
+
+	// the schema of retry is read from a file relative to the manifest file
+	violations := schemas[2].Schema.ValidateValue(map[string]interface{}{"attempts": 0, "delay": "soon"})
+	assert.Equal(t, 2, len(violations))
+	assert.Equal(t, "/attempts", violations[0].Path)
+	assert.Equal(t, "/delay", violations[1].Path)
+
+	file = "../tests/dat/manifest_validate_schema_params_invalid.yaml"
+	p, m, _ = testLoadParseManifest(t, file)
+	_, err = p.ComposeParameterSchemasFromAllPackages(m, m.Filepath, map[string]PackageInputs{})
+	assert.NotNil(t, err, "A missing schema file should fail to compose.")
+	assert.Contains(t, err.Error(), "Parameter [region] has an invalid schema")
+
+	_, err = ComposeParameterSchema(file, "config", map[interface{}]interface{}{"type": "string", "pattern": "[a-"})
+	assert.NotNil(t, err, "An invalid inline schema should fail to compose.")
+}
+
 // Test 17: validate JSON parameters
 func TestParseManifestForJSONParams(t *testing.T) {
 
@@ -1414,6 +1524,31 @@ func TestComposePackage(t *testing.T) {
 	assert.False(t, *(pkg[n].Publish), "Default package should not be maked as public.")
 }
 
+func TestComposePackage_Version(t *testing.T) {
+	p := NewYAMLParser()
+	file := "manifest.yaml"
+
+	// semantic versions, possibly partial or prefixed, are accepted
+	for _, version := range []string{"1.0", "v1.0", "1.2.3-beta.1", ""} {
+		pkg, _, err := p.ComposePackage(Package{Version: version}, "helloworld", file, whisk.KeyValue{}, nil)
+		assert.Nil(t, err, version)
+		if version == "" {
+			assert.Equal(t, DEFAULT_PACKAGE_VERSION, pkg.Version)
+		}
+	}
+
+	// other versions are deployed with a warning, and fail in strict mode
+	pkg, _, err := p.ComposePackage(Package{Version: "latest"}, "helloworld", file, whisk.KeyValue{}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "latest", pkg.Version)
+
+	utils.Flags.Strict = true
+	defer func() { utils.Flags.Strict = false }()
+	_, _, err = p.ComposePackage(Package{Version: "latest"}, "helloworld", file, whisk.KeyValue{}, nil)
+	assert.IsType(t, &wskderrors.YAMLFileFormatError{}, err)
+	assert.Contains(t, err.Error(), "latest")
+}
+
 func TestYAMLParser_ComposePackage_Inputs(t *testing.T) {
 	os.Setenv("SLACK_USERNAME", "slack_username")
 	os.Setenv("SLACK_URL", "https://hooks.slack.com/services/slack_webhook_url")
@@ -1527,7 +1662,7 @@ func TestComposeTriggers(t *testing.T) {
 
 	p, m, _ := testLoadParseManifest(t, "../tests/dat/manifest_data_compose_triggers.yaml")
 
-	triggerList, err := p.ComposeTriggersFromAllPackages(m, m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	triggerList, _, err := p.ComposeTriggersFromAllPackages(m, m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
 
 	if err != nil {
 		assert.Fail(t, "Failed to compose trigger")
@@ -1550,6 +1685,89 @@ func TestComposeTriggers(t *testing.T) {
 	}
 }
 
+func TestComposeFeeds(t *testing.T) {
+	os.Setenv("FEED_CREDENTIAL", "secret")
+	defer os.Unsetenv("FEED_CREDENTIAL")
+
+	file := "../tests/dat/manifest_data_compose_feeds.yaml"
+	p, m, _ := testLoadParseManifest(t, file)
+
+	feeds, err := p.ComposeFeedsFromAllPackages(m, m.Filepath)
+	assert.Nil(t, err, fmt.Sprintf("Failed to compose feeds of [%s]", file))
+	assert.Equal(t, 2, len(feeds), "Failed to get feeds")
+	for _, feed := range feeds {
+		assert.Equal(t, "events", feed.Packagename)
+		switch feed.Name {
+		case "changes":
+			assert.Equal(t, "events/changesFeed", feed.Action)
+			assert.Equal(t, map[string]string{
+				"CREATE":  "events/changesFeed",
+				"DELETE":  "events/changesFeed",
+				"PAUSE":   "events/pauseChanges",
+				"UNPAUSE": "events/pauseChanges",
+			}, feed.Operations)
+			assert.Equal(t, map[string]interface{}{
+				"topic":      "documents",
+				"location":   "https://events.example.com/changes",
+				"credential": "secret",
+			}, feed.Inputs)
+		case "ticks":
+			// the feed action is the action named after the feed, handling every operation
+			assert.Equal(t, "events/ticks", feed.Action)
+			assert.Equal(t, len(FEED_OPERATIONS), len(feed.Operations))
+			for _, action := range feed.Operations {
+				assert.Equal(t, "events/ticks", action)
+			}
+		default:
+			t.Errorf("Unexpected feed [%s]", feed.Name)
+		}
+	}
+
+	// triggers refer to the feeds of their package by name
+	triggers, statuses, err := p.ComposeTriggersFromAllPackages(m, m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err)
+	feedOf := make(map[string]interface{})
+	for _, trigger := range triggers {
+		feedOf[trigger.Name] = trigger.Annotations.GetValue(YAML_KEY_FEED)
+	}
+	assert.Equal(t, map[string]interface{}{
+		"onChange": "events/changesFeed",
+		"onTick":   "events/ticks",
+		"onAlarm":  "/whisk.system/alarms/alarm",
+	}, feedOf)
+	assert.Equal(t, map[string]string{
+		"onChange": YAML_VALUE_ACTIVE,
+		"onTick":   YAML_VALUE_INACTIVE,
+		"onAlarm":  YAML_VALUE_ACTIVE,
+	}, statuses)
+}
+
+func TestComposeFeeds_Invalid(t *testing.T) {
+	p := NewYAMLParser()
+	pkg := Package{
+		Actions: map[string]Action{"changes": {}},
+		Feeds:   map[string]Feed{"changes": {Operations: map[string]interface{}{"create": "unknown"}}},
+	}
+	_, err := p.ComposeFeeds("manifest.yaml", pkg, "events")
+	assert.IsType(t, &wskderrors.YAMLFileFormatError{}, err)
+	assert.Contains(t, err.Error(), "unknown")
+
+	pkg.Feeds["changes"] = Feed{Operations: map[string]interface{}{"refresh": nil}}
+	_, err = p.ComposeFeeds("manifest.yaml", pkg, "events")
+	assert.IsType(t, &wskderrors.YAMLFileFormatError{}, err)
+	assert.Contains(t, err.Error(), "refresh")
+
+	pkg.Feeds = map[string]Feed{"ticks": {}}
+	_, err = p.ComposeFeeds("manifest.yaml", pkg, "events")
+	assert.IsType(t, &wskderrors.YAMLFileFormatError{}, err)
+	assert.Contains(t, err.Error(), "ticks")
+
+	pkg.Triggers = map[string]Trigger{"onTick": {Feed: "/whisk.system/alarms/alarm", Status: "paused"}}
+	_, _, err = p.ComposeTriggers("manifest.yaml", pkg, "events", whisk.KeyValue{}, PackageInputs{})
+	assert.IsType(t, &wskderrors.YAMLFileFormatError{}, err)
+	assert.Contains(t, err.Error(), "paused")
+}
+
 func TestComposeRules(t *testing.T) {
 
 	p, m, _ := testLoadParseManifest(t, "../tests/dat/manifest_data_compose_rules.yaml")
@@ -1564,11 +1782,19 @@ func TestComposeRules(t *testing.T) {
 		case "rule1":
 			assert.Equal(t, "locationUpdate", rule.Trigger, "Failed to set rule trigger")
 			assert.Equal(t, "helloworld/greeting", rule.Action, "Failed to set rule action")
+			assert.Equal(t, YAML_VALUE_ACTIVE, rule.Status, "Failed to default rule status")
 		case "rule2":
 			assert.Equal(t, "trigger1", rule.Trigger, "Failed to set rule trigger")
 			assert.Equal(t, "helloworld/action1", rule.Action, "Failed to set rule action")
+			assert.Equal(t, YAML_VALUE_INACTIVE, rule.Status, "Failed to set rule status")
 		}
 	}
+
+	pkg := m.Packages["helloworld"]
+	pkg.Rules = map[string]Rule{"rule1": {Trigger: "locationUpdate", Action: "greeting", Status: "disabled"}}
+	_, err = p.ComposeRules("manifest.yaml", pkg, "helloworld", whisk.KeyValue{}, PackageInputs{})
+	assert.IsType(t, &wskderrors.YAMLFileFormatError{}, err)
+	assert.Contains(t, err.Error(), "disabled")
 }
 
 func TestComposeApiRecords(t *testing.T) {
@@ -1691,14 +1917,49 @@ func TestComposeDependencies(t *testing.T) {
 	}
 }
 
+func TestComposeDependencies_Sources(t *testing.T) {
+
+	file := "../tests/dat/manifest_data_compose_dependencies_sources.yaml"
+	p, m, _ := testLoadParseManifest(t, file)
+
+	depdList, err := p.ComposeDependenciesFromAllPackages(m, "/project_folder", m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err, fmt.Sprintf(TEST_ERROR_COMPOSE_DEPENDENCY_FAILURE, file))
+	assert.Equal(t, 3, len(depdList), "Failed to get dependencies")
+
+	local := depdList["helloworld:myLocal"]
+	assert.Equal(t, dependencies.SOURCE_LOCAL, local.Source, "Failed to set dependency source")
+	assert.False(t, local.IsBinding, "Failed to set dependency isbinding")
+	dir, _ := filepath.Abs("../tests/dat/src/utils")
+	assert.Equal(t, dir, local.BaseRepo, "Failed to resolve local dependency relative to the manifest")
+
+	git := depdList["helloworld:myGit"]
+	assert.Equal(t, dependencies.SOURCE_GIT, git.Source, "Failed to set dependency source")
+	assert.Equal(t, "https://git.example.com/user/repo.git", git.BaseRepo, "Failed to set dependency base repo url")
+	assert.Equal(t, "/folder", git.SubFolder, "Failed to set dependency sub folder")
+	assert.Equal(t, YAML_VALUE_GIT_HEAD, git.Version, "Failed to default git dependency to HEAD")
+
+	tagged := depdList["helloworld:myTaggedGit"]
+	assert.Equal(t, dependencies.SOURCE_GIT, tagged.Source, "Failed to set dependency source")
+	assert.Equal(t, "v1.0", tagged.Version, "Failed to set dependency version")
+}
+
+func TestComposeDependencies_InvalidLocation(t *testing.T) {
+
+	file := "../tests/dat/manifest_data_compose_dependencies_invalid_location.yaml"
+	p, m, _ := testLoadParseManifest(t, file)
+
+	_, err := p.ComposeDependenciesFromAllPackages(m, "/project_folder", m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.IsType(t, &wskderrors.DependencyError{}, err)
+	assert.Contains(t, err.Error(), "github.com/user")
+}
+
 func TestBadYAMLInvalidPackageKeyInManifest(t *testing.T) {
 	// read and parse manifest.yaml file located under ../tests folder
 	p := NewYAMLParser()
 	_, err := p.ParseManifest("../tests/dat/manifest_bad_yaml_invalid_package_key.yaml")
 
 	assert.NotNil(t, err)
-	// NOTE: go-yaml/yaml gets the line # wrong; testing only for the invalid key message
-	assert.Contains(t, err.Error(), "field invalidKey not found in type parsers.Package")
+	assert.Contains(t, err.Error(), "manifest_bad_yaml_invalid_package_key.yaml:21:5: $.packages.testBadYAMLInvalidPackageKeyInManifest: Unknown property [invalidKey].")
 }
 
 func TestBadYAMLInvalidKeyMappingValueInManifest(t *testing.T) {
@@ -1717,7 +1978,7 @@ func TestBadYAMLMissingRootKeyInManifest(t *testing.T) {
 	_, err := p.ParseManifest("../tests/dat/manifest_bad_yaml_missing_root_key.yaml")
 
 	assert.NotNil(t, err)
-	assert.Contains(t, err.Error(), "field actions not found in type parsers.YAML")
+	assert.Contains(t, err.Error(), "manifest_bad_yaml_missing_root_key.yaml:18:1: $: Unknown property [actions].")
 }
 
 func TestBadYAMLInvalidCommentInManifest(t *testing.T) {
@@ -2032,3 +2293,69 @@ func TestComposeActionForAnnotations(t *testing.T) {
 	eq = reflect.DeepEqual(actual_annotations, expected_annotations)
 	assert.True(t, eq, "Expected list of annotations does not match with actual list, expected annotations: %v actual annotations: %v", expected_annotations, actual_annotations)
 }
+
+// validate manifest_parser.ParseManifest() merges the packages of imported manifest files
+// and composes the actions of each package relative to the manifest file declaring it
+func TestParseManifest_Imports(t *testing.T) {
+	file := "../tests/dat/imports/manifest.yaml"
+	p, m, err := testLoadParseManifest(t, file)
+	if err != nil {
+		return
+	}
+
+	packages := m.GetProject().Packages
+	assert.Equal(t, 0, len(packages), "Imported packages should be merged with the packages of the root manifest.")
+	assert.Equal(t, 4, len(m.Packages))
+	assert.Equal(t, file, m.Packages["shared"].Filepath)
+	assert.Equal(t, "../tests/dat/imports/billing/manifest.yaml", m.Packages["billing"].Filepath)
+	assert.Equal(t, "../tests/dat/imports/teams/orders/manifest.yaml", m.Packages["orders"].Filepath)
+	assert.Equal(t, "../tests/dat/imports/teams/users/manifest.yaml", m.Packages["users"].Filepath)
+
+	actions, err := p.ComposeActionsFromAllPackages(m, m.Filepath, whisk.KeyValue{}, map[string]PackageInputs{})
+	assert.Nil(t, err, fmt.Sprintf(TEST_ERROR_COMPOSE_ACTION_FAILURE, file))
+	assert.Equal(t, 4, len(actions))
+	for _, action := range actions {
+		assert.Contains(t, *action.Action.Exec.Code, "action: \""+action.Action.Name+"\"",
+			"Action code should be read relative to the manifest file declaring the package.")
+	}
+}
+
+func testImportErrorPath(t *testing.T, err error) string {
+	if formatErr, ok := err.(*wskderrors.YAMLFileFormatError); ok {
+		return formatErr.ErrorFilePath
+	}
+	if parserErr, ok := err.(*wskderrors.SchemaValidationError); ok {
+		return parserErr.ErrorFilePath
+	}
+	assert.Fail(t, "Unexpected error: "+err.Error())
+	return ""
+}
+
+func TestParseManifest_ImportErrors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wskdeploy-imports")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	root := filepath.Join(dir, "manifest.yaml")
+	other := filepath.Join(dir, "other.yaml")
+	assert.Nil(t, ioutil.WriteFile(root, []byte("imports:\n  - teams/*.yaml\npackages:\n  hello:\n"), 0644))
+	_, err = NewYAMLParser().ParseManifest(root)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, root, testImportErrorPath(t, err))
+		assert.Contains(t, err.Error(), "Import ["+filepath.Join(dir, "teams/*.yaml")+"] does not match any manifest file.")
+	}
+
+	assert.Nil(t, ioutil.WriteFile(root, []byte("imports:\n  - other.yaml\npackages:\n  hello:\n"), 0644))
+	assert.Nil(t, ioutil.WriteFile(other, []byte("packages:\n  hello:\n"), 0644))
+	_, err = NewYAMLParser().ParseManifest(root)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, other, testImportErrorPath(t, err))
+		assert.Contains(t, err.Error(), "Package [hello] is already declared in manifest file ["+root+"].")
+	}
+
+	assert.Nil(t, ioutil.WriteFile(other, []byte("packages:\n  bye:\n    action:\n"), 0644))
+	_, err = NewYAMLParser().ParseManifest(root)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, other, testImportErrorPath(t, err))
+	}
+}
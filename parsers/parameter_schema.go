@@ -0,0 +1,146 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parsers
+
+import (
+	"path/filepath"
+
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+	"github.com/apache/openwhisk-wskdeploy/wskschema"
+)
+
+/*
+ * An input parameter can declare the JSON Schema its value must conform to, either inline
+ * or as the path of a JSON or YAML file relative to the manifest file:
+ *
+ * inputs:
+ *   config:
+ *     type: json
+ *     schema: schemas/config.schema.json
+ *
+ * The value is validated once the deployment files and --param have been applied, and so
+ * are the values of the types checked by ValidateParameterValue, e.g. string64.
+ */
+
+// ParameterSchema is the schema of an input parameter of a package, action or trigger
+type ParameterSchema struct {
+	Key       string // YAML_KEY_PACKAGE, YAML_KEY_ACTION or YAML_KEY_TRIGGER
+	Package   string
+	Entity    string // name of the package, action or trigger
+	Parameter string
+	Filepath  string            // manifest file declaring the parameter
+	Type      string            // type of the parameter, if IsValidatedType
+	Schema    *wskschema.Schema // nil if the parameter only declares a Type
+}
+
+func (dm *YAMLParser) ComposeParameterSchemasFromAllPackages(manifest *YAML, filePath string, packageInputs map[string]PackageInputs) ([]ParameterSchema, error) {
+	schemas := make([]ParameterSchema, 0)
+	manifestPackages := make(map[string]Package)
+
+	if len(manifest.Packages) != 0 {
+		manifestPackages = manifest.Packages
+	} else {
+		manifestPackages = manifest.GetProject().Packages
+	}
+
+	for _, n := range utils.SortedKeys(manifestPackages) {
+		p := manifestPackages[n]
+		s, err := dm.ComposeParameterSchemas(packageFilepath(p, filePath), p, n, packageInputs[n])
+		if err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, s...)
+	}
+	return schemas, nil
+}
+
+// ComposeParameterSchemas returns the schemas and the validated types of the input parameters
+// of a package and of its actions and triggers, in a stable order
+func (dm *YAMLParser) ComposeParameterSchemas(filePath string, pkg Package, packageName string, packageInputs PackageInputs) ([]ParameterSchema, error) {
+	schemas := make([]ParameterSchema, 0)
+	add := func(key string, entity string, inputs map[string]Parameter) error {
+		for _, name := range utils.SortedKeys(inputs) {
+			schema, err := ComposeParameterSchema(filePath, name, inputs[name].Schema)
+			if err != nil {
+				return err
+			}
+			paramType := inputs[name].Type
+			if !IsValidatedType(paramType) {
+				paramType = ""
+			}
+			if schema != nil || len(paramType) != 0 {
+				schemas = append(schemas, ParameterSchema{
+					Key:       key,
+					Package:   packageName,
+					Entity:    entity,
+					Parameter: name,
+					Filepath:  filePath,
+					Type:      paramType,
+					Schema:    schema,
+				})
+			}
+		}
+		return nil
+	}
+
+	if err := add(YAML_KEY_PACKAGE, packageName, pkg.Inputs); err != nil {
+		return nil, err
+	}
+	for _, name := range utils.SortedKeys(pkg.Actions) {
+		if err := add(YAML_KEY_ACTION, name, pkg.Actions[name].Inputs); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range utils.SortedKeys(pkg.Triggers) {
+		trigger := pkg.Triggers[name]
+		trigger.Name = name
+		if err := add(YAML_KEY_TRIGGER, composeTriggerName(trigger, packageInputs), trigger.Inputs); err != nil {
+			return nil, err
+		}
+	}
+	return schemas, nil
+}
+
+// ComposeParameterSchema returns the schema declared by a parameter, inline or as the path
+// of a schema file relative to the manifest file, or nil if the parameter has no schema
+func ComposeParameterSchema(filePath string, paramName string, schema interface{}) (*wskschema.Schema, error) {
+	if schema == nil {
+		return nil, nil
+	}
+
+	var composed *wskschema.Schema
+	var err error
+	if path, ok := schema.(string); ok {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(filepath.Dir(filePath), path)
+		}
+		composed, err = wskschema.ReadSchemaFile(path)
+	} else {
+		composed, err = wskschema.NewSchema(schema)
+	}
+	if err != nil {
+		errString := wski18n.T(wski18n.ID_ERR_PARAMETER_SCHEMA_INVALID_X_name_X_err_X,
+			map[string]interface{}{
+				wski18n.KEY_NAME: paramName,
+				wski18n.KEY_ERR:  err.Error()})
+		return nil, wskderrors.NewYAMLFileFormatError(filePath, errString)
+	}
+	return composed, nil
+}
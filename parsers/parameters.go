@@ -19,23 +19,35 @@ package parsers
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/apache/openwhisk-wskdeploy/semver"
 	"github.com/apache/openwhisk-wskdeploy/utils"
 	"github.com/apache/openwhisk-wskdeploy/wskderrors"
 	"github.com/apache/openwhisk-wskdeploy/wskenv"
 	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 )
 
-// TODO(): Support other valid Package Manifest types
-// TODO(): i.e., timestamp, version, string256, string64, string16
-// TODO(): Support JSON schema validation for type: json
 // TODO(): Support OpenAPI schema validation
 const (
-	STRING  string = "string"
-	INTEGER string = "integer"
-	FLOAT   string = "float"
-	BOOLEAN string = "boolean"
-	JSON    string = "json"
-	SLICE   string = "slice"
+	STRING           string = "string"
+	INTEGER          string = "integer"
+	FLOAT            string = "float"
+	BOOLEAN          string = "boolean"
+	JSON             string = "json"
+	SLICE            string = "slice"
+	TIMESTAMP        string = "timestamp"
+	NULL             string = "null"
+	VERSION          string = "version"
+	STRING256        string = "string256"
+	STRING64         string = "string64"
+	STRING16         string = "string16"
+	SCALAR_UNIT      string = "scalar-unit"
+	SCALAR_UNIT_SIZE string = "scalar-unit.size"
+	SCALAR_UNIT_TIME string = "scalar-unit.time"
 )
 
 var validParameterNameMap = map[string]string{
@@ -54,6 +66,16 @@ var validParameterNameMap = map[string]string{
 	JSON:      JSON,
 	"map":     JSON,
 	"slice":   SLICE,
+	// OpenWhisk types
+	TIMESTAMP:        TIMESTAMP,
+	NULL:             NULL,
+	VERSION:          VERSION,
+	STRING256:        STRING256,
+	STRING64:         STRING64,
+	STRING16:         STRING16,
+	SCALAR_UNIT:      SCALAR_UNIT,
+	SCALAR_UNIT_SIZE: SCALAR_UNIT_SIZE,
+	SCALAR_UNIT_TIME: SCALAR_UNIT_TIME,
 }
 
 var typeDefaultValueMap = map[string]interface{}{
@@ -62,18 +84,52 @@ var typeDefaultValueMap = map[string]interface{}{
 	FLOAT:   0.0,
 	BOOLEAN: false,
 	JSON:    make(map[string]interface{}),
+	// OpenWhisk types, validated by ValidateParameterValue
+	TIMESTAMP:        "",
+	NULL:             nil,
+	VERSION:          "",
+	STRING256:        "",
+	STRING64:         "",
+	STRING16:         "",
+	SCALAR_UNIT:      "",
+	SCALAR_UNIT_SIZE: "",
+	SCALAR_UNIT_TIME: "",
 	// TODO() Support these types + their validation
-	// timestamp
-	// null
-	// version
-	// string256
-	// string64
-	// string16
-	// scalar-unit
 	// schema
 	// object
 }
 
+// maximum length, in characters, of the values of the string length classes
+var stringTypeLengthMap = map[string]int{
+	STRING256: 256,
+	STRING64:  64,
+	STRING16:  16,
+}
+
+// units recognized by the scalar-unit types, the case of a unit is significant
+var scalarUnitMap = map[string][]string{
+	SCALAR_UNIT_SIZE: {"B", "kB", "MB", "GB", "TB"},
+	SCALAR_UNIT_TIME: {"d", "h", "m", "s", "ms", "us"},
+}
+
+// a scalar followed by its unit, e.g. 10 GB or 600s
+var scalarUnit = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*([A-Za-z]+)$`)
+
+// isStringType tells if values of the type are strings, which may hold env. variables
+func isStringType(typeName string) bool {
+	switch typeName {
+	case STRING, TIMESTAMP, VERSION, SCALAR_UNIT, SCALAR_UNIT_SIZE, SCALAR_UNIT_TIME:
+		return true
+	}
+	_, isStringClass := stringTypeLengthMap[typeName]
+	return isStringClass
+}
+
+// IsValidatedType tells if the values of the type are checked by ValidateParameterValue
+func IsValidatedType(typeName string) bool {
+	return typeName == NULL || (typeName != STRING && isStringType(typeName))
+}
+
 func isValidParameterType(typeName string) bool {
 	_, isValid := typeDefaultValueMap[typeName]
 	return isValid
@@ -339,7 +395,7 @@ func ResolveParameter(paramName string, param *Parameter, filePath string) (inte
 	// See if we have any Environment Variable replacement within the parameter's value
 
 	// Make sure the parameter's value is a valid, non-empty string
-	if param.Value != nil && param.Type == "string" {
+	if param.Value != nil && isStringType(param.Type) {
 		// perform $ notation replacement on string if any exist
 		value = wskenv.InterpolateStringWithEnvVar(param.Value)
 	}
@@ -363,12 +419,94 @@ func ResolveParameter(paramName string, param *Parameter, filePath string) (inte
 		//return param.Value, utils.NewParserErr(filePath, nil, msgs)
 	}
 
+	if errorParser == nil {
+		errorParser = ValidateParameterValue(filePath, paramName, param.Type, value)
+	}
+
 	// Trace Parameter struct after resolution
 	//dumpParameter(paramName, param, "AFTER")
 	//fmt.Printf("EXIT: Parameter [%s] type=[%v] value=[%v]\n", paramName, param.Type, value)
 	return value, errorParser
 }
 
+/*
+   ValidateParameterValue assures that a resolved value is valid for the timestamp, null, version,
+   string length class and scalar-unit types.
+
+   Empty values are valid, as the value may be provided later by a Deployment file. The values
+   bound by Deployment files and --param are validated again once the deployment plan is complete.
+
+   Inputs:
+   - filePath: the path, including name, of the YAML file which contained the parameter for error reporting
+   - paramName: name of the parameter for error reporting
+   - paramType: the resolved type of the parameter
+   - value: the resolved value of the parameter
+
+   Returns:
+   - (error) a ParameterTypeMismatchError if the value is malformed or out of range
+*/
+func ValidateParameterValue(filePath string, paramName string, paramType string, value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	if paramType == NULL {
+		return wskderrors.NewParameterTypeMismatchError(filePath, paramName, NULL, reflect.TypeOf(value).Kind().String())
+	}
+	if paramType == STRING || !isStringType(paramType) {
+		return nil
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return wskderrors.NewParameterTypeMismatchError(filePath, paramName, paramType, reflect.TypeOf(value).Kind().String())
+	}
+	if len(str) == 0 {
+		return nil
+	}
+
+	valid := true
+	actual := STRING + " " + strconv.Quote(str)
+	switch paramType {
+	case TIMESTAMP:
+		_, err := time.Parse(time.RFC3339, str)
+		valid = err == nil
+	case VERSION:
+		_, err := semver.ParseVersion(str)
+		valid = err == nil
+	case SCALAR_UNIT, SCALAR_UNIT_SIZE, SCALAR_UNIT_TIME:
+		valid = isScalarUnit(paramType, str)
+	default:
+		if length := utf8.RuneCountInString(str); length > stringTypeLengthMap[paramType] {
+			valid = false
+			actual = fmt.Sprintf("%s(%d)", STRING, length)
+		}
+	}
+	if !valid {
+		return wskderrors.NewParameterTypeMismatchError(filePath, paramName, paramType, actual)
+	}
+	return nil
+}
+
+// isScalarUnit tells if the value is a scalar followed by a unit recognized by the scalar-unit type,
+// scalar-unit itself accepts the units of all its subtypes
+func isScalarUnit(paramType string, value string) bool {
+	match := scalarUnit.FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil {
+		return false
+	}
+	for subtype, units := range scalarUnitMap {
+		if paramType != SCALAR_UNIT && paramType != subtype {
+			continue
+		}
+		for _, unit := range units {
+			if unit == match[2] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Provide custom Parameter marshalling and unmarshalling
 type ParsedParameter Parameter
 
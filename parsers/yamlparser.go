@@ -31,6 +31,7 @@ const (
 	YAML_KEY_ACTION     = "action"
 	YAML_KEY_ANNOTATION = "annotation"
 	YAML_KEY_API        = "api"
+	YAML_KEY_DEPENDENCY = "dependency"
 	YAML_KEY_FEED       = "feed"
 	YAML_KEY_MANIFEST   = "manifest"
 	YAML_KEY_NAMESPACE  = "namespace"
@@ -42,11 +43,17 @@ const (
 	YAML_KEY_TRIGGER    = "trigger"
 	YAML_KEY_SOURCE     = "source"
 	YAML_KEY_BLACKBOX   = "blackbox"
+	YAML_KEY_LOCATION   = "location"
+	YAML_KEY_CREDENTIAL = "credential"
+	YAML_KEY_STATUS     = "status"
 )
 
 // YAML schema key values
 const (
 	YAML_VALUE_BRANCH_MASTER = "master"
+	YAML_VALUE_GIT_HEAD      = "HEAD"
+	YAML_VALUE_ACTIVE        = "active"
+	YAML_VALUE_INACTIVE      = "inactive"
 )
 
 // default values
@@ -55,6 +62,26 @@ const (
 	DEFAULT_PACKAGE_VERSION = "0.0.1"
 )
 
+// Lifecycle operations of feeds, the lifecycle event passed to a feed action
+// is the upper case operation, e.g. CREATE
+const (
+	FEED_OPERATION_CREATE  = "create"
+	FEED_OPERATION_READ    = "read"
+	FEED_OPERATION_UPDATE  = "update"
+	FEED_OPERATION_DELETE  = "delete"
+	FEED_OPERATION_PAUSE   = "pause"
+	FEED_OPERATION_UNPAUSE = "unpause"
+)
+
+var FEED_OPERATIONS = [](string){
+	FEED_OPERATION_CREATE,
+	FEED_OPERATION_READ,
+	FEED_OPERATION_UPDATE,
+	FEED_OPERATION_DELETE,
+	FEED_OPERATION_PAUSE,
+	FEED_OPERATION_UNPAUSE,
+}
+
 // Known Limit values
 const (
 	// supported
@@ -159,6 +186,7 @@ type Trigger struct {
 	Namespace   string               `yaml:"namespace"`
 	Credential  string               `yaml:"credential"`
 	Inputs      map[string]Parameter `yaml:"inputs"`
+	Status      string               `yaml:"status"` // active or inactive, pauses the feed of the trigger
 	Name        string
 	Description string                 `yaml:"description,omitempty"`
 	Annotations map[string]interface{} `yaml:"annotations,omitempty"`
@@ -168,13 +196,15 @@ type Trigger struct {
 	Source string `yaml:"source"`
 }
 
+// Feed is a feed of a package, implemented by actions of the package
 type Feed struct {
 	Namespace  string            `yaml:"namespace"`
-	Credential string            `yaml:"credential"`
-	Inputs     map[string]string `yaml:"inputs"`
-	Location   string            `yaml:"location"`
-	Action     string            `yaml:"action"`
-	// TODO(): need to define operation structure
+	Credential string            `yaml:"credential"` // passed to the feed actions as the credential input
+	Inputs     map[string]string `yaml:"inputs"`     // passed to the feed actions on every lifecycle event
+	Location   string            `yaml:"location"`   // passed to the feed actions as the location input
+	Action     string            `yaml:"action"`     // action of the package implementing the feed, the feed name by default
+	// lifecycle operations supported by the feed, e.g. create, each handled by the
+	// feed action or by the action of the package it names; all of them if empty
 	Operations map[string]interface{} `yaml:"operations"`
 	Name       string
 }
@@ -185,6 +215,8 @@ type Rule struct {
 	//mapping to wsk.Rule.Action
 	Action string `yaml:"action"` //used in manifest.yaml
 	Rule   string `yaml:"rule"`   //used in manifest.yaml
+	//mapping to wsk.Rule.Status
+	Status string `yaml:"status"` //used in manifest.yaml and deployment.yaml
 	//mapping to wsk.Rule.Name
 	Name        string
 	Description string                 `yaml:"description,omitempty"`
@@ -222,6 +254,7 @@ type Package struct {
 	Description      string                                                        `yaml:"description,omitempty"`
 	Annotations      map[string]interface{}                                        `yaml:"annotations,omitempty"`
 	Apis             map[string]map[string]map[string]map[string]APIMethodResponse `yaml:"apis"`
+	Filepath         string                                                        `yaml:"-"` // file path of the manifest file declaring the package
 }
 
 type Project struct {
@@ -237,14 +270,18 @@ type Project struct {
 }
 
 type YAML struct {
+	Imports  []string           `yaml:"imports,omitempty"`
 	Project  Project            `yaml:"project"`
 	Packages map[string]Package `yaml:"packages"`
-	Filepath string             //file path of the yaml file
+	Filepath string             `yaml:"-"` //file path of the yaml file
 }
 
 type DisplayInputs struct {
-	Name   string
-	Inputs map[string]interface{}
+	Name              string
+	Inputs            map[string]interface{}
+	Sources           map[string]string      `json:",omitempty"` // file or command line of each input
+	Annotations       map[string]interface{} `json:",omitempty"` // annotations read from a deployment file
+	AnnotationSources map[string]string      `json:",omitempty"` // deployment file of each annotation
 }
 
 type PackageInputs struct {
@@ -487,6 +524,11 @@ func (yaml *YAML) ComposeParsersRule(wskrule whisk.Rule) *Rule {
 	rule.Action = pa + "/" + wskrule.Action.(map[string]interface{})["name"].(string)
 	rule.Trigger = wskrule.Trigger.(map[string]interface{})["name"].(string)
 
+	// rules are active unless stated otherwise
+	if wskrule.Status == YAML_VALUE_INACTIVE {
+		rule.Status = wskrule.Status
+	}
+
 	rule.Annotations = filterAnnotations(wskrule.Annotations)
 	return rule
 }
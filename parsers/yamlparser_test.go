@@ -20,8 +20,16 @@
 package parsers
 
 import (
-	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
+
+	"github.com/apache/openwhisk-wskdeploy/wskschema"
+	"github.com/stretchr/testify/assert"
 )
 
 var manifestfile_val_pkg = "../tests/dat/manifest_validate_package_grammar.yaml"
@@ -137,3 +145,79 @@ func TestGetApisList(t *testing.T) {
 	apis := pkg.GetApis()
 	assert.Equal(t, 5, len(apis), "Get api list failed.")
 }
+
+// yamlKeys returns the keys accepted by the YAML parser for the fields of a struct
+func yamlKeys(t reflect.Type) []string {
+	var keys []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		key := strings.Split(field.Tag.Get("yaml"), ",")[0]
+		if field.PkgPath != "" || key == "-" {
+			continue
+		}
+		if len(key) == 0 {
+			key = strings.ToLower(field.Name)
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func schemaKeys(schema *wskschema.Schema) []string {
+	var keys []string
+	for key := range schema.Properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// The manifest schema must accept exactly the keys of the manifest grammar
+func TestManifestSchemaMatchesGrammar(t *testing.T) {
+	schema, err := wskschema.LoadSchema(wskschema.MANIFEST_SCHEMA)
+	assert.Nil(t, err)
+
+	assert.Equal(t, yamlKeys(reflect.TypeOf(YAML{})), schemaKeys(schema))
+	types := map[string]interface{}{
+		"project":           Project{},
+		"package":           Package{},
+		"repository":        Repository{},
+		"dependency":        Dependency{},
+		"limits":            Limits{},
+		"action":            Action{},
+		"sequence":          Sequence{},
+		"trigger":           Trigger{},
+		"feed":              Feed{},
+		"rule":              Rule{},
+		"apiMethodResponse": APIMethodResponse{},
+	}
+	for name, value := range types {
+		definition := schema.Definitions[name]
+		if assert.NotNil(t, definition, name) {
+			assert.Equal(t, yamlKeys(reflect.TypeOf(value)), schemaKeys(definition), name)
+		}
+	}
+}
+
+// Manifests written by Write, e.g. by export, must pass the schema validation
+func TestWriteManifestMatchesSchema(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wskdeploy-manifest")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "manifest.yaml")
+	manifest := YAML{
+		Project:  Project{Name: "export"},
+		Packages: map[string]Package{"hello": {}},
+		Filepath: path,
+	}
+	assert.Nil(t, Write(&manifest, path))
+
+	content, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(content), "filepath", "The file path of the manifest should not be written to the manifest.")
+
+	_, err = NewYAMLParser().ParseManifest(path)
+	assert.Nil(t, err, "A written manifest should pass the schema validation.")
+}
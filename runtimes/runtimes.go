@@ -136,14 +136,7 @@ func ParseOpenWhisk(apiHost string) (op OpenWhiskInfo, err error) {
 
 	// Local openwhisk deployment sometimes only returns "application/json" as the content type
 	if err != nil || !strings.Contains(HTTP_CONTENT_TYPE_VALUE, res.Header.Get(HTTP_CONTENT_TYPE_KEY)) {
-		stdout := wski18n.T(wski18n.ID_MSG_UNMARSHAL_LOCAL)
-		wskprint.PrintOpenWhiskVerbose(utils.Flags.Verbose, stdout)
-		err = json.Unmarshal(RUNTIME_DETAILS, &op)
-		if err != nil {
-			errMessage := wski18n.T(wski18n.ID_ERR_RUNTIME_PARSER_ERROR,
-				map[string]interface{}{wski18n.KEY_ERR: err.Error()})
-			err = wskderrors.NewRuntimeParserError(errMessage)
-		}
+		op, err = ParseLocalOpenWhisk()
 	} else {
 		b, _ := ioutil.ReadAll(res.Body)
 		if b != nil && len(b) > 0 {
@@ -161,6 +154,20 @@ func ParseOpenWhisk(apiHost string) (op OpenWhiskInfo, err error) {
 	return
 }
 
+// ParseLocalOpenWhisk returns the runtimes known to wskdeploy itself, used when the
+// runtimes supported by the OpenWhisk server can not be retrieved
+func ParseLocalOpenWhisk() (op OpenWhiskInfo, err error) {
+	stdout := wski18n.T(wski18n.ID_MSG_UNMARSHAL_LOCAL)
+	wskprint.PrintOpenWhiskVerbose(utils.Flags.Verbose, stdout)
+	err = json.Unmarshal(RUNTIME_DETAILS, &op)
+	if err != nil {
+		errMessage := wski18n.T(wski18n.ID_ERR_RUNTIME_PARSER_ERROR,
+			map[string]interface{}{wski18n.KEY_ERR: err.Error()})
+		err = wskderrors.NewRuntimeParserError(errMessage)
+	}
+	return
+}
+
 func ConvertToMap(op OpenWhiskInfo) (rt map[string][]string) {
 	rt = make(map[string][]string)
 	for k, v := range op.Runtimes {
@@ -0,0 +1,371 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package semver parses semantic versions and the ranges of versions dependencies are
+// requested at
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+ * Semantic versions, see https://semver.org, and the ranges of versions dependencies
+ * are requested at, following the npm syntax:
+ *
+ *   1.2.3, =1.2.3    exactly 1.2.3
+ *   >1.2 <=2.0.0     comparators, separated by spaces, must all be satisfied
+ *   ^1.4.0           compatible with 1.4.0: >=1.4.0 <2.0.0, or <0.5.0 for 0.4.0
+ *   ~2.1             patches of 2.1: >=2.1.0 <2.2.0
+ *   1.x, 1.2.*, *    any value of the missing or wildcard components
+ *   ^1.0 || ^2.0     alternatives, one of them must be satisfied
+ *
+ * Versions may start with v, e.g. the tag v1.4.2, and may omit their minor and patch
+ * numbers, e.g. 1.0 is 1.0.0.
+ */
+
+const RANGE_OR = "||"
+
+var (
+	semVersion    = regexp.MustCompile(`^[vV]?(\d+)(?:\.(\d+)(?:\.(\d+))?)?(?:-([0-9A-Za-z.-]+))?(?:\+([0-9A-Za-z.-]+))?$`)
+	partialRange  = regexp.MustCompile(`^[vV]?(\d+|[xX*])(?:\.(\d+|[xX*])(?:\.(\d+|[xX*]))?)?(?:-([0-9A-Za-z.-]+))?(?:\+[0-9A-Za-z.-]+)?$`)
+	rangeOperator = regexp.MustCompile(`^(\^|~|>=|<=|>|<|=)?\s*(.*)$`)
+)
+
+// Version is a parsed semantic version
+type Version struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	Prerelease string
+}
+
+// ParseVersion parses a version such as 1.4.2, v1.4.2-rc.1 or 1.0
+func ParseVersion(version string) (Version, error) {
+	match := semVersion.FindStringSubmatch(strings.TrimSpace(version))
+	if match == nil {
+		return Version{}, fmt.Errorf("[%s] is not a semantic version", version)
+	}
+	var v Version
+	var err error
+	for i, n := range []*uint64{&v.Major, &v.Minor, &v.Patch} {
+		if len(match[i+1]) != 0 {
+			if *n, err = strconv.ParseUint(match[i+1], 10, 64); err != nil {
+				return Version{}, err
+			}
+		}
+	}
+	v.Prerelease = match[4]
+	return v, nil
+}
+
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if len(v.Prerelease) != 0 {
+		s += "-" + v.Prerelease
+	}
+	return s
+}
+
+// Compare returns -1, 0 or 1 when v precedes, equals or follows other
+func (v Version) Compare(other Version) int {
+	for _, pair := range [][2]uint64{{v.Major, other.Major}, {v.Minor, other.Minor}, {v.Patch, other.Patch}} {
+		if pair[0] != pair[1] {
+			if pair[0] < pair[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return comparePrerelease(v.Prerelease, other.Prerelease)
+}
+
+// comparePrerelease compares the dot separated identifiers of two pre-releases, a
+// version without pre-release follows its pre-releases
+func comparePrerelease(a string, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case len(a) == 0:
+		return 1
+	case len(b) == 0:
+		return -1
+	}
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if as[i] == bs[i] {
+			continue
+		}
+		an, aErr := strconv.ParseUint(as[i], 10, 64)
+		bn, bErr := strconv.ParseUint(bs[i], 10, 64)
+		switch {
+		case aErr == nil && bErr == nil:
+			if an < bn {
+				return -1
+			}
+			return 1
+		case aErr == nil:
+			// numeric identifiers precede alphanumeric ones
+			return -1
+		case bErr == nil:
+			return 1
+		case as[i] < bs[i]:
+			return -1
+		default:
+			return 1
+		}
+	}
+	switch {
+	case len(as) < len(bs):
+		return -1
+	case len(as) > len(bs):
+		return 1
+	}
+	return 0
+}
+
+// comparator is a single constraint of a range, e.g. >=1.4.0
+type comparator struct {
+	operator string
+	version  Version
+}
+
+func (c comparator) matches(v Version) bool {
+	cmp := v.Compare(c.version)
+	switch c.operator {
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	}
+	return cmp == 0
+}
+
+// Range is a set of alternatives, each of them a set of comparators
+type Range [][]comparator
+
+// IsRange tells whether the version of a dependency is a range rather than a
+// git reference: it holds an operator, a wildcard or alternatives. 1.4.0 and v1.4.0 are
+// references, to a tag of this name.
+func IsRange(version string) bool {
+	version = strings.TrimSpace(version)
+	if strings.ContainsAny(version, "^~<>=| ") {
+		return true
+	}
+	match := partialRange.FindStringSubmatch(version)
+	return match != nil && strings.ContainsAny(match[1]+match[2]+match[3], "xX*")
+}
+
+// ParseRange parses a range of versions, e.g. ^1.4.0 or >=1.0 <2.0 || ^3
+func ParseRange(versionRange string) (Range, error) {
+	var r Range
+	for _, alternative := range strings.Split(versionRange, RANGE_OR) {
+		set := []comparator{}
+		fields := strings.Fields(alternative)
+		for i := 0; i < len(fields); i++ {
+			field := fields[i]
+			// an operator separated from its version by a space, e.g. >= 1.0
+			if strings.Trim(field, "^~<>=") == "" && i+1 < len(fields) {
+				i++
+				field += fields[i]
+			}
+			comparators, err := parseComparators(field)
+			if err != nil {
+				return nil, fmt.Errorf("[%s] is not a range of versions: %s", versionRange, err.Error())
+			}
+			set = append(set, comparators...)
+		}
+		r = append(r, set)
+	}
+	return r, nil
+}
+
+// Intersect returns a range satisfied by the versions which satisfy both ranges, e.g.
+// ^1.0.0 ~1.2.0 for ^1.0.0 and ~1.2.0, every alternative of one combined with every
+// alternative of the other
+func Intersect(a string, b string) string {
+	alternatives := []string{}
+	for _, x := range strings.Split(a, RANGE_OR) {
+		for _, y := range strings.Split(b, RANGE_OR) {
+			alternatives = append(alternatives, strings.TrimSpace(x)+" "+strings.TrimSpace(y))
+		}
+	}
+	return strings.Join(alternatives, " "+RANGE_OR+" ")
+}
+
+// parseComparators translates a single term of a range, e.g. ^1.4 or 2.x, to comparators
+func parseComparators(term string) ([]comparator, error) {
+	match := rangeOperator.FindStringSubmatch(term)
+	operator, version := match[1], match[2]
+	parts := partialRange.FindStringSubmatch(version)
+	if parts == nil {
+		return nil, fmt.Errorf("[%s] is not a version", version)
+	}
+
+	// the number of components given before the first wildcard or missing one
+	numbers := []uint64{}
+	for _, part := range parts[1:4] {
+		if len(part) == 0 || strings.ContainsAny(part, "xX*") {
+			break
+		}
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		numbers = append(numbers, n)
+	}
+	low := Version{Prerelease: parts[4]}
+	components := []*uint64{&low.Major, &low.Minor, &low.Patch}
+	for i, n := range numbers {
+		*components[i] = n
+	}
+	// the lowest version above the range, none for *
+	upper := func(component int) []comparator {
+		if component < 0 {
+			return []comparator{{">=", Version{}}}
+		}
+		high := Version{Major: low.Major, Minor: low.Minor}
+		switch component {
+		case 0:
+			high = Version{Major: low.Major + 1}
+		case 1:
+			high.Minor++
+		case 2:
+			high.Patch = low.Patch + 1
+		}
+		return []comparator{{">=", low}, {"<", high}}
+	}
+
+	switch operator {
+	case "^":
+		// the left-most non-zero component given can not change
+		switch {
+		case len(numbers) == 0:
+			return upper(-1), nil
+		case low.Major != 0 || len(numbers) == 1:
+			return upper(0), nil
+		case low.Minor != 0 || len(numbers) == 2:
+			return upper(1), nil
+		default:
+			return upper(2), nil
+		}
+	case "~":
+		// patches of the minor version, or minor versions when only the major is given
+		switch len(numbers) {
+		case 0:
+			return upper(-1), nil
+		case 1:
+			return upper(0), nil
+		default:
+			return upper(1), nil
+		}
+	case "", "=":
+		if len(numbers) == 3 {
+			return []comparator{{"=", low}}, nil
+		}
+		return upper(len(numbers) - 1), nil
+	case ">", "<=":
+		if len(numbers) < 3 {
+			// above or up to every version matching the partial version
+			bound := upper(len(numbers) - 1)
+			if len(numbers) == 0 {
+				if operator == ">" {
+					return nil, errors.New("no version is greater than *")
+				}
+				return bound, nil
+			}
+			if operator == ">" {
+				return []comparator{{">=", bound[1].version}}, nil
+			}
+			return []comparator{{"<", bound[1].version}}, nil
+		}
+	}
+	if len(numbers) == 0 {
+		if operator == "<" {
+			return nil, errors.New("no version is less than *")
+		}
+		return upper(-1), nil
+	}
+	return []comparator{{operator, low}}, nil
+}
+
+// Matches tells whether the version satisfies the range. A pre-release only satisfies
+// comparators naming a pre-release of the same major, minor and patch numbers.
+func (r Range) Matches(v Version) bool {
+	for _, set := range r {
+		matches := true
+		prerelease := len(v.Prerelease) == 0
+		for _, c := range set {
+			if !c.matches(v) {
+				matches = false
+				break
+			}
+			if len(c.version.Prerelease) != 0 && c.version.Major == v.Major && c.version.Minor == v.Minor && c.version.Patch == v.Patch {
+				prerelease = true
+			}
+		}
+		if matches && prerelease {
+			return true
+		}
+	}
+	return false
+}
+
+// MaxSatisfying returns the tag holding the highest version in the range, tags which
+// are not versions are ignored
+func (r Range) MaxSatisfying(tags []string) (string, bool) {
+	type candidate struct {
+		tag     string
+		version Version
+	}
+	candidates := []candidate{}
+	for _, tag := range tags {
+		if v, err := ParseVersion(tag); err == nil && r.Matches(v) {
+			candidates = append(candidates, candidate{tag, v})
+		}
+	}
+	if len(candidates) == 0 {
+		return "", false
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].version.Compare(candidates[j].version) < 0
+	})
+	return candidates[len(candidates)-1].tag, true
+}
+
+// Satisfies tells whether the tag is a version within the range, false for a version
+// which is not a range
+func Satisfies(tag string, versionRange string) bool {
+	if len(tag) == 0 || !IsRange(versionRange) {
+		return false
+	}
+	v, err := ParseVersion(tag)
+	if err != nil {
+		return false
+	}
+	r, err := ParseRange(versionRange)
+	return err == nil && r.Matches(v)
+}
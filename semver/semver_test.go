@@ -0,0 +1,155 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVersion(t *testing.T) {
+	for version, expected := range map[string]string{
+		"1.4.2":        "1.4.2",
+		"v1.4.2":       "1.4.2",
+		"1.0":          "1.0.0",
+		"2":            "2.0.0",
+		"1.0.0-rc.1":   "1.0.0-rc.1",
+		"1.0.0+build5": "1.0.0",
+	} {
+		v, err := ParseVersion(version)
+		assert.NoError(t, err, version)
+		assert.Equal(t, expected, v.String(), version)
+	}
+	for _, version := range []string{"", "master", "1.0.0.0", "1.x", "^1.0", "1.0-"} {
+		_, err := ParseVersion(version)
+		assert.Error(t, err, version)
+	}
+}
+
+func TestVersion_Compare(t *testing.T) {
+	// in increasing order, see https://semver.org/#spec-item-11
+	ordered := []string{"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+		"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.2.0", "1.10.0", "2.0.0"}
+	for i := range ordered {
+		for j := range ordered {
+			a, _ := ParseVersion(ordered[i])
+			b, _ := ParseVersion(ordered[j])
+			expected := 0
+			if i < j {
+				expected = -1
+			} else if i > j {
+				expected = 1
+			}
+			assert.Equal(t, expected, a.Compare(b), ordered[i]+" "+ordered[j])
+		}
+	}
+}
+
+func TestIsRange(t *testing.T) {
+	for _, version := range []string{"^1.4.0", "~2.1", ">=1.0 <2.0", "1.x", "1.2.*", "*", "x", "=1.0.0", "^1 || ^2"} {
+		assert.True(t, IsRange(version), version)
+	}
+	for _, version := range []string{"", "master", "1.0", "v1.4.0", "1.4.0", "feature/x",
+		"0123456789abcdef0123456789abcdef01234567"} {
+		assert.False(t, IsRange(version), version)
+	}
+}
+
+func TestRange_Matches(t *testing.T) {
+	for versionRange, cases := range map[string]map[string]bool{
+		"^1.4.0":        {"1.4.0": true, "1.9.3": true, "1.3.9": false, "2.0.0": false, "1.5.0-rc.1": false},
+		"^0.4":          {"0.4.0": true, "0.4.9": true, "0.5.0": false},
+		"^0.0.3":        {"0.0.3": true, "0.0.4": false},
+		"~2.1":          {"2.1.0": true, "2.1.7": true, "2.2.0": false, "2.0.9": false},
+		"~1":            {"1.0.0": true, "1.9.0": true, "2.0.0": false},
+		"1.x":           {"1.0.0": true, "1.99.0": true, "2.0.0": false},
+		"1.2.*":         {"1.2.0": true, "1.3.0": false},
+		"*":             {"0.0.1": true, "10.0.0": true, "1.0.0-rc.1": false},
+		">=1.0 <2.0":    {"1.0.0": true, "1.9.9": true, "2.0.0": false, "0.9.0": false},
+		">= 1.0":        {"1.0.0": true, "0.1.0": false},
+		">1.2":          {"1.2.9": false, "1.3.0": true},
+		"<=1.2":         {"1.2.9": true, "1.3.0": false},
+		"=1.2.3":        {"1.2.3": true, "1.2.4": false},
+		"^1.0 || ^3.0":  {"1.2.0": true, "2.0.0": false, "3.1.0": true},
+		"^1.0.0-beta.2": {"1.0.0-beta.3": true, "1.0.0-beta.1": false, "1.0.0": true, "1.1.0-rc.1": false},
+	} {
+		r, err := ParseRange(versionRange)
+		assert.NoError(t, err, versionRange)
+		for version, expected := range cases {
+			v, err := ParseVersion(version)
+			assert.NoError(t, err, version)
+			assert.Equal(t, expected, r.Matches(v), versionRange+" "+version)
+		}
+	}
+	for _, versionRange := range []string{"^master", ">*", "<*", "~1.2.3.4", "^1.0 || foo"} {
+		_, err := ParseRange(versionRange)
+		assert.Error(t, err, versionRange)
+	}
+}
+
+func TestRange_MaxSatisfying(t *testing.T) {
+	tags := []string{"v1.3.0", "v1.4.0", "v1.4.2", "v1.10.0", "v2.0.0", "v2.1.0-rc.1", "latest", "1.4.1"}
+	for versionRange, expected := range map[string]string{
+		"^1.4.0": "v1.10.0",
+		"~1.4":   "v1.4.2",
+		"<1.4":   "v1.3.0",
+		"^2":     "v2.0.0",
+	} {
+		r, err := ParseRange(versionRange)
+		assert.NoError(t, err)
+		tag, ok := r.MaxSatisfying(tags)
+		assert.True(t, ok, versionRange)
+		assert.Equal(t, expected, tag, versionRange)
+	}
+	r, _ := ParseRange("^3")
+	_, ok := r.MaxSatisfying(tags)
+	assert.False(t, ok)
+}
+
+func TestIntersect(t *testing.T) {
+	tags := []string{"v1.2.0", "v1.2.3", "v1.3.0", "v2.1.0", "v3.2.0"}
+	for ranges, expected := range map[[2]string]string{
+		{"^1.0.0", "~1.2.0"}:       "v1.2.3",
+		{"^1.0.0", "^1.3.0"}:       "v1.3.0",
+		{"^1 || ^3", ">=1.3.0"}:    "v3.2.0",
+		{"^1 || ^3", "<2 || ~2.1"}: "v1.3.0",
+	} {
+		intersection := Intersect(ranges[0], ranges[1])
+		r, err := ParseRange(intersection)
+		assert.NoError(t, err, intersection)
+		tag, ok := r.MaxSatisfying(tags)
+		assert.True(t, ok, intersection)
+		assert.Equal(t, expected, tag, intersection)
+	}
+	assert.Equal(t, "^1 ~1.2.0 || ^3 ~1.2.0", Intersect("^1 || ^3", "~1.2.0"))
+	r, _ := ParseRange(Intersect("~1.2.0", "^1.3.0"))
+	_, ok := r.MaxSatisfying(tags)
+	assert.False(t, ok, "disjoint ranges have no version in common")
+}
+
+func TestSatisfies(t *testing.T) {
+	assert.True(t, Satisfies("v1.2.3", "^1.0.0"))
+	assert.True(t, Satisfies("v1.2.3", "^1.0.0 ~1.2.0"))
+	assert.False(t, Satisfies("v1.3.0", "~1.2.0"))
+	assert.False(t, Satisfies("", "^1.0.0"), "a dependency without tag")
+	assert.False(t, Satisfies("v1.2.3", "v1.2.3"), "a git reference is not a range")
+	assert.False(t, Satisfies("latest", "*"))
+}
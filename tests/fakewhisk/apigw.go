@@ -0,0 +1,235 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fakewhisk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+)
+
+// the web actions of the whisk.system/apimgmt package backing whisk.Client.Apis
+const (
+	APIMGMT_CREATE = "createApi.http"
+	APIMGMT_GET    = "getApi.http"
+	APIMGMT_DELETE = "deleteApi.http"
+
+	PARAM_SPACEGUID = "spaceguid"
+	PARAM_BASEPATH  = "basepath"
+	PARAM_RELPATH   = "relpath"
+	PARAM_OPERATION = "operation"
+
+	SWAGGER_VERSION = "2.0"
+	MSG_API_MISSING = "API '%s' does not exist"
+)
+
+// apiDoc is an API of a tenant, i.e. the operations sharing a base path
+type apiDoc struct {
+	tenant string
+	api    whisk.RetApi
+}
+
+func (s *Server) serveApi(w http.ResponseWriter, r *http.Request, action string) {
+	tenant := r.URL.Query().Get(PARAM_SPACEGUID)
+	if len(tenant) == 0 {
+		tenant = s.subject()
+	}
+
+	switch {
+	case action == APIMGMT_CREATE && r.Method == http.MethodPost:
+		s.createApi(w, r, tenant)
+	case action == APIMGMT_GET && r.Method == http.MethodGet:
+		s.getApis(w, r, tenant)
+	case action == APIMGMT_DELETE && r.Method == http.MethodDelete:
+		s.deleteApi(w, r, tenant)
+	default:
+		writeError(w, http.StatusNotFound, MSG_NOT_FOUND)
+	}
+}
+
+// createApi adds an operation to an API, or replaces the whole API when the request
+// carries a swagger document
+func (s *Server) createApi(w http.ResponseWriter, r *http.Request, tenant string) {
+	request := new(whisk.ApiCreateRequest)
+	if err := readJSON(r, request); err != nil || request.ApiDoc == nil {
+		writeError(w, http.StatusBadRequest, MSG_BAD_REQUEST)
+		return
+	}
+
+	if len(request.ApiDoc.Swagger) > 0 {
+		swagger := new(whisk.ApiSwagger)
+		if err := json.Unmarshal([]byte(request.ApiDoc.Swagger), swagger); err != nil || len(swagger.BasePath) == 0 {
+			writeError(w, http.StatusBadRequest, MSG_BAD_REQUEST)
+			return
+		}
+		doc := s.apiDoc(tenant, request.ApiDoc.Namespace, swagger.BasePath)
+		doc.api.Swagger = swagger
+		writeJSON(w, http.StatusOK, doc.api)
+		return
+	}
+
+	api := request.ApiDoc
+	if len(api.GatewayBasePath) == 0 || len(api.GatewayRelPath) == 0 || api.Action == nil ||
+		!whisk.ApiVerbs[strings.ToUpper(api.GatewayMethod)] {
+		writeError(w, http.StatusBadRequest, MSG_BAD_REQUEST)
+		return
+	}
+	pkgName, actionName := splitActionName(api.Action.Name)
+	verb := strings.ToLower(api.GatewayMethod)
+	operation := &whisk.ApiSwaggerOperation{
+		OperationId: verb + api.GatewayRelPath,
+		Parameters:  api.PathParameters,
+		Responses:   map[string]interface{}{"default": map[string]interface{}{"description": "Default response"}},
+		XOpenWhisk: &whisk.ApiSwaggerOpXOpenWhisk{
+			ActionName: actionName,
+			Namespace:  s.resolveNamespace(api.Action.Namespace),
+			Package:    pkgName,
+			ApiUrl:     api.Action.BackendUrl,
+		},
+	}
+
+	doc := s.apiDoc(tenant, api.Namespace, api.GatewayBasePath)
+	if len(api.ApiName) > 0 {
+		doc.api.Swagger.Info.Title = api.ApiName
+	}
+	path, ok := doc.api.Swagger.Paths[api.GatewayRelPath]
+	if !ok {
+		path = new(whisk.ApiSwaggerPath)
+		doc.api.Swagger.Paths[api.GatewayRelPath] = path
+	}
+	*operationOf(path, verb) = operation
+	writeJSON(w, http.StatusOK, doc.api)
+}
+
+// getApis lists the APIs of the tenant, optionally those matching a base path or name
+func (s *Server) getApis(w http.ResponseWriter, r *http.Request, tenant string) {
+	basePath := r.URL.Query().Get(PARAM_BASEPATH)
+	result := whisk.ApiGetResponse{}
+	for _, key := range s.apiKeys(tenant) {
+		doc := s.apis[key]
+		if len(basePath) > 0 && basePath != doc.api.Swagger.BasePath && basePath != doc.api.Swagger.Info.Title {
+			continue
+		}
+		api := doc.api
+		result.Apis = append(result.Apis, whisk.ApiItem{ApiId: key, QueryKey: key, ApiValue: &api})
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// deleteApi deletes an operation, all operations of a relative path, or the whole
+// API when no relative path is given
+func (s *Server) deleteApi(w http.ResponseWriter, r *http.Request, tenant string) {
+	query := r.URL.Query()
+	basePath, relPath, verb := query.Get(PARAM_BASEPATH), query.Get(PARAM_RELPATH), strings.ToLower(query.Get(PARAM_OPERATION))
+
+	key, ok := "", false
+	for _, k := range s.apiKeys(tenant) {
+		if swagger := s.apis[k].api.Swagger; basePath == swagger.BasePath || basePath == swagger.Info.Title {
+			key, ok = k, true
+			break
+		}
+	}
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf(MSG_API_MISSING, basePath))
+		return
+	}
+
+	paths := s.apis[key].api.Swagger.Paths
+	if len(relPath) > 0 {
+		path, ok := paths[relPath]
+		if !ok || (len(verb) > 0 && *operationOf(path, verb) == nil) {
+			writeError(w, http.StatusNotFound, fmt.Sprintf(MSG_API_MISSING, basePath+relPath+" "+verb))
+			return
+		}
+		if len(verb) > 0 {
+			*operationOf(path, verb) = nil
+		}
+		if len(verb) == 0 || len(path.MakeOperationMap()) == 0 {
+			delete(paths, relPath)
+		}
+	}
+	if len(relPath) == 0 || len(paths) == 0 {
+		delete(s.apis, key)
+	}
+	writeJSON(w, http.StatusOK, whisk.ApiDeleteResponse{})
+}
+
+// apiDoc returns the API of the tenant with the given base path, creating it if needed
+func (s *Server) apiDoc(tenant string, ns string, basePath string) *apiDoc {
+	key := tenant + basePath
+	doc, ok := s.apis[key]
+	if !ok {
+		doc = &apiDoc{
+			tenant: tenant,
+			api: whisk.RetApi{
+				Namespace: s.resolveNamespace(ns),
+				BaseUrl:   s.URL() + "/api/" + tenant + basePath,
+				Activated: true,
+				TenantId:  tenant,
+				Swagger: &whisk.ApiSwagger{
+					SwaggerName: SWAGGER_VERSION,
+					BasePath:    basePath,
+					Info:        &whisk.ApiSwaggerInfo{Title: basePath, Version: "1.0.0"},
+					Paths:       make(map[string]*whisk.ApiSwaggerPath),
+				},
+			},
+		}
+		s.apis[key] = doc
+	}
+	if doc.api.Swagger.Info == nil {
+		doc.api.Swagger.Info = &whisk.ApiSwaggerInfo{Title: basePath}
+	}
+	if doc.api.Swagger.Paths == nil {
+		doc.api.Swagger.Paths = make(map[string]*whisk.ApiSwaggerPath)
+	}
+	return doc
+}
+
+func (s *Server) apiKeys(tenant string) []string {
+	var keys []string
+	for key, doc := range s.apis {
+		if doc.tenant == tenant {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// operationOf returns the field of the swagger path holding the operation of the verb
+func operationOf(path *whisk.ApiSwaggerPath, verb string) **whisk.ApiSwaggerOperation {
+	switch verb {
+	case "get":
+		return &path.Get
+	case "put":
+		return &path.Put
+	case "post":
+		return &path.Post
+	case "delete":
+		return &path.Delete
+	case "options":
+		return &path.Options
+	case "head":
+		return &path.Head
+	}
+	return &path.Patch
+}
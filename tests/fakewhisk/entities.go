@@ -0,0 +1,774 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fakewhisk
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+)
+
+const (
+	ANNOTATION_FEED    = "feed"
+	ANNOTATION_EXEC    = "exec"
+	ANNOTATION_BINDING = "binding"
+	KIND_SEQUENCE      = "sequence"
+	STATUS_ACTIVE      = "active"
+	STATUS_INACTIVE    = "inactive"
+	PARAM_OVERWRITE    = "overwrite"
+	PARAM_CODE         = "code"
+	PARAM_BLOCKING     = "blocking"
+	PARAM_RESULT       = "result"
+	PARAM_LIMIT        = "limit"
+	PARAM_SKIP         = "skip"
+	PATH_SEPARATOR     = "/"
+	SYSTEM_CODE        = "function main(params) { return params; }"
+	SYSTEM_KIND        = "nodejs:default"
+)
+
+// the whisk.system packages and their actions, feeds are annotated with feed: true
+var systemPackages = map[string]map[string]bool{
+	"alarms":    {"alarm": true, "once": true, "interval": true},
+	"cloudant":  {"changes": true, "read": false, "write": false},
+	"messaging": {"messageHubFeed": true, "messageHubProduce": false},
+	"utils":     {"echo": false, "cat": false, "sort": false, "split": false, "head": false},
+}
+
+// namespace holds the entities of a namespace, actions are keyed by their name
+// within the namespace, i.e. prefixed with their package name and a "/"
+type namespace struct {
+	packages map[string]*whisk.Package
+	actions  map[string]*whisk.Action
+	triggers map[string]*whisk.Trigger
+	rules    map[string]*whisk.Rule
+}
+
+func (s *Server) namespace(name string) *namespace {
+	ns, ok := s.namespaces[name]
+	if !ok {
+		ns = &namespace{
+			packages: make(map[string]*whisk.Package),
+			actions:  make(map[string]*whisk.Action),
+			triggers: make(map[string]*whisk.Trigger),
+			rules:    make(map[string]*whisk.Rule),
+		}
+		s.namespaces[name] = ns
+	}
+	return ns
+}
+
+func (s *Server) seedSystemPackages() {
+	ns := s.namespace(SYSTEM_NAMESPACE)
+	for pkgName, actions := range systemPackages {
+		publish := true
+		ns.packages[pkgName] = &whisk.Package{
+			Namespace: SYSTEM_NAMESPACE,
+			Name:      pkgName,
+			Version:   nextVersion(""),
+			Publish:   &publish,
+		}
+		for actionName, feed := range actions {
+			code := SYSTEM_CODE
+			action := &whisk.Action{
+				Namespace: SYSTEM_NAMESPACE + PATH_SEPARATOR + pkgName,
+				Name:      actionName,
+				Version:   nextVersion(""),
+				Exec:      &whisk.Exec{Kind: SYSTEM_KIND, Code: &code, Binary: new(bool)},
+				Publish:   &publish,
+			}
+			if feed {
+				action.Annotations = action.Annotations.AddOrReplace(&whisk.KeyValue{Key: ANNOTATION_FEED, Value: true})
+			}
+			ns.actions[pkgName+PATH_SEPARATOR+actionName] = action
+		}
+	}
+}
+
+func (s *Server) serveEntity(w http.ResponseWriter, r *http.Request, ns string, collection string, name string) {
+	switch collection {
+	case COLLECTION_PACKAGES:
+		s.servePackage(w, r, ns, name)
+	case COLLECTION_ACTIONS:
+		s.serveAction(w, r, ns, name)
+	case COLLECTION_TRIGGERS:
+		s.serveTrigger(w, r, ns, name)
+	case COLLECTION_RULES:
+		s.serveRule(w, r, ns, name)
+	default:
+		writeError(w, http.StatusNotFound, MSG_NOT_FOUND)
+	}
+}
+
+func (s *Server) servePackage(w http.ResponseWriter, r *http.Request, ns string, name string) {
+	entities := s.namespace(ns)
+	if name == "" {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, MSG_NOT_ALLOWED)
+			return
+		}
+		list := make([]whisk.Package, 0, len(entities.packages))
+		for _, key := range page(r, sortedKeys(entities.packages)) {
+			list = append(list, *entities.packages[key])
+		}
+		writeJSON(w, http.StatusOK, list)
+		return
+	}
+	if name == "refresh" && r.Method == http.MethodPost {
+		writeJSON(w, http.StatusOK, whisk.BindingUpdates{})
+		return
+	}
+
+	existing, exists := entities.packages[name]
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			writeError(w, http.StatusNotFound, MSG_NOT_FOUND)
+			return
+		}
+		writeJSON(w, http.StatusOK, s.packageWithContents(existing))
+	case http.MethodPut:
+		if strings.Contains(name, PATH_SEPARATOR) {
+			writeError(w, http.StatusBadRequest, MSG_BAD_REQUEST)
+			return
+		}
+		if exists && !overwrite(r) {
+			writeError(w, http.StatusConflict, MSG_CONFLICT)
+			return
+		}
+		pkg := new(whisk.Package)
+		if err := readJSON(r, pkg); err != nil {
+			writeError(w, http.StatusBadRequest, MSG_BAD_REQUEST)
+			return
+		}
+		if pkg.Binding != nil && len(pkg.Binding.Name) > 0 {
+			pkg.Binding.Namespace = s.resolveNamespace(pkg.Binding.Namespace)
+			if _, ok := s.namespace(pkg.Binding.Namespace).packages[pkg.Binding.Name]; !ok {
+				writeError(w, http.StatusBadRequest, MSG_BINDING_TARGET)
+				return
+			}
+			// like the controller, annotate bindings with the package they bind
+			pkg.Annotations = pkg.Annotations.AddOrReplace(&whisk.KeyValue{
+				Key:   ANNOTATION_BINDING,
+				Value: map[string]interface{}{"namespace": pkg.Binding.Namespace, "name": pkg.Binding.Name},
+			})
+		} else {
+			pkg.Binding = nil
+		}
+		pkg.Namespace = ns
+		pkg.Name = name
+		if exists {
+			pkg.Version = existing.Version
+		}
+		pkg.Version = nextVersion(pkg.Version)
+		pkg.Publish = publish(pkg.Publish)
+		pkg.Updated = now()
+		pkg.Actions = nil
+		pkg.Feeds = nil
+		entities.packages[name] = pkg
+		writeJSON(w, http.StatusOK, pkg)
+	case http.MethodDelete:
+		if !exists {
+			writeError(w, http.StatusNotFound, MSG_NOT_FOUND)
+			return
+		}
+		if count := len(actionsInPackage(entities, name)); count > 0 {
+			writeError(w, http.StatusConflict, fmt.Sprintf(MSG_NOT_EMPTY, count))
+			return
+		}
+		delete(entities.packages, name)
+		writeJSON(w, http.StatusOK, existing)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, MSG_NOT_ALLOWED)
+	}
+}
+
+// packageWithContents returns a copy of the package listing its actions and feeds,
+// those of the bound package in case of a binding
+func (s *Server) packageWithContents(pkg *whisk.Package) *whisk.Package {
+	result := *pkg
+	entities, pkgName := s.namespace(pkg.Namespace), pkg.Name
+	if pkg.Binding != nil {
+		entities, pkgName = s.namespace(pkg.Binding.Namespace), pkg.Binding.Name
+	}
+	for _, key := range actionsInPackage(entities, pkgName) {
+		action := entities.actions[key]
+		summary := whisk.Action{Name: action.Name, Version: action.Version, Annotations: action.Annotations}
+		if action.Annotations.GetValue(ANNOTATION_FEED) != nil {
+			result.Feeds = append(result.Feeds, summary)
+		} else {
+			result.Actions = append(result.Actions, summary)
+		}
+	}
+	return &result
+}
+
+func (s *Server) serveAction(w http.ResponseWriter, r *http.Request, ns string, name string) {
+	entities := s.namespace(ns)
+	if name == "" || strings.HasSuffix(name, PATH_SEPARATOR) {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, MSG_NOT_ALLOWED)
+			return
+		}
+		keys := sortedKeys(entities.actions)
+		if pkgName := strings.TrimSuffix(name, PATH_SEPARATOR); len(pkgName) > 0 {
+			keys = actionsInPackage(entities, pkgName)
+		}
+		list := make([]whisk.Action, 0, len(keys))
+		for _, key := range page(r, keys) {
+			list = append(list, *withoutCode(entities.actions[key]))
+		}
+		writeJSON(w, http.StatusOK, list)
+		return
+	}
+
+	pkgName, actionName := splitActionName(name)
+	existing, exists := entities.actions[name]
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			writeError(w, http.StatusNotFound, MSG_NOT_FOUND)
+			return
+		}
+		if r.URL.Query().Get(PARAM_CODE) == "false" {
+			existing = withoutCode(existing)
+		}
+		writeJSON(w, http.StatusOK, existing)
+	case http.MethodPut:
+		if len(pkgName) > 0 {
+			pkg, ok := entities.packages[pkgName]
+			if !ok {
+				writeError(w, http.StatusNotFound, MSG_NOT_FOUND)
+				return
+			}
+			if pkg.Binding != nil {
+				writeError(w, http.StatusBadRequest, MSG_NOT_ALLOWED)
+				return
+			}
+		}
+		if exists && !overwrite(r) {
+			writeError(w, http.StatusConflict, MSG_CONFLICT)
+			return
+		}
+		action := new(whisk.Action)
+		if err := readJSON(r, action); err != nil {
+			writeError(w, http.StatusBadRequest, MSG_BAD_REQUEST)
+			return
+		}
+		// like OpenWhisk, an update keeps what it leaves out
+		if exists {
+			if action.Exec == nil {
+				action.Exec = existing.Exec
+			}
+			if action.Parameters == nil {
+				action.Parameters = existing.Parameters
+			}
+			if action.Limits == nil {
+				action.Limits = existing.Limits
+			}
+		}
+		if action.Exec == nil {
+			writeError(w, http.StatusBadRequest, MSG_BAD_REQUEST)
+			return
+		}
+		if action.Exec.Kind == KIND_SEQUENCE {
+			for _, component := range action.Exec.Components {
+				componentNs, componentName := s.splitQualifiedName(component, ns)
+				if _, _, ok := s.lookupAction(componentNs, componentName); !ok {
+					writeError(w, http.StatusBadRequest, "Sequence component does not exist.")
+					return
+				}
+			}
+		}
+		if family := strings.TrimSuffix(action.Exec.Kind, ":default"); family != action.Exec.Kind {
+			if kind, ok := s.defaultKinds[family]; ok {
+				action.Exec.Kind = kind
+			}
+		}
+		if action.Exec.Binary == nil && action.Exec.Kind != KIND_SEQUENCE {
+			action.Exec.Binary = new(bool)
+		}
+		action.Namespace = ns
+		if len(pkgName) > 0 {
+			action.Namespace = ns + PATH_SEPARATOR + pkgName
+		}
+		action.Name = actionName
+		if exists {
+			action.Version = existing.Version
+		}
+		action.Version = nextVersion(action.Version)
+		action.Publish = publish(action.Publish)
+		action.Updated = now()
+		action.Annotations = action.Annotations.AddOrReplace(&whisk.KeyValue{Key: ANNOTATION_EXEC, Value: action.Exec.Kind})
+		entities.actions[name] = action
+		writeJSON(w, http.StatusOK, action)
+	case http.MethodDelete:
+		if !exists {
+			writeError(w, http.StatusNotFound, MSG_NOT_FOUND)
+			return
+		}
+		delete(entities.actions, name)
+		writeJSON(w, http.StatusOK, existing)
+	case http.MethodPost:
+		action, params, ok := s.lookupAction(ns, name)
+		if !ok {
+			writeError(w, http.StatusNotFound, MSG_NOT_FOUND)
+			return
+		}
+		payload := make(map[string]interface{})
+		if err := readJSON(r, &payload); err != nil {
+			writeError(w, http.StatusBadRequest, MSG_BAD_REQUEST)
+			return
+		}
+		for key, value := range payload {
+			params[key] = value
+		}
+		s.invoke(w, r, action, params)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, MSG_NOT_ALLOWED)
+	}
+}
+
+// lookupAction finds an action by its name within the namespace, resolving package
+// bindings, and returns it with the parameters of its package and its own
+func (s *Server) lookupAction(ns string, name string) (*whisk.Action, map[string]interface{}, bool) {
+	params := make(map[string]interface{})
+	entities := s.namespace(ns)
+	pkgName, actionName := splitActionName(name)
+	if len(pkgName) > 0 {
+		pkg, ok := entities.packages[pkgName]
+		if !ok {
+			return nil, nil, false
+		}
+		addParams(params, pkg.Parameters)
+		if pkg.Binding != nil {
+			entities = s.namespace(pkg.Binding.Namespace)
+			pkgName = pkg.Binding.Name
+			if bound, ok := entities.packages[pkgName]; ok {
+				addParams(params, bound.Parameters)
+			}
+			// the binding parameters take precedence over those of the bound package
+			addParams(params, pkg.Parameters)
+		}
+		name = pkgName + PATH_SEPARATOR + actionName
+	}
+	action, ok := entities.actions[name]
+	if !ok {
+		return nil, nil, false
+	}
+	for _, param := range action.Parameters {
+		if _, set := params[param.Key]; !set {
+			params[param.Key] = param.Value
+		}
+	}
+	return action, params, true
+}
+
+// invoke records the invocation and answers with a successful activation whose
+// result echoes the parameters, like the whisk.system actions do, unless it is the
+// lifecycle event of a feed
+func (s *Server) invoke(w http.ResponseWriter, r *http.Request, action *whisk.Action, params map[string]interface{}) {
+	s.invocations = append(s.invocations, Invocation{
+		Namespace: action.Namespace,
+		Name:      action.Name,
+		Params:    params,
+	})
+	result := params
+	if event, ok := params[PARAM_LIFECYCLE_EVENT].(string); ok {
+		if result, ok = s.feedLifecycle(w, event, params); !ok {
+			return
+		}
+	}
+	activationId := s.nextActivationId()
+	query := r.URL.Query()
+	if query.Get(PARAM_BLOCKING) != "true" {
+		writeJSON(w, http.StatusAccepted, map[string]interface{}{"activationId": activationId})
+		return
+	}
+	if query.Get(PARAM_RESULT) == "true" {
+		writeJSON(w, http.StatusOK, result)
+		return
+	}
+	var activationResult whisk.Result = result
+	writeJSON(w, http.StatusOK, whisk.Activation{
+		Namespace:    s.Namespace,
+		Name:         action.Name,
+		Version:      action.Version,
+		Subject:      s.subject(),
+		ActivationID: activationId,
+		Start:        now(),
+		End:          now(),
+		Response:     whisk.Response{Status: "success", Success: true, Result: &activationResult},
+		Logs:         []string{},
+	})
+}
+
+func (s *Server) serveTrigger(w http.ResponseWriter, r *http.Request, ns string, name string) {
+	entities := s.namespace(ns)
+	if name == "" {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, MSG_NOT_ALLOWED)
+			return
+		}
+		list := make([]whisk.Trigger, 0, len(entities.triggers))
+		for _, key := range page(r, sortedKeys(entities.triggers)) {
+			list = append(list, *entities.triggers[key])
+		}
+		writeJSON(w, http.StatusOK, list)
+		return
+	}
+
+	existing, exists := entities.triggers[name]
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			writeError(w, http.StatusNotFound, MSG_NOT_FOUND)
+			return
+		}
+		writeJSON(w, http.StatusOK, s.triggerWithRules(ns, existing))
+	case http.MethodPut:
+		if exists && !overwrite(r) {
+			writeError(w, http.StatusConflict, MSG_CONFLICT)
+			return
+		}
+		trigger := new(whisk.Trigger)
+		if err := readJSON(r, trigger); err != nil {
+			writeError(w, http.StatusBadRequest, MSG_BAD_REQUEST)
+			return
+		}
+		trigger.Namespace = ns
+		trigger.Name = name
+		if exists {
+			trigger.Version = existing.Version
+		}
+		trigger.Version = nextVersion(trigger.Version)
+		trigger.Publish = publish(trigger.Publish)
+		trigger.Updated = now()
+		trigger.Rules = nil
+		entities.triggers[name] = trigger
+		writeJSON(w, http.StatusOK, trigger)
+	case http.MethodDelete:
+		if !exists {
+			writeError(w, http.StatusNotFound, MSG_NOT_FOUND)
+			return
+		}
+		// rules outlive their trigger, but no longer fire until they are re-enabled
+		for _, rule := range s.rulesOf(ns, name) {
+			rule.Status = STATUS_INACTIVE
+		}
+		delete(entities.triggers, name)
+		writeJSON(w, http.StatusOK, existing)
+	case http.MethodPost:
+		if !exists {
+			writeError(w, http.StatusNotFound, MSG_NOT_FOUND)
+			return
+		}
+		payload := make(map[string]interface{})
+		if err := readJSON(r, &payload); err != nil {
+			writeError(w, http.StatusBadRequest, MSG_BAD_REQUEST)
+			return
+		}
+		for _, rule := range s.rulesOf(ns, name) {
+			if rule.Status != STATUS_ACTIVE {
+				continue
+			}
+			actionNs, actionName := ruleEntity(rule.Action)
+			if action, params, ok := s.lookupAction(actionNs, actionName); ok {
+				for key, value := range payload {
+					params[key] = value
+				}
+				s.invocations = append(s.invocations, Invocation{Namespace: action.Namespace, Name: action.Name, Params: params})
+			}
+		}
+		writeJSON(w, http.StatusAccepted, map[string]interface{}{"activationId": s.nextActivationId()})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, MSG_NOT_ALLOWED)
+	}
+}
+
+// triggerWithRules returns a copy of the trigger listing the rules it fires
+func (s *Server) triggerWithRules(ns string, trigger *whisk.Trigger) *whisk.Trigger {
+	result := *trigger
+	for _, rule := range s.rulesOf(ns, trigger.Name) {
+		if result.Rules == nil {
+			result.Rules = make(map[string]interface{})
+		}
+		result.Rules[rule.Namespace+PATH_SEPARATOR+rule.Name] = map[string]interface{}{
+			"action": rule.Action,
+			"status": rule.Status,
+		}
+	}
+	return &result
+}
+
+// rulesOf returns the rules of any namespace referencing the trigger
+func (s *Server) rulesOf(ns string, trigger string) []*whisk.Rule {
+	var rules []*whisk.Rule
+	for _, nsName := range sortedKeys(s.namespaces) {
+		entities := s.namespaces[nsName]
+		for _, key := range sortedKeys(entities.rules) {
+			rule := entities.rules[key]
+			if triggerNs, triggerName := ruleEntity(rule.Trigger); triggerNs == ns && triggerName == trigger {
+				rules = append(rules, rule)
+			}
+		}
+	}
+	return rules
+}
+
+func (s *Server) serveRule(w http.ResponseWriter, r *http.Request, ns string, name string) {
+	entities := s.namespace(ns)
+	if name == "" {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, MSG_NOT_ALLOWED)
+			return
+		}
+		list := make([]whisk.Rule, 0, len(entities.rules))
+		for _, key := range page(r, sortedKeys(entities.rules)) {
+			list = append(list, *entities.rules[key])
+		}
+		writeJSON(w, http.StatusOK, list)
+		return
+	}
+
+	existing, exists := entities.rules[name]
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			writeError(w, http.StatusNotFound, MSG_NOT_FOUND)
+			return
+		}
+		writeJSON(w, http.StatusOK, existing)
+	case http.MethodPut:
+		if exists && !overwrite(r) {
+			writeError(w, http.StatusConflict, MSG_CONFLICT)
+			return
+		}
+		rule := new(whisk.Rule)
+		if err := readJSON(r, rule); err != nil {
+			writeError(w, http.StatusBadRequest, MSG_BAD_REQUEST)
+			return
+		}
+		triggerName, _ := rule.Trigger.(string)
+		actionName, _ := rule.Action.(string)
+		if len(triggerName) == 0 || len(actionName) == 0 {
+			writeError(w, http.StatusBadRequest, MSG_BAD_REQUEST)
+			return
+		}
+		triggerNs, triggerName := s.splitQualifiedName(triggerName, ns)
+		if _, ok := s.namespace(triggerNs).triggers[triggerName]; !ok {
+			writeError(w, http.StatusNotFound, MSG_NOT_FOUND)
+			return
+		}
+		actionNs, actionName := s.splitQualifiedName(actionName, ns)
+		if _, _, ok := s.lookupAction(actionNs, actionName); !ok {
+			writeError(w, http.StatusNotFound, MSG_NOT_FOUND)
+			return
+		}
+		rule.Namespace = ns
+		rule.Name = name
+		if exists {
+			rule.Version = existing.Version
+		}
+		rule.Version = nextVersion(rule.Version)
+		rule.Publish = publish(rule.Publish)
+		rule.Updated = now()
+		rule.Status = STATUS_ACTIVE
+		rule.Trigger = entityPath(triggerNs, triggerName)
+		rule.Action = entityPath(actionNs, actionName)
+		entities.rules[name] = rule
+		writeJSON(w, http.StatusOK, rule)
+	case http.MethodDelete:
+		if !exists {
+			writeError(w, http.StatusNotFound, MSG_NOT_FOUND)
+			return
+		}
+		delete(entities.rules, name)
+		writeJSON(w, http.StatusOK, existing)
+	case http.MethodPost:
+		if !exists {
+			writeError(w, http.StatusNotFound, MSG_NOT_FOUND)
+			return
+		}
+		state := new(whisk.Rule)
+		if err := readJSON(r, state); err != nil || (state.Status != STATUS_ACTIVE && state.Status != STATUS_INACTIVE) {
+			writeError(w, http.StatusBadRequest, MSG_BAD_REQUEST)
+			return
+		}
+		existing.Status = state.Status
+		writeJSON(w, http.StatusOK, existing)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, MSG_NOT_ALLOWED)
+	}
+}
+
+// resolveNamespace maps an empty or default namespace to the server namespace
+func (s *Server) resolveNamespace(ns string) string {
+	if len(ns) == 0 || ns == DEFAULT_NAMESPACE_PLACEHOLDER {
+		return s.Namespace
+	}
+	return ns
+}
+
+// splitQualifiedName splits /namespace/[package/]name into the namespace and the
+// name within it, names which are not fully qualified belong to the given namespace
+func (s *Server) splitQualifiedName(qualifiedName string, ns string) (string, string) {
+	if !strings.HasPrefix(qualifiedName, PATH_SEPARATOR) {
+		return ns, qualifiedName
+	}
+	parts := strings.SplitN(strings.TrimPrefix(qualifiedName, PATH_SEPARATOR), PATH_SEPARATOR, 2)
+	if len(parts) < 2 {
+		return ns, parts[0]
+	}
+	return s.resolveNamespace(parts[0]), parts[1]
+}
+
+// splitActionName splits an action name within a namespace into its package and name
+func splitActionName(name string) (string, string) {
+	if i := strings.Index(name, PATH_SEPARATOR); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return "", name
+}
+
+// entityPath returns the {path, name} form the controller uses for the trigger and
+// action of a rule
+func entityPath(ns string, name string) map[string]interface{} {
+	pkgName, entityName := splitActionName(name)
+	path := ns
+	if len(pkgName) > 0 {
+		path = ns + PATH_SEPARATOR + pkgName
+	}
+	return map[string]interface{}{"path": path, "name": entityName}
+}
+
+// ruleEntity reverses entityPath, returning the namespace and the name within it
+func ruleEntity(entity interface{}) (string, string) {
+	e, _ := entity.(map[string]interface{})
+	path, _ := e["path"].(string)
+	name, _ := e["name"].(string)
+	parts := strings.SplitN(path, PATH_SEPARATOR, 2)
+	if len(parts) == 2 {
+		return parts[0], parts[1] + PATH_SEPARATOR + name
+	}
+	return parts[0], name
+}
+
+func actionsInPackage(entities *namespace, pkgName string) []string {
+	var keys []string
+	for _, key := range sortedKeys(entities.actions) {
+		if strings.HasPrefix(key, pkgName+PATH_SEPARATOR) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func addParams(params map[string]interface{}, keyValues whisk.KeyValueArr) {
+	for _, param := range keyValues {
+		params[param.Key] = param.Value
+	}
+}
+
+func withoutCode(action *whisk.Action) *whisk.Action {
+	result := *action
+	if action.Exec != nil {
+		exec := *action.Exec
+		exec.Code = nil
+		result.Exec = &exec
+	}
+	return &result
+}
+
+// sortedKeys returns the keys of a map keyed by entity or namespace name, sorted
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch entities := m.(type) {
+	case map[string]*namespace:
+		for key := range entities {
+			keys = append(keys, key)
+		}
+	case map[string]*whisk.Package:
+		for key := range entities {
+			keys = append(keys, key)
+		}
+	case map[string]*whisk.Action:
+		for key := range entities {
+			keys = append(keys, key)
+		}
+	case map[string]*whisk.Trigger:
+		for key := range entities {
+			keys = append(keys, key)
+		}
+	case map[string]*whisk.Rule:
+		for key := range entities {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// page applies the skip and limit query parameters of list requests, a limit of 0
+// returns every remaining entity
+func page(r *http.Request, keys []string) []string {
+	query := r.URL.Query()
+	skip, _ := strconv.Atoi(query.Get(PARAM_SKIP))
+	limit, _ := strconv.Atoi(query.Get(PARAM_LIMIT))
+	if skip >= len(keys) {
+		return nil
+	}
+	keys = keys[skip:]
+	if limit > 0 && limit < len(keys) {
+		keys = keys[:limit]
+	}
+	return keys
+}
+
+func overwrite(r *http.Request) bool {
+	return r.URL.Query().Get(PARAM_OVERWRITE) == "true"
+}
+
+func publish(value *bool) *bool {
+	if value == nil {
+		value = new(bool)
+	}
+	return value
+}
+
+// nextVersion increments the patch level of the version of an updated entity, new
+// entities start at 0.0.1
+func nextVersion(current string) string {
+	parts := strings.Split(current, ".")
+	if len(parts) != 3 {
+		return "0.0.1"
+	}
+	patch, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return "0.0.1"
+	}
+	return fmt.Sprintf("%s.%s.%d", parts[0], parts[1], patch+1)
+}
+
+func now() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
@@ -0,0 +1,100 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fakewhisk
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// the parameters and the lifecycle events feed actions are invoked with
+const (
+	PARAM_LIFECYCLE_EVENT = "lifecycleEvent"
+	PARAM_TRIGGER_NAME    = "triggerName"
+	PARAM_AUTH_KEY        = "authKey"
+
+	LIFECYCLE_CREATE  = "CREATE"
+	LIFECYCLE_READ    = "READ"
+	LIFECYCLE_UPDATE  = "UPDATE"
+	LIFECYCLE_DELETE  = "DELETE"
+	LIFECYCLE_PAUSE   = "PAUSE"
+	LIFECYCLE_UNPAUSE = "UNPAUSE"
+
+	MSG_NOT_SUBSCRIBED = "Trigger %v is not registered with the feed"
+)
+
+// subscription is a trigger registered with a feed, its configuration holds the
+// parameters of the last CREATE or UPDATE lifecycle event
+type subscription struct {
+	config map[string]interface{}
+	active bool
+}
+
+// Subscription returns the configuration of the trigger registered with a feed, and
+// whether the feed is active, i.e. not paused
+func (s *Server) Subscription(triggerName string) (map[string]interface{}, bool, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	sub, ok := s.subscriptions[triggerName]
+	if !ok {
+		return nil, false, false
+	}
+	return sub.config, sub.active, true
+}
+
+// feedLifecycle handles the lifecycle event of any action invoked with one, like the feed
+// providers do: triggers are registered on CREATE, and READ answers their configuration
+// and status. It fails for events other than CREATE and DELETE of unregistered triggers.
+func (s *Server) feedLifecycle(w http.ResponseWriter, event string, params map[string]interface{}) (map[string]interface{}, bool) {
+	trigger := params[PARAM_TRIGGER_NAME]
+	config := make(map[string]interface{})
+	for key, value := range params {
+		if key != PARAM_LIFECYCLE_EVENT && key != PARAM_TRIGGER_NAME && key != PARAM_AUTH_KEY {
+			config[key] = value
+		}
+	}
+
+	key := fmt.Sprint(trigger)
+	sub, exists := s.subscriptions[key]
+	switch {
+	case event == LIFECYCLE_CREATE:
+		s.subscriptions[key] = &subscription{config: config, active: true}
+		return params, true
+	case event == LIFECYCLE_DELETE:
+		delete(s.subscriptions, key)
+		return params, true
+	case !exists:
+		writeError(w, http.StatusBadGateway, fmt.Sprintf(MSG_NOT_SUBSCRIBED, trigger))
+		return nil, false
+	}
+
+	switch event {
+	case LIFECYCLE_READ:
+		return map[string]interface{}{
+			"config": sub.config,
+			"status": map[string]interface{}{"active": sub.active},
+		}, true
+	case LIFECYCLE_UPDATE:
+		sub.config = config
+	case LIFECYCLE_PAUSE:
+		sub.active = false
+	case LIFECYCLE_UNPAUSE:
+		sub.active = true
+	}
+	return params, true
+}
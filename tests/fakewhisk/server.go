@@ -0,0 +1,229 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fakewhisk implements the parts of the OpenWhisk REST API used by
+// whisk.Client in memory, so that wskdeploy can be exercised end to end without
+// a live OpenWhisk deployment.
+package fakewhisk
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/apache/openwhisk-wskdeploy/runtimes"
+)
+
+const (
+	DEFAULT_NAMESPACE = "guest"
+	DEFAULT_AUTH      = "23bc46b1-71f6-4ed5-8c54-816aa4f8c502:123zO3xZCLrMN6v2BKK1dXYFpXlPkccOFqm12CdAsMgRU4VrNZ9lyGVCGuMDGIwP"
+	SYSTEM_NAMESPACE  = "whisk.system"
+	API_VERSION       = "v1"
+	CONTENT_TYPE      = "application/json; charset=UTF-8"
+
+	// namespace placeholder resolved to the namespace of the authenticated subject
+	DEFAULT_NAMESPACE_PLACEHOLDER = "_"
+
+	COLLECTION_PACKAGES = "packages"
+	COLLECTION_ACTIONS  = "actions"
+	COLLECTION_TRIGGERS = "triggers"
+	COLLECTION_RULES    = "rules"
+
+	MSG_NOT_FOUND      = "The requested resource does not exist."
+	MSG_CONFLICT       = "resource already exists"
+	MSG_UNAUTHORIZED   = "The supplied authentication is invalid"
+	MSG_BAD_REQUEST    = "The request content was malformed"
+	MSG_NOT_ALLOWED    = "The requested operation is not allowed."
+	MSG_NOT_EMPTY      = "Package not empty (contains %d entities)"
+	MSG_BINDING_TARGET = "binding references a package that does not exist"
+)
+
+// Invocation records an action invocation received by the server, feed actions
+// are invoked with the lifecycleEvent, triggerName and authKey parameters
+type Invocation struct {
+	Namespace string
+	Name      string
+	Params    map[string]interface{}
+}
+
+// Server is an in-memory OpenWhisk listening on a local httptest.Server
+type Server struct {
+	Namespace string
+	AuthKey   string
+
+	server      *httptest.Server
+	mutex       sync.Mutex
+	namespaces  map[string]*namespace
+	apis        map[string]*apiDoc
+	invocations []Invocation
+	activations int
+
+	// triggers registered with feeds, by the triggerName parameter of their lifecycle events
+	subscriptions map[string]*subscription
+
+	// the kinds the default kind of each runtime family, e.g. nodejs:default, resolves to
+	defaultKinds map[string]string
+}
+
+// NewServer starts a server holding the namespace DEFAULT_NAMESPACE accessible with
+// DEFAULT_AUTH, and the whisk.system packages providing the well known feeds
+func NewServer() *Server {
+	s := &Server{
+		Namespace:     DEFAULT_NAMESPACE,
+		AuthKey:       DEFAULT_AUTH,
+		namespaces:    make(map[string]*namespace),
+		apis:          make(map[string]*apiDoc),
+		subscriptions: make(map[string]*subscription),
+	}
+	var info runtimes.OpenWhiskInfo
+	if err := json.Unmarshal(runtimes.RUNTIME_DETAILS, &info); err == nil {
+		s.defaultKinds = runtimes.DefaultRuntimes(info)
+	}
+	s.seedSystemPackages()
+	s.server = httptest.NewServer(s)
+	return s
+}
+
+// URL returns the API host to configure wskdeploy with, e.g. http://127.0.0.1:34567
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Close shuts the server down
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// WriteWskprops writes a wskprops file pointing at the server to the given path
+func (s *Server) WriteWskprops(path string) error {
+	props := fmt.Sprintf("APIHOST=%s\nAUTH=%s\nNAMESPACE=%s\nAPIGW_ACCESS_TOKEN=%s\n",
+		s.URL(), s.AuthKey, s.Namespace, "fakewhisk")
+	return ioutil.WriteFile(path, []byte(props), 0600)
+}
+
+// Invocations returns the action invocations received so far, in order
+func (s *Server) Invocations() []Invocation {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]Invocation(nil), s.invocations...)
+}
+
+// Reset drops every entity created through the API, keeping the whisk.system packages
+func (s *Server) Reset() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.namespaces = make(map[string]*namespace)
+	s.apis = make(map[string]*apiDoc)
+	s.invocations = nil
+	s.subscriptions = make(map[string]*subscription)
+	s.seedSystemPackages()
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	path := strings.Trim(r.URL.Path, "/")
+
+	// the info document read by runtimes.ParseOpenWhisk
+	if path == "" {
+		w.Header().Set("Content-Type", CONTENT_TYPE)
+		w.Write(runtimes.RUNTIME_DETAILS)
+		return
+	}
+
+	prefix := "api/" + API_VERSION
+	if path != prefix && !strings.HasPrefix(path, prefix+"/") {
+		writeError(w, http.StatusNotFound, MSG_NOT_FOUND)
+		return
+	}
+	if !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, MSG_UNAUTHORIZED)
+		return
+	}
+
+	segments := strings.Split(strings.TrimPrefix(path, prefix), "/")[1:]
+	switch {
+	case len(segments) == 0:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"description": "OpenWhisk", "api_paths": []string{"/api/" + API_VERSION}})
+	case segments[0] == "namespaces" && len(segments) == 1:
+		writeJSON(w, http.StatusOK, []string{s.Namespace})
+	case segments[0] == "namespaces" && len(segments) >= 3:
+		ns := segments[1]
+		if ns == DEFAULT_NAMESPACE_PLACEHOLDER {
+			ns = s.Namespace
+		}
+		// a trailing slash lists the actions of a package, e.g. actions/pkg/
+		name := strings.Join(segments[3:], "/")
+		if strings.HasSuffix(r.URL.Path, "/") && len(name) > 0 {
+			name += "/"
+		}
+		s.serveEntity(w, r, ns, segments[2], name)
+	case segments[0] == "web" && len(segments) == 4 && segments[1] == SYSTEM_NAMESPACE && segments[2] == "apimgmt":
+		s.serveApi(w, r, segments[3])
+	default:
+		writeError(w, http.StatusNotFound, MSG_NOT_FOUND)
+	}
+}
+
+// authorized checks the basic authentication header against the server AuthKey
+func (s *Server) authorized(r *http.Request) bool {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Basic ") {
+		return false
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(header, "Basic "))
+	return err == nil && string(key) == s.AuthKey
+}
+
+// subject returns the uuid part of the server AuthKey, used as the API gateway tenant
+func (s *Server) subject() string {
+	return strings.Split(s.AuthKey, ":")[0]
+}
+
+func (s *Server) nextActivationId() string {
+	s.activations++
+	return fmt.Sprintf("%032x", s.activations)
+}
+
+func readJSON(r *http.Request, v interface{}) error {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	if len(body) == 0 {
+		return nil
+	}
+	return json.Unmarshal(body, v)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", CONTENT_TYPE)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error in the format of the OpenWhisk controller, whisk.Client
+// only treats the body as a server error when both error and code are present
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{"error": message, "code": "fakewhisk"})
+}
@@ -0,0 +1,295 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fakewhisk
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/runtimes"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(t *testing.T, s *Server, authKey string) *whisk.Client {
+	baseURL, err := whisk.GetURLBase(s.URL(), "/api")
+	assert.NoError(t, err)
+	client, err := whisk.NewClient(http.DefaultClient, &whisk.Config{
+		Namespace: s.Namespace,
+		AuthToken: authKey,
+		Host:      s.URL(),
+		BaseURL:   baseURL,
+		Version:   API_VERSION,
+	})
+	assert.NoError(t, err)
+	return client
+}
+
+func nodeAction(name string) *whisk.Action {
+	code := "function main() { return {}; }"
+	return &whisk.Action{Name: name, Exec: &whisk.Exec{Kind: "nodejs:default", Code: &code}}
+}
+
+func TestServer_Info(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	info, err := runtimes.ParseOpenWhisk(s.URL())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, info.Runtimes["nodejs"])
+}
+
+func TestServer_Unauthorized(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	client := newTestClient(t, s, "unknown:key")
+	_, response, err := client.Packages.List(&whisk.PackageListOptions{})
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
+}
+
+func TestServer_PackagesAndActions(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := newTestClient(t, s, s.AuthKey)
+
+	pkg, _, err := client.Packages.Insert(&whisk.Package{Name: "pkg"}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.0.1", pkg.Version)
+
+	// inserting again requires overwrite
+	_, response, err := client.Packages.Insert(&whisk.Package{Name: "pkg"}, false)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusConflict, response.StatusCode)
+	pkg, _, err = client.Packages.Insert(&whisk.Package{Name: "pkg"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.0.2", pkg.Version)
+
+	// actions can only be created in existing packages
+	_, response, err = client.Actions.Insert(nodeAction("missing/hello"), true)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+	action, _, err := client.Actions.Insert(nodeAction("pkg/hello"), true)
+	assert.NoError(t, err)
+	assert.Equal(t, "guest/pkg", action.Namespace)
+	assert.Equal(t, "hello", action.Name)
+	assert.NotEqual(t, "nodejs:default", action.Exec.Kind, "default kind must be resolved")
+
+	action, _, err = client.Actions.Get("pkg/hello", false)
+	assert.NoError(t, err)
+	assert.Nil(t, action.Exec.Code)
+
+	actions, _, err := client.Actions.List("pkg", &whisk.ActionListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(actions))
+
+	pkg, _, err = client.Packages.Get("pkg")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(pkg.Actions))
+
+	// packages can only be deleted once empty
+	response, err = client.Packages.Delete("pkg")
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusConflict, response.StatusCode)
+	_, err = client.Actions.Delete("pkg/hello")
+	assert.NoError(t, err)
+	_, err = client.Packages.Delete("pkg")
+	assert.NoError(t, err)
+
+	_, response, err = client.Packages.Get("pkg")
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+func TestServer_BindingsAndFeeds(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := newTestClient(t, s, s.AuthKey)
+
+	binding := &whisk.BindingPackage{
+		Name:       "myAlarms",
+		Binding:    whisk.Binding{Namespace: SYSTEM_NAMESPACE, Name: "alarms"},
+		Parameters: whisk.KeyValueArr{{Key: "cron", Value: "* * * * *"}},
+	}
+	_, _, err := client.Packages.Insert(binding, true)
+	assert.NoError(t, err)
+
+	pkg, _, err := client.Packages.Get("myAlarms")
+	assert.NoError(t, err)
+	assert.NotNil(t, pkg.Annotations.GetValue(ANNOTATION_BINDING))
+	assert.Equal(t, 3, len(pkg.Feeds))
+
+	unknown := &whisk.BindingPackage{Name: "unknown", Binding: whisk.Binding{Namespace: SYSTEM_NAMESPACE, Name: "unknown"}}
+	_, response, err := client.Packages.Insert(unknown, true)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	// feed actions invoked through the binding see the binding parameters
+	params := map[string]interface{}{"lifecycleEvent": "CREATE", "triggerName": "/guest/tick"}
+	_, _, err = client.Actions.Invoke("myAlarms/alarm", params, true, false)
+	assert.NoError(t, err)
+
+	invocations := s.Invocations()
+	assert.Equal(t, 1, len(invocations))
+	assert.Equal(t, "whisk.system/alarms", invocations[0].Namespace)
+	assert.Equal(t, "alarm", invocations[0].Name)
+	assert.Equal(t, "CREATE", invocations[0].Params["lifecycleEvent"])
+	assert.Equal(t, "* * * * *", invocations[0].Params["cron"])
+}
+
+func TestServer_FeedLifecycle(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := newTestClient(t, s, s.AuthKey)
+	binding := &whisk.BindingPackage{Name: "myAlarms", Binding: whisk.Binding{Namespace: SYSTEM_NAMESPACE, Name: "alarms"}}
+	_, _, err := client.Packages.Insert(binding, true)
+	assert.NoError(t, err)
+
+	read := map[string]interface{}{PARAM_LIFECYCLE_EVENT: LIFECYCLE_READ, PARAM_TRIGGER_NAME: "/guest/tick"}
+	_, _, err = client.Actions.Invoke("myAlarms/alarm", read, true, true)
+	assert.Error(t, err, "unregistered triggers cannot be read")
+
+	create := map[string]interface{}{PARAM_LIFECYCLE_EVENT: LIFECYCLE_CREATE, PARAM_TRIGGER_NAME: "/guest/tick", "cron": "* * * * *"}
+	_, _, err = client.Actions.Invoke("myAlarms/alarm", create, true, true)
+	assert.NoError(t, err)
+
+	result, _, err := client.Actions.Invoke("myAlarms/alarm", read, true, true)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"config": map[string]interface{}{"cron": "* * * * *"},
+		"status": map[string]interface{}{"active": true},
+	}, result)
+
+	update := map[string]interface{}{PARAM_LIFECYCLE_EVENT: LIFECYCLE_UPDATE, PARAM_TRIGGER_NAME: "/guest/tick", "cron": "0 * * * *"}
+	_, _, err = client.Actions.Invoke("myAlarms/alarm", update, true, true)
+	assert.NoError(t, err)
+	pause := map[string]interface{}{PARAM_LIFECYCLE_EVENT: LIFECYCLE_PAUSE, PARAM_TRIGGER_NAME: "/guest/tick"}
+	_, _, err = client.Actions.Invoke("myAlarms/alarm", pause, true, true)
+	assert.NoError(t, err)
+	config, active, ok := s.Subscription("/guest/tick")
+	assert.True(t, ok)
+	assert.False(t, active)
+	assert.Equal(t, "0 * * * *", config["cron"])
+
+	remove := map[string]interface{}{PARAM_LIFECYCLE_EVENT: LIFECYCLE_DELETE, PARAM_TRIGGER_NAME: "/guest/tick"}
+	_, _, err = client.Actions.Invoke("myAlarms/alarm", remove, true, true)
+	assert.NoError(t, err)
+	_, _, ok = s.Subscription("/guest/tick")
+	assert.False(t, ok)
+}
+
+func TestServer_TriggersAndRules(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := newTestClient(t, s, s.AuthKey)
+
+	_, _, err := client.Actions.Insert(nodeAction("hello"), true)
+	assert.NoError(t, err)
+
+	// rules need an existing trigger
+	_, response, err := client.Rules.Insert(&whisk.Rule{Name: "onTick", Trigger: "/guest/tick", Action: "/guest/hello"}, true)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+	_, _, err = client.Triggers.Insert(&whisk.Trigger{Name: "tick"}, true)
+	assert.NoError(t, err)
+	rule, _, err := client.Rules.Insert(&whisk.Rule{Name: "onTick", Trigger: "/guest/tick", Action: "/guest/hello"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, STATUS_ACTIVE, rule.Status)
+	assert.Equal(t, map[string]interface{}{"path": "guest", "name": "tick"}, rule.Trigger)
+
+	trigger, _, err := client.Triggers.Get("tick")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(trigger.Rules))
+
+	_, _, err = client.Triggers.Fire("tick", map[string]interface{}{"tock": true})
+	assert.NoError(t, err)
+	invocations := s.Invocations()
+	assert.Equal(t, 1, len(invocations))
+	assert.Equal(t, "hello", invocations[0].Name)
+	assert.Equal(t, true, invocations[0].Params["tock"])
+
+	rule, _, err = client.Rules.SetState("onTick", STATUS_INACTIVE)
+	assert.NoError(t, err)
+	assert.Equal(t, STATUS_INACTIVE, rule.Status)
+	_, _, err = client.Triggers.Fire("tick", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(s.Invocations()))
+
+	// deleting the trigger disables its rules
+	_, _, err = client.Rules.SetState("onTick", STATUS_ACTIVE)
+	assert.NoError(t, err)
+	_, _, err = client.Triggers.Delete("tick")
+	assert.NoError(t, err)
+	rule, _, err = client.Rules.Get("onTick")
+	assert.NoError(t, err)
+	assert.Equal(t, STATUS_INACTIVE, rule.Status)
+}
+
+func TestServer_Sequences(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := newTestClient(t, s, s.AuthKey)
+
+	sequence := &whisk.Action{Name: "seq", Exec: &whisk.Exec{Kind: KIND_SEQUENCE, Components: []string{"/guest/hello"}}}
+	_, response, err := client.Actions.Insert(sequence, true)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	_, _, err = client.Actions.Insert(nodeAction("hello"), true)
+	assert.NoError(t, err)
+	_, _, err = client.Actions.Insert(sequence, true)
+	assert.NoError(t, err)
+}
+
+func TestServer_Apis(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := newTestClient(t, s, s.AuthKey)
+
+	api := &whisk.ApiCreateRequest{ApiDoc: &whisk.Api{
+		ApiName:         "books",
+		GatewayBasePath: "/library",
+		GatewayRelPath:  "/books",
+		GatewayMethod:   "GET",
+		Action:          &whisk.ApiAction{Name: "library/books", Namespace: "guest", BackendUrl: "https://host/api/v1/web/guest/library/books.http"},
+	}}
+	created, _, err := client.Apis.Insert(api, &whisk.ApiCreateRequestOptions{}, true)
+	assert.NoError(t, err)
+	operation := created.Swagger.Paths["/books"].Get
+	assert.Equal(t, "books", operation.XOpenWhisk.ActionName)
+	assert.Equal(t, "library", operation.XOpenWhisk.Package)
+
+	apis, _, err := client.Apis.Get(&whisk.ApiGetRequest{}, &whisk.ApiGetRequestOptions{ApiBasePath: "/library"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(apis.Apis))
+
+	_, err = client.Apis.Delete(&whisk.ApiDeleteRequest{}, &whisk.ApiDeleteRequestOptions{ApiBasePath: "/library", ApiRelPath: "/books", ApiVerb: "GET"})
+	assert.NoError(t, err)
+	apis, _, err = client.Apis.Get(&whisk.ApiGetRequest{}, &whisk.ApiGetRequestOptions{ApiBasePath: "/library"})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(apis.Apis))
+
+	response, err := client.Apis.Delete(&whisk.ApiDeleteRequest{}, &whisk.ApiDeleteRequestOptions{ApiBasePath: "/library"})
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
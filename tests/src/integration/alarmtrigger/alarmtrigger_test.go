@@ -23,7 +23,6 @@ import (
 	"fmt"
 	"github.com/apache/openwhisk-wskdeploy/tests/src/integration/common"
 	"github.com/stretchr/testify/assert"
-	"os"
 	"testing"
 )
 
@@ -47,6 +46,6 @@ func TestAlarmTrigger(t *testing.T) {
 }
 
 var (
-	manifestPath   = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/alarmtrigger/manifest.yaml"
-	deploymentPath = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/alarmtrigger/deployment.yaml"
+	manifestPath   = common.ProjectPath + "/tests/src/integration/alarmtrigger/manifest.yaml"
+	deploymentPath = common.ProjectPath + "/tests/src/integration/alarmtrigger/deployment.yaml"
 )
@@ -22,15 +22,14 @@ package tests
 import (
 	"github.com/apache/openwhisk-wskdeploy/tests/src/integration/common"
 	"github.com/stretchr/testify/assert"
-	"os"
 	"testing"
 )
 
-const PATH = "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/apigateway/"
+const PATH = "/tests/src/integration/apigateway/"
 
 func TestApiGateway(t *testing.T) {
 	wskdeploy := common.NewWskdeploy()
-	manifestPath := os.Getenv("GOPATH") + PATH + "manifest.yml"
+	manifestPath := common.ProjectPath + PATH + "manifest.yml"
 	_, err := wskdeploy.DeployManifestPathOnly(manifestPath)
 	assert.Equal(t, nil, err, "Failed to deploy based on the manifest file.")
 	_, err = wskdeploy.UndeployManifestPathOnly(manifestPath)
@@ -39,7 +38,7 @@ func TestApiGateway(t *testing.T) {
 
 func TestApiGatewayWithParams(t *testing.T) {
 	wskdeploy := common.NewWskdeploy()
-	manifestPath := os.Getenv("GOPATH") + PATH + "manifest-apis-with-params.yaml"
+	manifestPath := common.ProjectPath + PATH + "manifest-apis-with-params.yaml"
 	_, err := wskdeploy.DeployManifestPathOnly(manifestPath)
 	assert.Equal(t, nil, err, "Failed to deploy based on the manifest file.")
 	_, err = wskdeploy.UndeployManifestPathOnly(manifestPath)
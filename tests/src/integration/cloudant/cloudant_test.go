@@ -44,6 +44,6 @@ func TestCloudant(t *testing.T) {
 }
 
 var (
-	manifestPath   = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/cloudant/manifest.yaml"
+	manifestPath   = common.ProjectPath + "/tests/src/integration/cloudant/manifest.yaml"
 	deploymentPath = ""
 )
@@ -0,0 +1,106 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/tests/fakewhisk"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+)
+
+const (
+	// WSKDEPLOY_FAKEWHISK set to true or false forces the integration tests to run
+	// against an in-memory fakewhisk.Server, or against the configured OpenWhisk
+	WSKDEPLOY_FAKEWHISK = "WSKDEPLOY_FAKEWHISK"
+	WSK_CONFIG_FILE     = "WSK_CONFIG_FILE"
+	OPENWHISK_HOME      = "OPENWHISK_HOME"
+)
+
+// ProjectPath is the root directory of the wskdeploy sources, i.e. four levels up from this file
+var ProjectPath = projectPath()
+
+var fakeWhisk struct {
+	once     sync.Once
+	server   *fakewhisk.Server
+	wskprops string
+	binary   string
+	err      error
+}
+
+func projectPath() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "..", "..")
+}
+
+// UseFakeWhisk tells whether the integration tests run against fakewhisk, which is the
+// case unless an OpenWhisk is configured through WSK_CONFIG_FILE, OPENWHISK_HOME or ~/.wskprops
+func UseFakeWhisk() bool {
+	switch strings.ToLower(os.Getenv(WSKDEPLOY_FAKEWHISK)) {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if len(os.Getenv(WSK_CONFIG_FILE)) > 0 || len(os.Getenv(OPENWHISK_HOME)) > 0 {
+		return false
+	}
+	_, err := os.Stat(path.Join(utils.GetHomeDirectory(), whisk.DEFAULT_LOCAL_CONFIG))
+	return err != nil
+}
+
+// FakeWhisk returns the fakewhisk.Server shared by the tests of the package, starting
+// it and building the wskdeploy binary the tests run on first use
+func FakeWhisk() (*fakewhisk.Server, error) {
+	fakeWhisk.once.Do(func() {
+		dir, err := ioutil.TempDir("", "fakewhisk")
+		if err != nil {
+			fakeWhisk.err = err
+			return
+		}
+		fakeWhisk.binary = filepath.Join(dir, cmd)
+		build := exec.Command("go", "build", "-o", fakeWhisk.binary, ".")
+		build.Dir = ProjectPath
+		if out, err := build.CombinedOutput(); err != nil {
+			fakeWhisk.err = wskderrors.NewCommandError(strings.Join(build.Args, " "), string(out))
+			return
+		}
+		fakeWhisk.server = fakewhisk.NewServer()
+		fakeWhisk.wskprops = filepath.Join(dir, whisk.DEFAULT_LOCAL_CONFIG)
+		fakeWhisk.err = fakeWhisk.server.WriteWskprops(fakeWhisk.wskprops)
+	})
+	return fakeWhisk.server, fakeWhisk.err
+}
+
+// GetFakeWhiskWskprops returns the credentials of the shared fakewhisk.Server
+func GetFakeWhiskWskprops() (*whisk.Wskprops, error) {
+	server, err := FakeWhisk()
+	if err != nil {
+		return nil, err
+	}
+	return GetWskpropsFromValues(server.URL(), server.Namespace, server.AuthKey, "v1"), nil
+}
@@ -51,10 +51,17 @@ type Wskdeploy struct {
 }
 
 func NewWskdeploy() *Wskdeploy {
-	return NewWskWithPath(os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/")
+	return NewWskWithPath(ProjectPath)
 }
 
+// GetWskpropsFromEnvVars reads the credentials from the given environment variables,
+// falling back to those of fakewhisk when they are not set
 func GetWskpropsFromEnvVars(apiHost string, namespace string, authKey string) *whisk.Wskprops {
+	if len(os.Getenv(apiHost)) == 0 && UseFakeWhisk() {
+		if wskprops, err := GetFakeWhiskWskprops(); err == nil {
+			return wskprops
+		}
+	}
 	return GetWskpropsFromValues(os.Getenv(apiHost), os.Getenv(namespace), os.Getenv(authKey), "v1")
 }
 
@@ -113,8 +120,19 @@ func printOutput(outs string) {
 }
 
 func (wskdeploy *Wskdeploy) RunCommand(s ...string) (string, error) {
-	command := exec.Command(wskdeploy.Path, s...)
+	binary, env := wskdeploy.Path, os.Environ()
+
+	// run the wskdeploy built from the sources against fakewhisk
+	if UseFakeWhisk() {
+		if _, err := FakeWhisk(); err != nil {
+			return "", err
+		}
+		binary, env = fakeWhisk.binary, append(env, WSK_CONFIG_FILE+"="+fakeWhisk.wskprops)
+	}
+
+	command := exec.Command(binary, s...)
 	command.Dir = wskdeploy.Dir
+	command.Env = env
 
 	printCommand(command)
 
@@ -232,6 +250,12 @@ func (wskdeploy *Wskdeploy) GetDeploymentObjects(manifestPath string, deployment
 	//these values might be mock values because it's only for testing
 	userHome := utils.GetHomeDirectory()
 	defaultPath := path.Join(userHome, whisk.DEFAULT_LOCAL_CONFIG)
+	if UseFakeWhisk() {
+		if _, err := FakeWhisk(); err != nil {
+			return nil, err
+		}
+		defaultPath = fakeWhisk.wskprops
+	}
 	clientConfig, err := deployers.NewWhiskConfig(defaultPath, deploymentPath, manifestPath)
 	if err != nil {
 		return nil, err
@@ -20,17 +20,16 @@
 package tests
 
 import (
-	"os"
 	"testing"
 
 	"github.com/apache/openwhisk-wskdeploy/tests/src/integration/common"
 	"github.com/stretchr/testify/assert"
 )
 
-const PATH = "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/conductor/"
+const PATH = "/tests/src/integration/conductor/"
 
 func TestManagedDeployment(t *testing.T) {
-	manifestPath := os.Getenv("GOPATH") + PATH + "manifest.yaml"
+	manifestPath := common.ProjectPath + PATH + "manifest.yaml"
 	wskdeploy := common.NewWskdeploy()
 	_, err := wskdeploy.DeployManifestPathOnly(manifestPath)
 	assert.Equal(t, nil, err, "Failed to deploy based on the manifest file.")
@@ -22,14 +22,13 @@ package tests
 import (
 	"github.com/apache/openwhisk-wskdeploy/tests/src/integration/common"
 	"github.com/stretchr/testify/assert"
-	"os"
 	"testing"
 	"time"
 )
 
 func TestDefaultPackage(t *testing.T) {
-	path := "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/defaultpackage/"
-	manifestPath := os.Getenv("GOPATH") + path + "manifest.yaml"
+	path := "/tests/src/integration/defaultpackage/"
+	manifestPath := common.ProjectPath + path + "manifest.yaml"
 	wskdeploy := common.NewWskdeploy()
 	_, err := wskdeploy.DeployManifestPathOnly(manifestPath)
 	assert.Equal(t, nil, err, "Failed to deploy based on the manifest file.")
@@ -38,7 +37,7 @@ func TestDefaultPackage(t *testing.T) {
 
 	time.Sleep(10 * time.Second)
 
-	manifestPath = os.Getenv("GOPATH") + path + "manifest-with-project.yaml"
+	manifestPath = common.ProjectPath + path + "manifest-with-project.yaml"
 	_, err = wskdeploy.DeployManifestPathOnly(manifestPath)
 	assert.Equal(t, nil, err, "Failed to deploy based on the manifest file.")
 	_, err = wskdeploy.UndeployManifestPathOnly(manifestPath)
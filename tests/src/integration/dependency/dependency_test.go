@@ -38,6 +38,6 @@ var wskprops = common.GetWskprops()
 //}
 //
 //var (
-//	manifestPath   = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/dependency/manifest.yaml"
+//	manifestPath   = common.ProjectPath + "/tests/src/integration/dependency/manifest.yaml"
 //	deploymentPath = ""
 //)
@@ -22,7 +22,6 @@ package tests
 import (
 	"github.com/apache/openwhisk-wskdeploy/tests/src/integration/common"
 	"github.com/stretchr/testify/assert"
-	"os"
 	"testing"
 )
 
@@ -35,5 +34,5 @@ func TestDocker(t *testing.T) {
 }
 
 var (
-	manifestPath = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/docker/manifest.yaml"
+	manifestPath = common.ProjectPath + "/tests/src/integration/docker/manifest.yaml"
 )
@@ -30,16 +30,16 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-const EXPORT_TEST_PATH = "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/export/"
+const EXPORT_TEST_PATH = "/tests/src/integration/export/"
 
 func TestExport(t *testing.T) {
 	projectName := "EXT_PROJECT"
 
-	manifestLib1Path := os.Getenv("GOPATH") + EXPORT_TEST_PATH + "manifest_lib1.yaml"
-	manifestLib2Path := os.Getenv("GOPATH") + EXPORT_TEST_PATH + "manifest_lib2.yaml"
-	manifestExtPath := os.Getenv("GOPATH") + EXPORT_TEST_PATH + "manifest_ext.yaml"
+	manifestLib1Path := common.ProjectPath + EXPORT_TEST_PATH + "manifest_lib1.yaml"
+	manifestLib2Path := common.ProjectPath + EXPORT_TEST_PATH + "manifest_lib2.yaml"
+	manifestExtPath := common.ProjectPath + EXPORT_TEST_PATH + "manifest_ext.yaml"
 
-	targetManifestFolder := os.Getenv("GOPATH") + EXPORT_TEST_PATH + "tmp-" + strconv.Itoa(rand.Intn(1000)) + "/"
+	targetManifestFolder := common.ProjectPath + EXPORT_TEST_PATH + "tmp-" + strconv.Itoa(rand.Intn(1000)) + "/"
 	targetManifestPath := targetManifestFolder + "manifest-" + projectName + ".yaml"
 
 	wskdeploy := common.NewWskdeploy()
@@ -78,8 +78,8 @@ func TestExport(t *testing.T) {
 
 func SkipTestExportHelloWorld(t *testing.T) {
 	projectName := "HELLO_WORLD"
-	manifestHelloWorldPath := os.Getenv("GOPATH") + EXPORT_TEST_PATH + "manifest_helloworld.yaml"
-	targetManifestFolder := os.Getenv("GOPATH") + EXPORT_TEST_PATH + "tmp-" + strconv.Itoa(rand.Intn(1000)) + "/"
+	manifestHelloWorldPath := common.ProjectPath + EXPORT_TEST_PATH + "manifest_helloworld.yaml"
+	targetManifestFolder := common.ProjectPath + EXPORT_TEST_PATH + "tmp-" + strconv.Itoa(rand.Intn(1000)) + "/"
 	targetManifestHelloWorldPath := targetManifestFolder + "manifest-" + projectName + ".yaml"
 
 	wskdeploy := common.NewWskdeploy()
@@ -114,8 +114,8 @@ func SkipTestExportHelloWorld(t *testing.T) {
 }
 
 func TestExport2Pack(t *testing.T) {
-	manifest2PackPath := os.Getenv("GOPATH") + EXPORT_TEST_PATH + "manifest_2pack.yaml"
-	targetManifestFolder := os.Getenv("GOPATH") + EXPORT_TEST_PATH + "tmp-" + strconv.Itoa(rand.Intn(1000)) + "/"
+	manifest2PackPath := common.ProjectPath + EXPORT_TEST_PATH + "manifest_2pack.yaml"
+	targetManifestFolder := common.ProjectPath + EXPORT_TEST_PATH + "tmp-" + strconv.Itoa(rand.Intn(1000)) + "/"
 	target2PackManifestPath := targetManifestFolder + "exported2packmanifest.yaml"
 
 	projectName := "2pack"
@@ -207,19 +207,19 @@ func SkipTestExportTriggerFeed(t *testing.T) {
 }
 
 var (
-	manifestLib1Path = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/export/manifest_lib1.yaml"
-	manifestLib2Path = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/export/manifest_lib2.yaml"
-	manifestExtPath  = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/export/manifest_ext.yaml"
+	manifestLib1Path = common.ProjectPath + "/tests/src/integration/export/manifest_lib1.yaml"
+	manifestLib2Path = common.ProjectPath + "/tests/src/integration/export/manifest_lib2.yaml"
+	manifestExtPath  = common.ProjectPath + "/tests/src/integration/export/manifest_ext.yaml"
 
-	targetManifestFolder = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/export/tmp/"
+	targetManifestFolder = common.ProjectPath + "/tests/src/integration/export/tmp/"
 	targetManifestPath   = targetManifestFolder + "manifest.yaml"
 
-	manifest2PackPath       = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/export/manifest_2pack.yaml"
+	manifest2PackPath       = common.ProjectPath + "/tests/src/integration/export/manifest_2pack.yaml"
 	target2PackManifestPath = targetManifestFolder + "exported2packmanifest.yaml"
 
-	manifestApiExpPath       = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/export/manifest_apiexp.yaml"
+	manifestApiExpPath       = common.ProjectPath + "/tests/src/integration/export/manifest_apiexp.yaml"
 	targetApiExpManifestPath = targetManifestFolder + "exportedapimanifest.yaml"
 
-	manifestFeedExpPath       = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/export/manifest_feed.yaml"
+	manifestFeedExpPath       = common.ProjectPath + "/tests/src/integration/export/manifest_feed.yaml"
 	targetFeedExpManifestPath = targetManifestFolder + "exportedfeedmanifest.yaml"
 )
@@ -0,0 +1,143 @@
+// +build integration
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/apache/openwhisk-wskdeploy/tests/src/integration/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeeds(t *testing.T) {
+	wskdeploy := common.NewWskdeploy()
+	_, err := wskdeploy.DeployManifestPathOnly(manifestPath)
+	assert.Equal(t, nil, err, "Failed to deploy based on the manifest file.")
+	if common.UseFakeWhisk() {
+		assertLastFeedInvocation(t, "changes", "CREATE")
+	}
+
+	// the feed of an unchanged trigger is only read, once for the rollback snapshot
+	// and once to compare its configuration with the manifest
+	start := invocationCount(t)
+	_, err = wskdeploy.DeployManifestPathOnly(manifestPath)
+	assert.Equal(t, nil, err, "Failed to deploy based on the manifest file.")
+	if common.UseFakeWhisk() {
+		assert.Equal(t, []string{"READ", "READ"}, lifecycleEventsSince(t, start))
+	}
+
+	// the feed of a changed trigger is updated, then paused
+	start = invocationCount(t)
+	_, err = wskdeploy.DeployManifestPathOnly(manifestInactivePath)
+	assert.Equal(t, nil, err, "Failed to deploy based on the manifest file.")
+	if common.UseFakeWhisk() {
+		assert.Equal(t, []string{"READ", "READ", "UPDATE", "PAUSE"}, lifecycleEventsSince(t, start))
+		assertLastFeedInvocation(t, "pauseChanges", "PAUSE")
+	}
+
+	start = invocationCount(t)
+	_, err = wskdeploy.DeployManifestPathOnly(manifestPath)
+	assert.Equal(t, nil, err, "Failed to deploy based on the manifest file.")
+	if common.UseFakeWhisk() {
+		assert.Equal(t, []string{"READ", "READ", "UPDATE", "UNPAUSE"}, lifecycleEventsSince(t, start))
+	}
+
+	// the feed of a trigger whose input was removed is updated
+	start = invocationCount(t)
+	_, err = wskdeploy.DeployManifestPathOnly(manifestWithoutFilterPath)
+	assert.Equal(t, nil, err, "Failed to deploy based on the manifest file.")
+	if common.UseFakeWhisk() {
+		assert.Equal(t, []string{"READ", "READ", "UPDATE"}, lifecycleEventsSince(t, start))
+		assertLastFeedInvocation(t, "changes", "UPDATE")
+		fake, err := common.FakeWhisk()
+		assert.NoError(t, err)
+		invocations := fake.Invocations()
+		assert.NotContains(t, invocations[len(invocations)-1].Params, "filter")
+	}
+
+	_, err = wskdeploy.UndeployManifestPathOnly(manifestPath)
+	assert.Equal(t, nil, err, "Failed to undeploy based on the manifest file.")
+	if common.UseFakeWhisk() {
+		assertLastFeedInvocation(t, "changes", "DELETE")
+	}
+}
+
+func TestFeeds_ManagedTriggerRemoved(t *testing.T) {
+	wskdeploy := common.NewWskdeploy()
+	_, err := wskdeploy.ManagedDeploymentManifestAndProject(manifestPath, managedProjectName)
+	assert.Equal(t, nil, err, "Failed to deploy based on the manifest file.")
+
+	// the feed of a managed trigger removed from the manifest is deleted along with the trigger
+	_, err = wskdeploy.ManagedDeploymentManifestAndProject(manifestWithoutTriggerPath, managedProjectName)
+	assert.Equal(t, nil, err, "Failed to deploy based on the manifest file.")
+	if common.UseFakeWhisk() {
+		assertLastFeedInvocation(t, "changes", "DELETE")
+	}
+
+	_, err = wskdeploy.UndeployManifestPathOnly(manifestWithoutTriggerPath)
+	assert.Equal(t, nil, err, "Failed to undeploy based on the manifest file.")
+}
+
+// invocationCount returns the number of actions invoked so far on the fake OpenWhisk
+func invocationCount(t *testing.T) int {
+	if !common.UseFakeWhisk() {
+		return 0
+	}
+	server, err := common.FakeWhisk()
+	assert.NoError(t, err)
+	return len(server.Invocations())
+}
+
+// lifecycleEventsSince returns the lifecycle events of the feed actions invoked after
+// the first start invocations
+func lifecycleEventsSince(t *testing.T, start int) []string {
+	server, err := common.FakeWhisk()
+	assert.NoError(t, err)
+	events := []string{}
+	for _, invocation := range server.Invocations()[start:] {
+		if event, ok := invocation.Params["lifecycleEvent"].(string); ok {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// assertLastFeedInvocation checks that the last action invoked is the action of the feed
+// handling the lifecycle event, with the inputs of the feed and of the trigger
+func assertLastFeedInvocation(t *testing.T, action string, lifecycleEvent string) {
+	server, err := common.FakeWhisk()
+	assert.NoError(t, err)
+	invocations := server.Invocations()
+	if assert.NotEmpty(t, invocations, "The feed action was not invoked.") {
+		invocation := invocations[len(invocations)-1]
+		assert.Equal(t, action, invocation.Name)
+		assert.Equal(t, lifecycleEvent, invocation.Params["lifecycleEvent"])
+		assert.Equal(t, "https://events.example.com/changes", invocation.Params["location"])
+		assert.Equal(t, "documents", invocation.Params["topic"])
+		assert.Contains(t, invocation.Params["triggerName"], "onDocumentChange")
+	}
+}
+
+var manifestPath = common.ProjectPath + "/tests/src/integration/feeds/manifest.yaml"
+var manifestInactivePath = common.ProjectPath + "/tests/src/integration/feeds/manifest_inactive.yaml"
+var manifestWithoutFilterPath = common.ProjectPath + "/tests/src/integration/feeds/manifest_without_filter.yaml"
+var manifestWithoutTriggerPath = common.ProjectPath + "/tests/src/integration/feeds/manifest_without_trigger.yaml"
+
+const managedProjectName = "feeds"
@@ -22,7 +22,6 @@ package tests
 import (
 	"github.com/apache/openwhisk-wskdeploy/tests/src/integration/common"
 	"github.com/stretchr/testify/assert"
-	"os"
 	"testing"
 )
 
@@ -31,7 +30,7 @@ var wskprops = common.GetWskprops()
 // support only projectpath flag
 func TestSupportProjectPath(t *testing.T) {
 	wskdeploy := common.NewWskdeploy()
-	projectPath := os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/flagstests"
+	projectPath := common.ProjectPath + "/tests/src/integration/flagstests"
 	_, err := wskdeploy.DeployProjectPathOnly(projectPath)
 	assert.Equal(t, nil, err, "Failed to deploy based on the projectpath")
 	_, err = wskdeploy.UndeployProjectPathOnly(projectPath)
@@ -41,7 +40,7 @@ func TestSupportProjectPath(t *testing.T) {
 // support only projectpath with trailing slash
 func TestSupportProjectPathTrailingSlash(t *testing.T) {
 	wskdeploy := common.NewWskdeploy()
-	projectPath := os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/flagstests" + "/"
+	projectPath := common.ProjectPath + "/tests/src/integration/flagstests" + "/"
 	_, err := wskdeploy.DeployProjectPathOnly(projectPath)
 	assert.Equal(t, nil, err, "Failed to deploy based on the projectpath")
 	_, err = wskdeploy.UndeployProjectPathOnly(projectPath)
@@ -51,7 +50,7 @@ func TestSupportProjectPathTrailingSlash(t *testing.T) {
 // only a yaml manifest
 func TestSupportManifestYamlPath(t *testing.T) {
 	wskdeploy := common.NewWskdeploy()
-	manifestPath := os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/flagstests/manifest.yaml"
+	manifestPath := common.ProjectPath + "/tests/src/integration/flagstests/manifest.yaml"
 	_, err := wskdeploy.DeployManifestPathOnly(manifestPath)
 	assert.Equal(t, nil, err, "Failed to deploy based on the manifestpath")
 	_, err = wskdeploy.UndeployManifestPathOnly(manifestPath)
@@ -61,7 +60,7 @@ func TestSupportManifestYamlPath(t *testing.T) {
 // only a yml manifest
 func TestSupportManifestYmlPath(t *testing.T) {
 	wskdeploy := common.NewWskdeploy()
-	manifestPath := os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/flagstests/manifest.yml"
+	manifestPath := common.ProjectPath + "/tests/src/integration/flagstests/manifest.yml"
 	_, err := wskdeploy.DeployManifestPathOnly(manifestPath)
 	assert.Equal(t, nil, err, "Failed to deploy based on the manifestpath")
 	_, err = wskdeploy.UndeployManifestPathOnly(manifestPath)
@@ -71,8 +70,8 @@ func TestSupportManifestYmlPath(t *testing.T) {
 // manifest yaml and deployment yaml
 func TestSupportManifestYamlDeployment(t *testing.T) {
 	wskdeploy := common.NewWskdeploy()
-	manifestPath := os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/flagstests/manifest.yaml"
-	deploymentPath := os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/flagstests/deployment.yml"
+	manifestPath := common.ProjectPath + "/tests/src/integration/flagstests/manifest.yaml"
+	deploymentPath := common.ProjectPath + "/tests/src/integration/flagstests/deployment.yml"
 	_, err := wskdeploy.Deploy(manifestPath, deploymentPath)
 	assert.Equal(t, nil, err, "Failed to deploy based on the manifestpath and deploymentpath.")
 	_, err = wskdeploy.Undeploy(manifestPath, deploymentPath)
@@ -82,8 +81,8 @@ func TestSupportManifestYamlDeployment(t *testing.T) {
 // manifest yml and deployment yaml
 func TestSupportManifestYmlDeployment(t *testing.T) {
 	wskdeploy := common.NewWskdeploy()
-	manifestPath := os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/flagstests/manifest.yml"
-	deploymentPath := os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/flagstests/deployment.yml"
+	manifestPath := common.ProjectPath + "/tests/src/integration/flagstests/manifest.yml"
+	deploymentPath := common.ProjectPath + "/tests/src/integration/flagstests/deployment.yml"
 	_, err := wskdeploy.Deploy(manifestPath, deploymentPath)
 	assert.Equal(t, nil, err, "Failed to deploy based on the manifestpath and deploymentpath.")
 	_, err = wskdeploy.Undeploy(manifestPath, deploymentPath)
@@ -22,7 +22,6 @@ package tests
 import (
 	"github.com/apache/openwhisk-wskdeploy/tests/src/integration/common"
 	"github.com/stretchr/testify/assert"
-	"os"
 	"testing"
 )
 
@@ -37,6 +36,6 @@ func TestJarAction(t *testing.T) {
 }
 
 var (
-	manifestPath   = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/jaraction/manifest.yaml"
+	manifestPath   = common.ProjectPath + "/tests/src/integration/jaraction/manifest.yaml"
 	deploymentPath = ""
 )
@@ -17,39 +17,39 @@
 
 package tests
 
-const PATH = "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/managed-deployment/"
+const PATH = "/tests/src/integration/managed-deployment/"
 
 //func TestManagedDeployment(t *testing.T) {
-//	manifestPath := os.Getenv("GOPATH") + PATH + "manifest.yaml"
+//	manifestPath := common.ProjectPath + PATH + "manifest.yaml"
 //	deploymentPath := ""
 //	wskdeploy := common.NewWskdeploy()
 //	_, err := wskdeploy.ManagedDeployment(manifestPath, deploymentPath)
 //	assert.Equal(t, nil, err, "Failed to deploy based on the manifest and deployment files.")
 //
-//	manifestPath = os.Getenv("GOPATH") + PATH + "00-manifest-minus-second-package.yaml"
+//	manifestPath = common.ProjectPath + PATH + "00-manifest-minus-second-package.yaml"
 //	_, err = wskdeploy.ManagedDeployment(manifestPath, deploymentPath)
 //	assert.Equal(t, nil, err, "Failed to deploy based on the manifest and deployment files.")
 //
-//	manifestPath = os.Getenv("GOPATH") + PATH + "01-manifest-minus-sequence-2.yaml"
+//	manifestPath = common.ProjectPath + PATH + "01-manifest-minus-sequence-2.yaml"
 //	_, err = wskdeploy.ManagedDeployment(manifestPath, deploymentPath)
 //	assert.Equal(t, nil, err, "Failed to deploy based on the manifest and deployment files.")
 //
-//	manifestPath = os.Getenv("GOPATH") + PATH + "02-manifest-minus-action-3.yaml"
+//	manifestPath = common.ProjectPath + PATH + "02-manifest-minus-action-3.yaml"
 //	_, err = wskdeploy.ManagedDeployment(manifestPath, deploymentPath)
 //	assert.Equal(t, nil, err, "Failed to deploy based on the manifest and deployment files.")
 //
-//	manifestPath = os.Getenv("GOPATH") + PATH + "03-manifest-minus-trigger.yaml"
+//	manifestPath = common.ProjectPath + PATH + "03-manifest-minus-trigger.yaml"
 //	_, err = wskdeploy.ManagedDeployment(manifestPath, deploymentPath)
 //	assert.Equal(t, nil, err, "Failed to deploy based on the manifest and deployment files.")
 //
-//	manifestPath = os.Getenv("GOPATH") + PATH + "04-manifest-minus-package.yaml"
+//	manifestPath = common.ProjectPath + PATH + "04-manifest-minus-package.yaml"
 //	_, err = wskdeploy.ManagedDeployment(manifestPath, deploymentPath)
 //	assert.Equal(t, nil, err, "Failed to deploy based on the manifest and deployment files.")
 //
 //}
 
 //func TestHeadlessManagedDeployment(t *testing.T) {
-//	manifestPath := os.Getenv("GOPATH") + PATH + "05-manifest-headless.yaml"
+//	manifestPath := common.ProjectPath + PATH + "05-manifest-headless.yaml"
 //	deploymentPath := ""
 //	wskdeploy := common.NewWskdeploy()
 //	_, err := wskdeploy.HeadlessManagedDeployment(manifestPath, deploymentPath, "HeadlessManaged")
@@ -60,7 +60,7 @@ const PATH = "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/m
 //}
 
 //func TestManagedDeploymentWithDependency(t *testing.T) {
-//	manifestPath := os.Getenv("GOPATH") + PATH + "06-manifest-with-single-dependency.yaml"
+//	manifestPath := common.ProjectPath + PATH + "06-manifest-with-single-dependency.yaml"
 //	deploymentPath := ""
 //	wskdeploy := common.NewWskdeploy()
 //	_, err := wskdeploy.ManagedDeployment(manifestPath, deploymentPath)
@@ -70,7 +70,7 @@ const PATH = "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/m
 //}
 
 //func TestManagedDeploymentWithMultipleDependency(t *testing.T) {
-//	manifestPath := os.Getenv("GOPATH") + PATH + "07-manifest-with-dependency.yaml"
+//	manifestPath := common.ProjectPath + PATH + "07-manifest-with-dependency.yaml"
 //	deploymentPath := ""
 //	wskdeploy := common.NewWskdeploy()
 //	_, err := wskdeploy.ManagedDeployment(manifestPath, deploymentPath)
@@ -80,7 +80,7 @@ const PATH = "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/m
 //}
 
 //func TestManagedDeploymentWithWhiskSystem(t *testing.T) {
-//manifestPath := os.Getenv("GOPATH") + PATH + "08-manifest-with-dependencies-on-whisk-system.yaml"
+//manifestPath := common.ProjectPath + PATH + "08-manifest-with-dependencies-on-whisk-system.yaml"
 //deploymentPath := ""
 //wskdeploy := common.NewWskdeploy()
 //_, err := wskdeploy.ManagedDeployment(manifestPath, deploymentPath)
@@ -56,6 +56,6 @@ func TestMessageHub(t *testing.T) {
 }
 
 var (
-	manifestPath   = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/message-hub/manifest.yaml"
-	deploymentPath = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/message-hub/deployment.yaml"
+	manifestPath   = common.ProjectPath + "/tests/src/integration/message-hub/manifest.yaml"
+	deploymentPath = common.ProjectPath + "/tests/src/integration/message-hub/deployment.yaml"
 )
@@ -22,7 +22,6 @@ package tests
 import (
 	"github.com/apache/openwhisk-wskdeploy/tests/src/integration/common"
 	"github.com/stretchr/testify/assert"
-	"os"
 	"testing"
 )
 
@@ -38,6 +37,6 @@ func TestTriggerRule(t *testing.T) {
 }
 
 var (
-	manifestPath   = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/triggerrule/manifest.yml"
-	deploymentPath = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/triggerrule/deployment.yml"
+	manifestPath   = common.ProjectPath + "/tests/src/integration/triggerrule/manifest.yml"
+	deploymentPath = common.ProjectPath + "/tests/src/integration/triggerrule/deployment.yml"
 )
@@ -22,15 +22,14 @@ package tests
 import (
 	"github.com/apache/openwhisk-wskdeploy/tests/src/integration/common"
 	"github.com/stretchr/testify/assert"
-	"os"
 	"testing"
 )
 
-var path = "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/validate-action-annotations/"
+var path = "/tests/src/integration/validate-action-annotations/"
 
 func TestActionAnnotations(t *testing.T) {
-	manifestPath := os.Getenv("GOPATH") + path + "manifest.yaml"
-	deploymentPath := os.Getenv("GOPATH") + path + "deployment.yaml"
+	manifestPath := common.ProjectPath + path + "manifest.yaml"
+	deploymentPath := common.ProjectPath + path + "deployment.yaml"
 	wskdeploy := common.NewWskdeploy()
 	_, err := wskdeploy.Deploy(manifestPath, deploymentPath)
 	assert.Equal(t, nil, err, "Failed to deploy based on the manifest and deployment files.")
@@ -22,16 +22,15 @@ package tests
 import (
 	"github.com/apache/openwhisk-wskdeploy/tests/src/integration/common"
 	"github.com/stretchr/testify/assert"
-	"os"
 	"testing"
 )
 
-var PATH = "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/validate-binding-inputs-annotations/"
+var PATH = "/tests/src/integration/validate-binding-inputs-annotations/"
 
 func TestBindingInputsAnnotations(t *testing.T) {
 
-	manifestPath := os.Getenv("GOPATH") + PATH + "manifest.yaml"
-	deploymentPath := os.Getenv("GOPATH") + PATH + "deployment.yaml"
+	manifestPath := common.ProjectPath + PATH + "manifest.yaml"
+	deploymentPath := common.ProjectPath + PATH + "deployment.yaml"
 
 	wskdeploy := common.NewWskdeploy()
 	// verify the inputs & annotations are set
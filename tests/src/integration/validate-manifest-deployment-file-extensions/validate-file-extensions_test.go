@@ -22,15 +22,14 @@ package tests
 import (
 	"github.com/apache/openwhisk-wskdeploy/tests/src/integration/common"
 	"github.com/stretchr/testify/assert"
-	"os"
 	"testing"
 )
 
-var projectPath = "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/validate-manifest-deployment-file-extensions/"
+var projectPath = "/tests/src/integration/validate-manifest-deployment-file-extensions/"
 
 func TestYAMLExtension(t *testing.T) {
-	manifestPath := os.Getenv("GOPATH") + projectPath + "manifest.yaml"
-	deploymentPath := os.Getenv("GOPATH") + projectPath + "deployment.yaml"
+	manifestPath := common.ProjectPath + projectPath + "manifest.yaml"
+	deploymentPath := common.ProjectPath + projectPath + "deployment.yaml"
 	wskdeploy := common.NewWskdeploy()
 	_, err := wskdeploy.Deploy(manifestPath, deploymentPath)
 	assert.Equal(t, nil, err, "Failed to deploy based on the manifest and deployment files with .yaml extension.")
@@ -39,8 +38,8 @@ func TestYAMLExtension(t *testing.T) {
 }
 
 func TestYMLExtension(t *testing.T) {
-	manifestPath := os.Getenv("GOPATH") + projectPath + "manifest.yml"
-	deploymentPath := os.Getenv("GOPATH") + projectPath + "deployment.yml"
+	manifestPath := common.ProjectPath + projectPath + "manifest.yml"
+	deploymentPath := common.ProjectPath + projectPath + "deployment.yml"
 	wskdeploy := common.NewWskdeploy()
 	_, err := wskdeploy.Deploy(manifestPath, deploymentPath)
 	assert.Equal(t, nil, err, "Failed to deploy based on the manifest and deployment files with .yml extension.")
@@ -49,8 +48,8 @@ func TestYMLExtension(t *testing.T) {
 }
 
 func TestNonStandardFileNames(t *testing.T) {
-	manifestPath := os.Getenv("GOPATH") + projectPath + "not-standard-manifest.yaml"
-	deploymentPath := os.Getenv("GOPATH") + projectPath + "not-standard-deployment.yaml"
+	manifestPath := common.ProjectPath + projectPath + "not-standard-manifest.yaml"
+	deploymentPath := common.ProjectPath + projectPath + "not-standard-deployment.yaml"
 	wskdeploy := common.NewWskdeploy()
 	_, err := wskdeploy.Deploy(manifestPath, deploymentPath)
 	assert.Equal(t, nil, err, "Failed to deploy based on the manifest and deployment files with non standard names.")
@@ -59,8 +58,8 @@ func TestNonStandardFileNames(t *testing.T) {
 }
 
 func TestRandomFileNames(t *testing.T) {
-	manifestPath := os.Getenv("GOPATH") + projectPath + "random-name-1.yaml"
-	deploymentPath := os.Getenv("GOPATH") + projectPath + "random-name-2.yaml"
+	manifestPath := common.ProjectPath + projectPath + "random-name-1.yaml"
+	deploymentPath := common.ProjectPath + projectPath + "random-name-2.yaml"
 	wskdeploy := common.NewWskdeploy()
 	_, err := wskdeploy.Deploy(manifestPath, deploymentPath)
 	assert.Equal(t, nil, err, "Failed to deploy based on the manifest and deployment files with random names.")
@@ -69,8 +68,8 @@ func TestRandomFileNames(t *testing.T) {
 }
 
 func TestYAMLManifestWithYMLDeployment(t *testing.T) {
-	manifestPath := os.Getenv("GOPATH") + projectPath + "yaml-manifest-with-yml-deployment.yaml"
-	deploymentPath := os.Getenv("GOPATH") + projectPath + "yml-deployment-with-yaml-manifest.yml"
+	manifestPath := common.ProjectPath + projectPath + "yaml-manifest-with-yml-deployment.yaml"
+	deploymentPath := common.ProjectPath + projectPath + "yml-deployment-with-yaml-manifest.yml"
 	wskdeploy := common.NewWskdeploy()
 	_, err := wskdeploy.Deploy(manifestPath, deploymentPath)
 	assert.Equal(t, nil, err, "Failed to deploy based on the manifest and deployment files with mix of .yaml and .yml extensions.")
@@ -79,8 +78,8 @@ func TestYAMLManifestWithYMLDeployment(t *testing.T) {
 }
 
 func TestYMLManifestWithYAMLDeployment(t *testing.T) {
-	manifestPath := os.Getenv("GOPATH") + projectPath + "yml-manifest-with-yaml-deployment.yml"
-	deploymentPath := os.Getenv("GOPATH") + projectPath + "yaml-deployment-with-yml-manifest.yaml"
+	manifestPath := common.ProjectPath + projectPath + "yml-manifest-with-yaml-deployment.yml"
+	deploymentPath := common.ProjectPath + projectPath + "yaml-deployment-with-yml-manifest.yaml"
 	wskdeploy := common.NewWskdeploy()
 	_, err := wskdeploy.Deploy(manifestPath, deploymentPath)
 	assert.Equal(t, nil, err, "Failed to deploy based on the manifest and deployment files with .yml manifest and .yaml deployment file.")
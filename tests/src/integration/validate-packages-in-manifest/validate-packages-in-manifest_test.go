@@ -22,7 +22,6 @@ package tests
 import (
 	"github.com/apache/openwhisk-wskdeploy/tests/src/integration/common"
 	"github.com/stretchr/testify/assert"
-	"os"
 	"testing"
 )
 
@@ -35,6 +34,6 @@ func TestPackagesInManifest(t *testing.T) {
 }
 
 var (
-	manifestPath   = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/validate-packages-in-manifest/manifest.yaml"
-	deploymentPath = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/validate-packages-in-manifest/deployment.yaml"
+	manifestPath   = common.ProjectPath + "/tests/src/integration/validate-packages-in-manifest/manifest.yaml"
+	deploymentPath = common.ProjectPath + "/tests/src/integration/validate-packages-in-manifest/deployment.yaml"
 )
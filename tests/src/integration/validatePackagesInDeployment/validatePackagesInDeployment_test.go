@@ -22,7 +22,6 @@ package tests
 import (
 	"github.com/apache/openwhisk-wskdeploy/tests/src/integration/common"
 	"github.com/stretchr/testify/assert"
-	"os"
 	"testing"
 )
 
@@ -37,6 +36,6 @@ func TestPackagesInDeploymentFile(t *testing.T) {
 }
 
 var (
-	manifestPath   = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/validatePackagesInDeployment/manifest.yaml"
-	deploymentPath = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/validatePackagesInDeployment/deployment.yaml"
+	manifestPath   = common.ProjectPath + "/tests/src/integration/validatePackagesInDeployment/manifest.yaml"
+	deploymentPath = common.ProjectPath + "/tests/src/integration/validatePackagesInDeployment/deployment.yaml"
 )
@@ -22,7 +22,6 @@ package tests
 import (
 	"github.com/apache/openwhisk-wskdeploy/tests/src/integration/common"
 	"github.com/stretchr/testify/assert"
-	"os"
 	"testing"
 )
 
@@ -32,10 +31,10 @@ const (
 )
 
 var (
-	manifestStringEmpty = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/webaction/manifest_require_whisk_auth_invalid_str_empty.yaml"
-	manifestStringNil = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/webaction/manifest_require_whisk_auth_invalid_str_nil.yaml"
-	manifestIntTooBig = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/webaction/manifest_require_whisk_auth_invalid_int_big.yaml"
-	manifestIntNegative = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/webaction/manifest_require_whisk_auth_invalid_int_neg.yaml"
+	manifestStringEmpty = common.ProjectPath + "/tests/src/integration/webaction/manifest_require_whisk_auth_invalid_str_empty.yaml"
+	manifestStringNil   = common.ProjectPath + "/tests/src/integration/webaction/manifest_require_whisk_auth_invalid_str_nil.yaml"
+	manifestIntTooBig   = common.ProjectPath + "/tests/src/integration/webaction/manifest_require_whisk_auth_invalid_int_big.yaml"
+	manifestIntNegative = common.ProjectPath + "/tests/src/integration/webaction/manifest_require_whisk_auth_invalid_int_neg.yaml"
 )
 
 func TestRequireWhiskAuthAnnotationInvalid(t *testing.T) {
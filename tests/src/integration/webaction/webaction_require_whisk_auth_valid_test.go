@@ -22,7 +22,6 @@ package tests
 import (
 	"github.com/apache/openwhisk-wskdeploy/tests/src/integration/common"
 	"github.com/stretchr/testify/assert"
-	"os"
 	"testing"
 	"time"
 )
@@ -40,5 +39,5 @@ func TestRequireWhiskAuthAnnotation(t *testing.T) {
 }
 
 var (
-	manifestPathValidTests = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/webaction/manifest_require_whisk_auth_valid.yaml"
+	manifestPathValidTests = common.ProjectPath + "/tests/src/integration/webaction/manifest_require_whisk_auth_valid.yaml"
 )
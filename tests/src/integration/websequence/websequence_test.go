@@ -20,7 +20,6 @@
 package tests
 
 import (
-	"os"
 	"testing"
 
 	"github.com/apache/openwhisk-wskdeploy/tests/src/integration/common"
@@ -38,6 +37,6 @@ func TestWebSequence(t *testing.T) {
 }
 
 var (
-	manifestPath   = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/websequence/manifest.yaml"
+	manifestPath   = common.ProjectPath + "/tests/src/integration/websequence/manifest.yaml"
 	deploymentPath = ""
 )
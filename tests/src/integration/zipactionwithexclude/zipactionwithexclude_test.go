@@ -22,7 +22,6 @@ package tests
 import (
 	"github.com/apache/openwhisk-wskdeploy/tests/src/integration/common"
 	"github.com/stretchr/testify/assert"
-	"os"
 	"testing"
 )
 
@@ -35,6 +34,6 @@ func TestZipActionWithExclude(t *testing.T) {
 }
 
 var (
-	manifestPath   = os.Getenv("GOPATH") + "/src/github.com/apache/openwhisk-wskdeploy/tests/src/integration/zipactionwithexclude/manifest.yml"
+	manifestPath   = common.ProjectPath + "/tests/src/integration/zipactionwithexclude/manifest.yml"
 	deploymentPath = ""
 )
@@ -20,6 +20,7 @@ package utils
 import (
 	"fmt"
 	"reflect"
+	"time"
 )
 
 type WskDeployFlags struct {
@@ -42,12 +43,29 @@ type WskDeployFlags struct {
 	ProjectName      string // Project name
 	ApigwAccessToken string
 	//ApigwTenantId    string // APIGW_TENANT_ID (IAM namespace resource identifier); not avail. as CLI flag yet
-	Verbose   bool
-	Trace     bool
-	Sync      bool
-	Report    bool
-	Param     []string
-	ParamFile string
+	Verbose            bool
+	Trace              bool
+	Sync               bool
+	Report             bool
+	Plan               bool
+	Parallelism        int            // maximum number of entities deployed concurrently
+	NoRollback         bool           // keep the entities deployed before a failure
+	Offline            bool           // do not access the network, set by the validate command
+	Env                string         // deployment environment, selects deployment.<env>.yaml
+	DeploymentOverlays []string       // deployment files merged over DeploymentPath, in order
+	SecretsDir         string         // directory of the dir secret provider, one file per secret
+	SecretsFile        string         // encrypted secrets file of the age and gpg secret providers
+	SecretsIdentity    string         // age identity file decrypting SecretsFile
+	Output             string         // format of the documents printed by deploy, undeploy, preview and report: json or yaml
+	Only               []string       // entity selectors restricting deploy and undeploy, e.g. action:pkg/name
+	Exclude            []string       // entity selectors never deployed nor undeployed
+	RetryAttempts      *int           // attempts of a server call failing with a transient error, nil if not set
+	RetryDelay         *time.Duration // delay before the first retry, nil if not set
+	RetryMaxDelay      *time.Duration // maximum delay between two attempts, nil if not set
+	FrozenLockfile     bool           // fail instead of updating wskdeploy.lock
+	CacheDir           string         // cache of the downloaded dependencies, see dependencies.DefaultCacheDir
+	Param              []string
+	ParamFile          string
 }
 
 // TODO turn this into a generic utility for formatting any struct
@@ -24,6 +24,8 @@ import (
 	"os"
 	"os/user"
 	"path"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/apache/openwhisk-client-go/whisk"
@@ -38,15 +40,27 @@ const (
 	ManifestFileNameYml    = "manifest.yml"
 	DeploymentFileNameYaml = "deployment.yaml"
 	DeploymentFileNameYml  = "deployment.yml"
+	// name of the deployment files of an environment, e.g. deployment.prod.yaml
+	DeploymentEnvFileNameYaml = "deployment.%s.yaml"
+	DeploymentEnvFileNameYml  = "deployment.%s.yml"
 )
 
 // ActionRecord is a container to keep track of
 // a whisk action struct and a location filepath we use to
 // map files and manifest declared actions
 type ActionRecord struct {
-	Action      *whisk.Action
-	Packagename string
-	Filepath    string
+	Action      *whisk.Action `json:"action"`
+	Packagename string        `json:"packageName"`
+	Filepath    string        `json:"filepath"`
+}
+
+// FeedRecord is a feed declared by a package, implemented by actions of the package
+type FeedRecord struct {
+	Name        string                 `json:"name"`
+	Packagename string                 `json:"packageName"`
+	Action      string                 `json:"action"`           // qualified name of the feed action, e.g. pkg/changes
+	Operations  map[string]string      `json:"operations"`       // qualified name of the action handling each lifecycle event, e.g. CREATE
+	Inputs      map[string]interface{} `json:"inputs,omitempty"` // passed to the actions on every lifecycle event
 }
 
 type TriggerRecord struct {
@@ -198,3 +212,13 @@ func GetActionFromActionRecords(records []ActionRecord, packageName string, acti
 	}
 	return nil
 }
+
+// SortedKeys returns the keys of a map with string keys in sorted order
+func SortedKeys(m interface{}) []string {
+	keys := make([]string, 0)
+	for _, key := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, key.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
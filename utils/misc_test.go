@@ -65,3 +65,8 @@ func TestNewZipWriter(t *testing.T) {
 	defer os.Remove(zipName)
 	assert.Equal(t, nil, err, "zip folder error happened.")
 }
+
+func TestSortedKeys(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "c"}, SortedKeys(map[string]int{"c": 3, "a": 1, "b": 2}))
+	assert.Equal(t, []string{}, SortedKeys(map[string]bool{}))
+}
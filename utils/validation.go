@@ -63,7 +63,8 @@ var license_json = LicenseJSON{}
 //Then check remote json data
 func CheckLicense(license string) bool {
 	// TODO(#673) Strict flag should cause an error to be generated
-	if !LicenseLocalValidation(license) && !LicenseRemoteValidation(license) {
+	// without network access only the local license records can be checked
+	if !LicenseLocalValidation(license) && !Flags.Offline && !LicenseRemoteValidation(license) {
 		warningString := wski18n.T(
 			wski18n.ID_WARN_KEYVALUE_INVALID,
 			map[string]interface{}{
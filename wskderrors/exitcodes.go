@@ -0,0 +1,161 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wskderrors
+
+import (
+	"strings"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+)
+
+// Process exit codes returned by wskdeploy. Codes are stable and grouped by the
+// origin of the failure so that callers can tell them apart:
+//
+//	10-29 the manifest or deployment files (or values they reference)
+//	30-39 the local wskdeploy or OpenWhisk client configuration
+//	40-49 the OpenWhisk server
+//
+// These values are part of the documented CLI contract (docs/exit_codes.md);
+// never renumber an existing code.
+const (
+	EXIT_CODE_SUCCESS                     = 0
+	EXIT_CODE_UNKNOWN_ERROR               = 1
+	EXIT_CODE_COMMAND_FAILED              = 2
+	EXIT_CODE_FILE_READ_ERROR             = 10
+	EXIT_CODE_MANIFEST_FILE_NOT_FOUND     = 11
+	EXIT_CODE_YAML_FILE_FORMAT_ERROR      = 12
+	EXIT_CODE_YAML_PARSER_ERROR           = 13
+	EXIT_CODE_YAML_SCHEMA_VIOLATION       = 14
+	EXIT_CODE_PARAMETER_TYPE_MISMATCH     = 15
+	EXIT_CODE_INVALID_PARAMETER_TYPE      = 16
+	EXIT_CODE_INVALID_RUNTIME             = 17
+	EXIT_CODE_INVALID_WEB_EXPORT          = 18
+	EXIT_CODE_INVALID_API                 = 19
+	EXIT_CODE_INVALID_API_GATEWAY_METHOD  = 20
+	EXIT_CODE_ACTION_ANNOTATION           = 21
+	EXIT_CODE_VALIDATION_FAILED           = 22
+	EXIT_CODE_SECRET_RESOLUTION_FAILED    = 23
+	EXIT_CODE_DEPENDENCY_FAILED           = 24
+	EXIT_CODE_PARAMETER_SCHEMA_VIOLATION  = 25
+	EXIT_CODE_WHISK_CLIENT_INVALID_CONFIG = 30
+	EXIT_CODE_RUNTIME_PARSER_FAILURE      = 31
+	EXIT_CODE_WHISK_CLIENT_ERROR          = 40
+)
+
+var exitCodes = map[string]int{
+	ERROR_COMMAND_FAILED:                  EXIT_CODE_COMMAND_FAILED,
+	ERROR_FILE_READ_ERROR:                 EXIT_CODE_FILE_READ_ERROR,
+	ERROR_MANIFEST_FILE_NOT_FOUND:         EXIT_CODE_MANIFEST_FILE_NOT_FOUND,
+	ERROR_YAML_FILE_FORMAT_ERROR:          EXIT_CODE_YAML_FILE_FORMAT_ERROR,
+	ERROR_YAML_PARSER_ERROR:               EXIT_CODE_YAML_PARSER_ERROR,
+	ERROR_YAML_SCHEMA_VIOLATION:           EXIT_CODE_YAML_SCHEMA_VIOLATION,
+	ERROR_YAML_PARAMETER_TYPE_MISMATCH:    EXIT_CODE_PARAMETER_TYPE_MISMATCH,
+	ERROR_YAML_INVALID_PARAMETER_TYPE:     EXIT_CODE_INVALID_PARAMETER_TYPE,
+	ERROR_YAML_INVALID_RUNTIME:            EXIT_CODE_INVALID_RUNTIME,
+	ERROR_YAML_INVALID_WEB_EXPORT:         EXIT_CODE_INVALID_WEB_EXPORT,
+	ERROR_YAML_INVALID_API:                EXIT_CODE_INVALID_API,
+	ERROR_YAML_INVALID_API_GATEWAY_METHOD: EXIT_CODE_INVALID_API_GATEWAY_METHOD,
+	ERROR_ACTION_ANNOTATION:               EXIT_CODE_ACTION_ANNOTATION,
+	ERROR_VALIDATION_FAILED:               EXIT_CODE_VALIDATION_FAILED,
+	ERROR_SECRET_RESOLUTION_FAILED:        EXIT_CODE_SECRET_RESOLUTION_FAILED,
+	ERROR_DEPENDENCY_FAILED:               EXIT_CODE_DEPENDENCY_FAILED,
+	ERROR_PARAMETER_SCHEMA_VIOLATION:      EXIT_CODE_PARAMETER_SCHEMA_VIOLATION,
+	ERROR_WHISK_CLIENT_INVALID_CONFIG:     EXIT_CODE_WHISK_CLIENT_INVALID_CONFIG,
+	ERROR_RUNTIME_PARSER_FAILURE:          EXIT_CODE_RUNTIME_PARSER_FAILURE,
+	ERROR_WHISK_CLIENT_ERROR:              EXIT_CODE_WHISK_CLIENT_ERROR,
+}
+
+// GetErrorType returns the error type of a wskdeploy error, e.g. ERROR_YAML_INVALID_RUNTIME,
+// ERROR_WHISK_CLIENT_ERROR for errors returned directly by the OpenWhisk client,
+// and an empty string for other errors
+func GetErrorType(err error) string {
+	switch e := err.(type) {
+	case interface{ GetErrorType() string }:
+		return e.GetErrorType()
+	case *whisk.WskError:
+		return ERROR_WHISK_CLIENT_ERROR
+	}
+	return ""
+}
+
+// ExitCode returns the process exit code for err: EXIT_CODE_SUCCESS for nil,
+// the code mapped to its error type for wskdeploy errors and
+// EXIT_CODE_UNKNOWN_ERROR for anything else
+func ExitCode(err error) int {
+	if err == nil {
+		return EXIT_CODE_SUCCESS
+	}
+	if code, ok := exitCodes[GetErrorType(err)]; ok {
+		return code
+	}
+	return EXIT_CODE_UNKNOWN_ERROR
+}
+
+// SetEntity records the entity (e.g. action:hello/greet) that was being deployed
+// when err occurred, unless a more specific entity was already recorded
+func SetEntity(err error, entity string) {
+	if e, ok := err.(interface {
+		GetEntity() string
+		SetEntity(string)
+	}); ok && len(e.GetEntity()) == 0 {
+		e.SetEntity(entity)
+	}
+}
+
+// ErrorDocument is the serialized form of an error used for structured (--output) results
+type ErrorDocument struct {
+	Type       string `json:"type"`
+	Code       int    `json:"code"`
+	Message    string `json:"message"`
+	File       string `json:"file,omitempty"`
+	Line       int    `json:"line,omitempty"`
+	Entity     string `json:"entity,omitempty"`
+	HTTPStatus int    `json:"httpStatus,omitempty"`
+	HTTPBody   string `json:"httpBody,omitempty"`
+}
+
+// NewErrorDocument returns the serialized form of err, or nil if err is nil
+func NewErrorDocument(err error) *ErrorDocument {
+	if err == nil {
+		return nil
+	}
+	doc := &ErrorDocument{
+		Type:    GetErrorType(err),
+		Code:    ExitCode(err),
+		Message: strings.TrimSpace(err.Error()),
+	}
+	if e, ok := err.(interface{ GetMessage() string }); ok {
+		doc.Message = strings.TrimSpace(e.GetMessage())
+	}
+	if e, ok := err.(interface{ GetEntity() string }); ok {
+		doc.Entity = e.GetEntity()
+	}
+	if e, ok := err.(interface{ GetErrorFilePath() string }); ok {
+		doc.File = e.GetErrorFilePath()
+	}
+	switch e := err.(type) {
+	case *SchemaValidationError:
+		if len(e.Violations) > 0 {
+			doc.Line = e.Violations[0].Line
+		}
+	case *WhiskClientError:
+		doc.HTTPStatus = e.HTTPStatus
+		doc.HTTPBody = e.HTTPBody
+	}
+	return doc
+}
@@ -0,0 +1,86 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package wskderrors
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExitCode(t *testing.T) {
+	assert.Equal(t, EXIT_CODE_SUCCESS, ExitCode(nil))
+	assert.Equal(t, EXIT_CODE_UNKNOWN_ERROR, ExitCode(errors.New("unexpected")))
+	assert.Equal(t, EXIT_CODE_COMMAND_FAILED, ExitCode(NewCommandError("deploy", "failed")))
+	assert.Equal(t, EXIT_CODE_YAML_FILE_FORMAT_ERROR, ExitCode(NewYAMLFileFormatError("manifest.yaml", "invalid")))
+	assert.Equal(t, EXIT_CODE_WHISK_CLIENT_INVALID_CONFIG, ExitCode(NewWhiskClientInvalidConfigError("no auth")))
+	assert.Equal(t, EXIT_CODE_WHISK_CLIENT_ERROR, ExitCode(NewWhiskClientError("failed", 1, nil)))
+	assert.Equal(t, EXIT_CODE_WHISK_CLIENT_ERROR, ExitCode(whisk.MakeWskError(errors.New("timeout"), 1)),
+		"Errors of the OpenWhisk client are server errors.")
+}
+
+func TestExitCode_Distinct(t *testing.T) {
+	codes := make(map[int]string)
+	for errorType, code := range exitCodes {
+		assert.NotContains(t, codes, code, "%s and %s share an exit code.", errorType, codes[code])
+		codes[code] = errorType
+		assert.NotEqual(t, EXIT_CODE_SUCCESS, code)
+		assert.NotEqual(t, EXIT_CODE_UNKNOWN_ERROR, code)
+	}
+}
+
+func TestNewErrorDocument(t *testing.T) {
+	assert.Nil(t, NewErrorDocument(nil))
+
+	err := NewSchemaValidationError("/tmp/project/manifest.yaml", []SchemaViolation{
+		{Line: 7, Column: 5, Path: "packages.p.actions.a", Message: "unknown key"},
+	})
+	SetEntity(err, "action:p/a")
+	SetEntity(err, "package:p")
+	doc := NewErrorDocument(err)
+	assert.Equal(t, ERROR_YAML_SCHEMA_VIOLATION, doc.Type)
+	assert.Equal(t, EXIT_CODE_YAML_SCHEMA_VIOLATION, doc.Code)
+	assert.Equal(t, "/tmp/project/manifest.yaml", doc.File)
+	assert.Equal(t, 7, doc.Line)
+	assert.Equal(t, "action:p/a", doc.Entity, "The first entity recorded is kept.")
+	assert.Zero(t, doc.HTTPStatus)
+
+	response := &http.Response{
+		StatusCode: http.StatusBadGateway,
+		Status:     "502 Bad Gateway",
+		Body:       ioutil.NopCloser(strings.NewReader("upstream unavailable")),
+	}
+	doc = NewErrorDocument(NewWhiskClientError("failed", 1, response))
+	assert.Equal(t, ERROR_WHISK_CLIENT_ERROR, doc.Type)
+	assert.Equal(t, http.StatusBadGateway, doc.HTTPStatus)
+	assert.Equal(t, "upstream unavailable", doc.HTTPBody)
+	assert.Empty(t, doc.File)
+
+	doc = NewErrorDocument(errors.New("unexpected\n"))
+	assert.Equal(t, "", doc.Type)
+	assert.Equal(t, EXIT_CODE_UNKNOWN_ERROR, doc.Code)
+	assert.Equal(t, "unexpected", doc.Message)
+}
@@ -61,6 +61,7 @@ const (
 	ERROR_MANIFEST_FILE_NOT_FOUND         = "ERROR_MANIFEST_FILE_NOT_FOUND"
 	ERROR_YAML_FILE_FORMAT_ERROR          = "ERROR_YAML_FILE_FORMAT_ERROR"
 	ERROR_YAML_PARSER_ERROR               = "ERROR_YAML_PARSER_ERROR"
+	ERROR_YAML_SCHEMA_VIOLATION           = "ERROR_YAML_SCHEMA_VIOLATION"
 	ERROR_YAML_PARAMETER_TYPE_MISMATCH    = "ERROR_YAML_PARAMETER_TYPE_MISMATCH"
 	ERROR_YAML_INVALID_PARAMETER_TYPE     = "ERROR_YAML_INVALID_PARAMETER_TYPE"
 	ERROR_YAML_INVALID_RUNTIME            = "ERROR_YAML_INVALID_RUNTIME"
@@ -69,6 +70,10 @@ const (
 	ERROR_YAML_INVALID_API_GATEWAY_METHOD = "ERROR_YAML_INVALID_API_GATEWAY_METHOD"
 	ERROR_RUNTIME_PARSER_FAILURE          = "ERROR_RUNTIME_PARSER_FAILURE"
 	ERROR_ACTION_ANNOTATION               = "ERROR_ACTION_ANNOTATION"
+	ERROR_VALIDATION_FAILED               = "ERROR_VALIDATION_FAILED"
+	ERROR_SECRET_RESOLUTION_FAILED        = "ERROR_SECRET_RESOLUTION_FAILED"
+	ERROR_DEPENDENCY_FAILED               = "ERROR_DEPENDENCY_FAILED"
+	ERROR_PARAMETER_SCHEMA_VIOLATION      = "ERROR_PARAMETER_SCHEMA_VIOLATION"
 )
 
 /*
@@ -80,6 +85,7 @@ type WskDeployBaseErr struct {
 	LineNum       int
 	Message       string
 	MessageFormat string
+	Entity        string // entity being deployed when the error occurred, e.g. action:hello/greet
 }
 
 func NewWskDeployBaseError(typ string, fn string, ln int, msg string) *WskDeployBaseErr {
@@ -116,6 +122,18 @@ func (e *WskDeployBaseErr) GetMessage() string {
 	return e.Message
 }
 
+func (e *WskDeployBaseErr) GetErrorType() string {
+	return e.ErrorType
+}
+
+func (e *WskDeployBaseErr) SetEntity(entity string) {
+	e.Entity = entity
+}
+
+func (e *WskDeployBaseErr) GetEntity() string {
+	return e.Entity
+}
+
 func (e *WskDeployBaseErr) GetMessageFormat() string {
 	return e.MessageFormat
 }
@@ -186,7 +204,9 @@ func NewCommandError(cmd string, errorMessage string) *CommandError {
  */
 type WhiskClientError struct {
 	WskDeployBaseErr
-	ErrorCode int
+	ErrorCode  int
+	HTTPStatus int
+	HTTPBody   string
 }
 
 func NewWhiskClientError(errorMessage string, code int, response *http.Response) *WhiskClientError {
@@ -203,9 +223,11 @@ func NewWhiskClientError(errorMessage string, code int, response *http.Response)
 		// do not add body in case of a success
 		// when response.Status is 200, response.Body contains the entire action source code
 		// we should not expose the action source when the HTTP request was successful
+		err.HTTPStatus = response.StatusCode
 		if strings.Contains(response.Status, "200 OK") {
 			str = fmt.Sprintf(err.MessageFormat, STR_ERROR_CODE, code, errorMessage, STR_HTTP_STATUS, response.Status, "", "")
 		} else {
+			err.HTTPBody = string(responseData)
 			str = fmt.Sprintf(err.MessageFormat, STR_ERROR_CODE, code, errorMessage, STR_HTTP_STATUS, response.Status, STR_HTTP_BODY, string(responseData))
 		}
 	}
@@ -246,6 +268,10 @@ func (e *FileError) SetErrorFileName(fname string) {
 	e.ErrorFilePath = fname
 }
 
+func (e *FileError) GetErrorFilePath() string {
+	return e.ErrorFilePath
+}
+
 func (e *FileError) Error() string {
 	return fmt.Sprintf("%s [%d]: [%s]: "+STR_FILE+": [%s]: %s\n",
 		e.FileName,
@@ -375,6 +401,78 @@ func NewYAMLParserErr(fpath string, msg interface{}) *YAMLParserError {
 	return err
 }
 
+/*
+ * SchemaValidationError
+ */
+type SchemaViolation struct {
+	Line       int
+	Column     int
+	Path       string
+	Message    string
+	Suggestion string
+}
+
+type SchemaValidationError struct {
+	FileError
+	Violations []SchemaViolation
+}
+
+func NewSchemaValidationError(fpath string, violations []SchemaViolation) *SchemaValidationError {
+	var err = &SchemaValidationError{
+		Violations: violations,
+	}
+	err.SetErrorType(ERROR_YAML_SCHEMA_VIOLATION)
+	err.SetCallerByStackFrameSkip(2)
+	err.SetErrorFilePath(fpath)
+	err.SetMessage(wski18n.T(wski18n.ID_ERR_SCHEMA_VIOLATIONS_X_count_X,
+		map[string]interface{}{wski18n.KEY_COUNT: len(violations)}))
+	for _, v := range violations {
+		detail := fmt.Sprintf("%s:%d:%d: %s: %s", fpath, v.Line, v.Column, v.Path, v.Message)
+		if len(v.Suggestion) > 0 {
+			detail += " " + wski18n.T(wski18n.ID_ERR_SCHEMA_SUGGESTION_X_suggestion_X,
+				map[string]interface{}{wski18n.KEY_SUGGESTION: v.Suggestion})
+		}
+		err.AppendDetail(detail)
+	}
+	return err
+}
+
+/*
+ * ParameterSchemaError
+ */
+type ParameterSchemaError struct {
+	FileError
+	Parameter  string
+	Violations []SchemaViolation
+}
+
+// NewParameterSchemaError reports the violations of the schema of a parameter of a package,
+// action or trigger, the path of each violation is the JSON pointer of the offending value
+func NewParameterSchemaError(fpath string, key string, entity string, param string, violations []SchemaViolation) *ParameterSchemaError {
+	var err = &ParameterSchemaError{
+		Parameter:  param,
+		Violations: violations,
+	}
+	err.SetErrorType(ERROR_PARAMETER_SCHEMA_VIOLATION)
+	err.SetCallerByStackFrameSkip(2)
+	err.SetErrorFilePath(fpath)
+	err.SetMessage(wski18n.T(wski18n.ID_ERR_PARAMETER_SCHEMA_VIOLATIONS_X_name_X_key_X_entity_X_count_X,
+		map[string]interface{}{
+			wski18n.KEY_NAME:   param,
+			wski18n.KEY_KEY:    key,
+			wski18n.KEY_ENTITY: entity,
+			wski18n.KEY_COUNT:  len(violations)}))
+	for _, v := range violations {
+		detail := fmt.Sprintf("[%s]: %s", v.Path, v.Message)
+		if len(v.Suggestion) > 0 {
+			detail += " " + wski18n.T(wski18n.ID_ERR_SCHEMA_SUGGESTION_X_suggestion_X,
+				map[string]interface{}{wski18n.KEY_SUGGESTION: v.Suggestion})
+		}
+		err.AppendDetail(detail)
+	}
+	return err
+}
+
 /*
  * InvalidRuntime
  */
@@ -506,6 +604,75 @@ func NewActionSecureKeyError(errorMsg string) *DeployError {
 	return err
 }
 
+/*
+ * ValidationError
+ */
+type ValidationError struct {
+	WskDeployBaseErr
+	Problems []error
+}
+
+func NewValidationError(problems []error) *ValidationError {
+	var err = &ValidationError{
+		Problems: problems,
+	}
+	err.SetErrorType(ERROR_VALIDATION_FAILED)
+	err.SetCallerByStackFrameSkip(2)
+	err.SetMessage(wski18n.T(wski18n.ID_ERR_VALIDATION_FAILED_X_count_X,
+		map[string]interface{}{wski18n.KEY_COUNT: len(problems)}))
+	for _, problem := range problems {
+		// the details of a problem are indented below it
+		lines := strings.Split(strings.TrimSpace(problem.Error()), STR_NEWLINE)
+		err.AppendDetail(lines[0])
+		for _, line := range lines[1:] {
+			err.Message += STR_NEWLINE + "    " + strings.TrimSpace(line)
+		}
+	}
+	return err
+}
+
+/*
+ * SecretError
+ */
+type SecretError struct {
+	WskDeployBaseErr
+	Reference string
+}
+
+func NewSecretError(reference string, errMessage string) *SecretError {
+	var err = &SecretError{
+		Reference: reference,
+	}
+	err.SetErrorType(ERROR_SECRET_RESOLUTION_FAILED)
+	err.SetCallerByStackFrameSkip(2)
+	err.SetMessage(wski18n.T(wski18n.ID_ERR_SECRET_X_secret_X_err_X,
+		map[string]interface{}{
+			wski18n.KEY_SECRET: reference,
+			wski18n.KEY_ERR:    errMessage}))
+	return err
+}
+
+/*
+ * DependencyError
+ */
+type DependencyError struct {
+	WskDeployBaseErr
+	Dependency string
+}
+
+func NewDependencyError(dependency string, errMessage string) *DependencyError {
+	var err = &DependencyError{
+		Dependency: dependency,
+	}
+	err.SetErrorType(ERROR_DEPENDENCY_FAILED)
+	err.SetCallerByStackFrameSkip(2)
+	err.SetMessage(wski18n.T(wski18n.ID_ERR_DEPENDENCY_X_dependency_X_err_X,
+		map[string]interface{}{
+			wski18n.KEY_DEPENDENCY: dependency,
+			wski18n.KEY_ERR:        errMessage}))
+	return err
+}
+
 func IsCustomError(err error) bool {
 
 	switch err.(type) {
@@ -32,6 +32,10 @@ const (
 	BINDING            = "binding"
 	CLI_FLAGS          = "CLI Flags"
 	CMD_DEPLOY         = "deploy"
+	CMD_DEPS           = "deps"
+	CMD_REPORT         = "report"
+	CMD_STATUS         = "status"
+	CMD_VALIDATE       = "validate"
 	CMD_UNDEPLOY       = "undeploy"
 	COMMAND_LINE       = "command line"
 	CONFIGURATION      = "Configuration"
@@ -52,48 +56,81 @@ const (
 // Known keys used for text replacement in i18n translated strings
 const (
 	KEY_ACTION            = "action"
+	KEY_ACTUAL            = "actual"
 	KEY_API               = "api"
 	KEY_API_BASE_PATH     = "apibasepath"
 	KEY_API_RELATIVE_PATH = "apirelativepath"
 	KEY_ARG               = "arg"
+	KEY_ATTEMPT           = "attempt"
+	KEY_ATTEMPTS          = "attempts"
 	KEY_BINDINGS          = "bindings"
 	KEY_CMD               = "cmd"
 	KEY_CODE              = "code"
+	KEY_COMMIT            = "commit"
+	KEY_COUNT             = "count"
+	KEY_CREATE            = "create"
+	KEY_DELAY             = "delay"
+	KEY_DELETED           = "deleted"
 	KEY_DEPENDENCY        = "dependency"
 	KEY_DEPLOYMENT_NAME   = "dname"
 	KEY_DEPLOYMENT_PATH   = "dpath"
 	KEY_DESTINATION       = "destination"
 	KEY_DUMMY_TOKEN       = "dummytoken"
+	KEY_ENTITIES          = "entities"
+	KEY_ENTITY            = "entity"
 	KEY_ERR               = "err"
+	KEY_EXPECTED          = "expected"
 	KEY_EXTENSION         = "ext"
 	KEY_FILE_TYPE         = "filetype"
+	KEY_FLAG              = "flag"
+	KEY_FORMAT            = "format"
+	KEY_FORMATS           = "formats"
 	KEY_HOST              = "host"
 	KEY_INCLUDE           = "include"
+	KEY_IN_SYNC           = "insync"
 	KEY_INPUTS            = "inputs"
 	KEY_KEY               = "key"
+	KEY_KINDS             = "kinds"
 	KEY_LIMIT             = "limit"
 	KEY_LOCATION          = "location"
 	KEY_MANIFEST_NAME     = "mname"
 	KEY_MANIFEST_PATH     = "mpath"
+	KEY_MODIFIED          = "modified"
 	KEY_NAME              = "name"
 	KEY_NAMESPACE         = "namespace"
 	KEY_NEW               = "newkey"
 	KEY_OLD               = "oldkey"
+	KEY_ORPHANED          = "orphaned"
 	KEY_PACKAGE           = "package"
 	KEY_PATH              = "path"
+	KEY_ENV               = "env"
+	KEY_IMPORT            = "import"
 	KEY_PROJECT           = "project"
+	KEY_PROVIDER          = "provider"
+	KEY_PROVIDERS         = "providers"
 	KEY_RESPONSE          = "response"
 	KEY_RULE              = "rule"
 	KEY_RUNTIME           = "runtime"
+	KEY_SECRET            = "secret"
+	KEY_SELECTOR          = "selector"
 	KEY_SEQUENCE          = "sequence"
 	KEY_SOURCE            = "source"
+	KEY_STATUS            = "status"
+	KEY_SUGGESTION        = "suggestion"
+	KEY_TIME              = "time"
 	KEY_TRIGGER           = "trigger"
 	KEY_TRIGGER_FEED      = "feed"
+	KEY_OPERATION         = "operation"
+	KEY_OPERATIONS        = "operations"
+	KEY_UNCHANGED         = "unchanged"
+	KEY_UPDATE            = "update"
 	KEY_URL               = "url"
 	KEY_UUID              = "uuid"
 	KEY_VALUE             = "value"
 	KEY_VALUE_MAX         = "max" // TODO() attempt to use this for Limit value range errors
 	KEY_VALUE_MIN         = "min" // TODO() attempt to use this for Limit value range errors
+	KEY_VERSION           = "version"
+	KEY_TAG               = "tag"
 )
 
 // DO NOT TRANSLATE
@@ -106,38 +143,66 @@ const (
 	ID_MSG_PREFIX_WARNING = "msg_prefix_warning" // "Warning"
 
 	// Cobra command descriptions
-	ID_CMD_DESC_LONG_REPORT    = "msg_cmd_desc_long_report"
-	ID_CMD_DESC_LONG_ROOT      = "msg_cmd_desc_long_root"
-	ID_CMD_DESC_LONG_SYNC      = "msg_cmd_desc_long_sync"
-	ID_CMD_DESC_LONG_UNDEPLOY  = "msg_cmd_desc_long_undeploy"
-	ID_CMD_DESC_LONG_EXPORT    = "msg_cmd_desc_long_export"
-	ID_CMD_DESC_SHORT_REPORT   = "msg_cmd_desc_short_report"
-	ID_CMD_DESC_SHORT_ROOT     = "msg_cmd_desc_short_root"
-	ID_CMD_DESC_SHORT_VERSION  = "msg_cmd_desc_short_version"
-	ID_CMD_DESC_SHORT_SYNC     = "msg_cmd_desc_short_sync"
-	ID_CMD_DESC_SHORT_UNDEPLOY = "msg_cmd_desc_short_undeploy"
-	ID_CMD_DESC_SHORT_EXPORT   = "msg_cmd_desc_short_export"
+	ID_CMD_DESC_LONG_REPORT       = "msg_cmd_desc_long_report"
+	ID_CMD_DESC_LONG_ROOT         = "msg_cmd_desc_long_root"
+	ID_CMD_DESC_LONG_SYNC         = "msg_cmd_desc_long_sync"
+	ID_CMD_DESC_LONG_UNDEPLOY     = "msg_cmd_desc_long_undeploy"
+	ID_CMD_DESC_LONG_EXPORT       = "msg_cmd_desc_long_export"
+	ID_CMD_DESC_LONG_PLAN         = "msg_cmd_desc_long_plan"
+	ID_CMD_DESC_LONG_STATUS       = "msg_cmd_desc_long_status"
+	ID_CMD_DESC_LONG_VALIDATE     = "msg_cmd_desc_long_validate"
+	ID_CMD_DESC_LONG_DEPS         = "msg_cmd_desc_long_deps"
+	ID_CMD_DESC_LONG_DEPS_UPDATE  = "msg_cmd_desc_long_deps_update"
+	ID_CMD_DESC_LONG_DEPS_TREE    = "msg_cmd_desc_long_deps_tree"
+	ID_CMD_DESC_LONG_DEPS_VENDOR  = "msg_cmd_desc_long_deps_vendor"
+	ID_CMD_DESC_SHORT_REPORT      = "msg_cmd_desc_short_report"
+	ID_CMD_DESC_SHORT_ROOT        = "msg_cmd_desc_short_root"
+	ID_CMD_DESC_SHORT_VERSION     = "msg_cmd_desc_short_version"
+	ID_CMD_DESC_SHORT_SYNC        = "msg_cmd_desc_short_sync"
+	ID_CMD_DESC_SHORT_UNDEPLOY    = "msg_cmd_desc_short_undeploy"
+	ID_CMD_DESC_SHORT_EXPORT      = "msg_cmd_desc_short_export"
+	ID_CMD_DESC_SHORT_PLAN        = "msg_cmd_desc_short_plan"
+	ID_CMD_DESC_SHORT_STATUS      = "msg_cmd_desc_short_status"
+	ID_CMD_DESC_SHORT_VALIDATE    = "msg_cmd_desc_short_validate"
+	ID_CMD_DESC_SHORT_DEPS        = "msg_cmd_desc_short_deps"
+	ID_CMD_DESC_SHORT_DEPS_UPDATE = "msg_cmd_desc_short_deps_update"
+	ID_CMD_DESC_SHORT_DEPS_TREE   = "msg_cmd_desc_short_deps_tree"
+	ID_CMD_DESC_SHORT_DEPS_VENDOR = "msg_cmd_desc_short_deps_vendor"
 
 	// Cobra Flag messages
-	ID_CMD_FLAG_API_HOST    = "msg_cmd_flag_api_host"
-	ID_CMD_FLAG_API_VERSION = "msg_cmd_flag_api_version"
-	ID_CMD_FLAG_AUTH_KEY    = "msg_cmd_flag_auth_key"
-	ID_CMD_FLAG_CERT_FILE   = "msg_cmd_flag_cert_file"
-	ID_CMD_FLAG_CONFIG      = "msg_cmd_flag_config"
-	ID_CMD_FLAG_DEFAULTS    = "msg_cmd_flag_allow_defaults"
-	ID_CMD_FLAG_DEPLOYMENT  = "msg_cmd_flag_deployment"
-	ID_CMD_FLAG_PREVIEW     = "msg_cmd_flag_preview"
-	ID_CMD_FLAG_KEY_FILE    = "msg_cmd_flag_key_file"
-	ID_CMD_FLAG_MANAGED     = "msg_cmd_flag_allow_managed"
-	ID_CMD_FLAG_PROJECTNAME = "msg_cmd_flag_project_name"
-	ID_CMD_FLAG_MANIFEST    = "msg_cmd_flag_manifest"
-	ID_CMD_FLAG_NAMESPACE   = "msg_cmd_flag_namespace"
-	ID_CMD_FLAG_PROJECT     = "msg_cmd_flag_project"
-	ID_CMD_FLAG_STRICT      = "msg_cmd_flag_strict"
-	ID_CMD_FLAG_TRACE       = "msg_cmd_flag_trace"
-	ID_CMD_FLAG_VERBOSE     = "msg_cmd_flag_allow_verbose"
-	ID_CMD_FLAG_PARAM       = "msg_cmd_flag_allow_param"
-	ID_CMD_FLAG_PARAM_FILE  = "msg_cmd_flag_allow_param_file"
+	ID_CMD_FLAG_API_HOST         = "msg_cmd_flag_api_host"
+	ID_CMD_FLAG_API_VERSION      = "msg_cmd_flag_api_version"
+	ID_CMD_FLAG_AUTH_KEY         = "msg_cmd_flag_auth_key"
+	ID_CMD_FLAG_CERT_FILE        = "msg_cmd_flag_cert_file"
+	ID_CMD_FLAG_CONFIG           = "msg_cmd_flag_config"
+	ID_CMD_FLAG_DEFAULTS         = "msg_cmd_flag_allow_defaults"
+	ID_CMD_FLAG_DEPLOYMENT       = "msg_cmd_flag_deployment"
+	ID_CMD_FLAG_ENV              = "msg_cmd_flag_env"
+	ID_CMD_FLAG_PREVIEW          = "msg_cmd_flag_preview"
+	ID_CMD_FLAG_KEY_FILE         = "msg_cmd_flag_key_file"
+	ID_CMD_FLAG_MANAGED          = "msg_cmd_flag_allow_managed"
+	ID_CMD_FLAG_PROJECTNAME      = "msg_cmd_flag_project_name"
+	ID_CMD_FLAG_MANIFEST         = "msg_cmd_flag_manifest"
+	ID_CMD_FLAG_NAMESPACE        = "msg_cmd_flag_namespace"
+	ID_CMD_FLAG_PROJECT          = "msg_cmd_flag_project"
+	ID_CMD_FLAG_STRICT           = "msg_cmd_flag_strict"
+	ID_CMD_FLAG_TRACE            = "msg_cmd_flag_trace"
+	ID_CMD_FLAG_VERBOSE          = "msg_cmd_flag_allow_verbose"
+	ID_CMD_FLAG_PARAM            = "msg_cmd_flag_allow_param"
+	ID_CMD_FLAG_PARAM_FILE       = "msg_cmd_flag_allow_param_file"
+	ID_CMD_FLAG_PARALLELISM      = "msg_cmd_flag_parallelism"
+	ID_CMD_FLAG_NO_ROLLBACK      = "msg_cmd_flag_no_rollback"
+	ID_CMD_FLAG_OUTPUT           = "msg_cmd_flag_output"
+	ID_CMD_FLAG_ONLY             = "msg_cmd_flag_only"
+	ID_CMD_FLAG_EXCLUDE          = "msg_cmd_flag_exclude"
+	ID_CMD_FLAG_RETRY_ATTEMPTS   = "msg_cmd_flag_retry_attempts"
+	ID_CMD_FLAG_RETRY_DELAY      = "msg_cmd_flag_retry_delay"
+	ID_CMD_FLAG_RETRY_MAX_DELAY  = "msg_cmd_flag_retry_max_delay"
+	ID_CMD_FLAG_SECRETS_DIR      = "msg_cmd_flag_secrets_dir"
+	ID_CMD_FLAG_SECRETS_FILE     = "msg_cmd_flag_secrets_file"
+	ID_CMD_FLAG_SECRETS_IDENTITY = "msg_cmd_flag_secrets_identity"
+	ID_CMD_FLAG_FROZEN_LOCKFILE  = "msg_cmd_flag_frozen_lockfile"
+	ID_CMD_FLAG_CACHE_DIR        = "msg_cmd_flag_cache_dir"
 
 	// Root <command> using <manifest | deployment> file
 	ID_MSG_COMMAND_USING_X_cmd_X_filetype_X_path_X = "msg_command_using_filename_at_path"
@@ -164,6 +229,18 @@ const (
 	ID_MSG_DEPLOYMENT_REPORT    = "msg_deployment_report_status"
 	ID_MSG_DEPLOYMENT_SUCCEEDED = "msg_deployment_succeeded"
 
+	ID_MSG_ROLLBACK_STARTED   = "msg_rollback_started"
+	ID_MSG_ROLLBACK_SUCCEEDED = "msg_rollback_succeeded"
+	ID_MSG_ROLLBACK_FAILED    = "msg_rollback_failed"
+
+	ID_MSG_PLAN_HEADER                                             = "msg_plan_header"
+	ID_MSG_PLAN_SUMMARY_X_create_X_update_X_unchanged_X_orphaned_X = "msg_plan_summary"
+
+	ID_MSG_STATUS_HEADER_X_project_X_namespace_X_time_X   = "msg_status_header"
+	ID_MSG_STATUS_SUMMARY_X_insync_X_modified_X_deleted_X = "msg_status_summary"
+
+	ID_MSG_VALIDATION_SUCCEEDED_X_path_X = "msg_validation_succeeded"
+
 	ID_MSG_UNDEPLOYMENT_CANCELLED = "msg_undeployment_cancelled"
 	ID_MSG_UNDEPLOYMENT_FAILED    = "msg_undeployment_failed"
 	ID_MSG_UNDEPLOYMENT_SUCCEEDED = "msg_undeployment_succeeded"
@@ -171,6 +248,7 @@ const (
 	ID_MSG_ENTITY_DEPLOYED_SUCCESS_X_key_X_name_X   = "msg_entity_deployed_success"
 	ID_MSG_ENTITY_DEPLOYING_X_key_X_name_X          = "msg_entity_deploying"
 	ID_MSG_ENTITY_UNDEPLOYED_SUCCESS_X_key_X_name_X = "msg_entity_undeployed_success"
+	ID_MSG_ENTITY_UNCHANGED_X_key_X_name_X          = "msg_entity_unchanged"
 	ID_MSG_ENTITY_UNDEPLOYING_X_key_X_name_X        = "msg_entity_undeploying"
 
 	ID_MSG_DEPENDENCY_DEPLOYING_X_name_X            = "msg_dependency_deploying"
@@ -182,15 +260,90 @@ const (
 
 	ID_MSG_DEFAULT_PACKAGE = "msg_default_package"
 
+	// Dependency lockfile
+	ID_MSG_DEPENDENCY_LOCKED_X_dependency_X_commit_X          = "msg_dependency_locked"
+	ID_MSG_DEPENDENCY_CACHED_X_dependency_X_commit_X          = "msg_dependency_cached"
+	ID_MSG_DEPENDENCY_RESOLVED_X_dependency_X_version_X_tag_X = "msg_dependency_resolved"
+	ID_MSG_DEPENDENCIES_VENDORED_X_path_X                     = "msg_dependencies_vendored"
+	ID_MSG_LOCKFILE_UPDATED_X_path_X                          = "msg_lockfile_updated"
+
+	// Feeds
+	ID_MSG_FEED_OPERATION_UNSUPPORTED_X_feed_X_operation_X = "msg_feed_operation_unsupported"
+	ID_MSG_FEED_READ_FAILED_X_trigger_X_err_X              = "msg_feed_read_failed"
+
 	// Managed deployments
 	ID_MSG_MANAGED_UNDEPLOYMENT_FAILED                    = "msg_managed_undeployment_failed"
 	ID_MSG_MANAGED_FOUND_DELETED_X_key_X_name_X_project_X = "msg_managed_found_deleted_entity"
 
 	// Errors
 	ID_ERR_DEPENDENCY_UNKNOWN_TYPE                                       = "msg_err_dependency_unknown_type"
+	ID_ERR_DEPENDENCY_X_dependency_X_err_X                               = "msg_err_dependency"
+	ID_ERR_DEPENDENCY_NOT_LOCKED_X_path_X                                = "msg_err_dependency_not_locked"
+	ID_ERR_DEPENDENCY_CHECKSUM_X_commit_X_expected_X_actual_X            = "msg_err_dependency_checksum"
+	ID_ERR_DEPENDENCY_RESOLVE_X_version_X_err_X                          = "msg_err_dependency_resolve"
+	ID_ERR_DEPENDENCY_NO_TAG_X_version_X                                 = "msg_err_dependency_no_tag"
+	ID_ERR_DEPENDENCY_CONFLICT_X_expected_X_mpath_X_actual_X_path_X      = "msg_err_dependency_conflict"
+	ID_ERR_DEPENDENCY_CYCLE_X_path_X                                     = "msg_err_dependency_cycle"
+	ID_ERR_DEPENDENCY_INVALID_LOCATION_X_location_X                      = "msg_err_dependency_invalid_location"
+	ID_ERR_DEPENDENCY_DOWNLOAD_X_url_X_err_X                             = "msg_err_dependency_download"
+	ID_ERR_PACKAGE_VERSION_INVALID_X_package_X_version_X_err_X           = "msg_err_package_version_invalid"
+	ID_ERR_LOCKFILE_WRITE_X_path_X_err_X                                 = "msg_err_lockfile_write"
+	ID_ERR_ROLLBACK_ENTITY_X_key_X_name_X_err_X                          = "msg_err_rollback_entity"
+	ID_ERR_DEPLOYMENT_CYCLE_X_entities_X                                 = "msg_err_deployment_cycle"
+	ID_ERR_DEPLOYMENT_ENV_FILE_NOT_FOUND_X_env_X_path_X                  = "msg_err_deployment_env_file_not_found"
+	ID_ERR_IMPORT_NOT_FOUND_X_import_X                                   = "msg_err_import_not_found"
+	ID_ERR_IMPORT_DUPLICATE_PACKAGE_X_package_X_path_X                   = "msg_err_import_duplicate_package"
+	ID_ERR_SECRET_X_secret_X_err_X                                       = "msg_err_secret"
+	ID_ERR_SECRET_PROVIDER_UNKNOWN_X_provider_X_providers_X              = "msg_err_secret_provider_unknown"
+	ID_ERR_SECRET_PROVIDER_NOT_CONFIGURED_X_provider_X_flag_X            = "msg_err_secret_provider_not_configured"
+	ID_ERR_SECRET_INVALID_PATH_X_path_X                                  = "msg_err_secret_invalid_path"
+	ID_ERR_SECRET_NOT_FOUND_X_path_X                                     = "msg_err_secret_not_found"
+	ID_ERR_SECRET_NOT_A_VALUE_X_path_X                                   = "msg_err_secret_not_a_value"
+	ID_ERR_SECRET_DECRYPT_X_path_X_cmd_X_err_X                           = "msg_err_secret_decrypt"
+	ID_ERR_OUTPUT_FORMAT_UNKNOWN_X_format_X_formats_X                    = "msg_err_output_format_unknown"
+	ID_ERR_ENTITY_SELECTOR_INVALID_X_selector_X_kinds_X                  = "msg_err_entity_selector_invalid"
+	ID_ERR_ENTITY_SELECTOR_NO_MATCH_X_selector_X                         = "msg_err_entity_selector_no_match"
+	ID_ERR_RETRY_POLICY_INVALID_X_key_X_value_X                          = "msg_err_retry_policy_invalid"
+	ID_ERR_STATE_FILE_NOT_FOUND_X_path_X                                 = "msg_err_state_file_not_found"
+	ID_ERR_STATE_FILE_WRITE_X_path_X_err_X                               = "msg_err_state_file_write"
+	ID_ERR_SCHEMA_VIOLATIONS_X_count_X                                   = "msg_err_schema_violations"
+	ID_ERR_SCHEMA_UNKNOWN_PROPERTY_X_key_X                               = "msg_err_schema_unknown_property"
+	ID_ERR_SCHEMA_MISSING_PROPERTY_X_key_X                               = "msg_err_schema_missing_property"
+	ID_ERR_SCHEMA_INVALID_TYPE_X_actual_X_expected_X                     = "msg_err_schema_invalid_type"
+	ID_ERR_SCHEMA_SUGGESTION_X_suggestion_X                              = "msg_err_schema_suggestion"
+	ID_ERR_SCHEMA_REF_NOT_FOUND_X_key_X                                  = "msg_err_schema_ref_not_found"
+	ID_ERR_SCHEMA_ENUM_X_expected_X                                      = "msg_err_schema_enum"
+	ID_ERR_SCHEMA_CONST_X_expected_X                                     = "msg_err_schema_const"
+	ID_ERR_SCHEMA_MINIMUM_X_limit_X                                      = "msg_err_schema_minimum"
+	ID_ERR_SCHEMA_MAXIMUM_X_limit_X                                      = "msg_err_schema_maximum"
+	ID_ERR_SCHEMA_EXCLUSIVE_MINIMUM_X_limit_X                            = "msg_err_schema_exclusive_minimum"
+	ID_ERR_SCHEMA_EXCLUSIVE_MAXIMUM_X_limit_X                            = "msg_err_schema_exclusive_maximum"
+	ID_ERR_SCHEMA_MULTIPLE_OF_X_limit_X                                  = "msg_err_schema_multiple_of"
+	ID_ERR_SCHEMA_MIN_LENGTH_X_limit_X                                   = "msg_err_schema_min_length"
+	ID_ERR_SCHEMA_MAX_LENGTH_X_limit_X                                   = "msg_err_schema_max_length"
+	ID_ERR_SCHEMA_PATTERN_X_expected_X                                   = "msg_err_schema_pattern"
+	ID_ERR_SCHEMA_MIN_ITEMS_X_limit_X                                    = "msg_err_schema_min_items"
+	ID_ERR_SCHEMA_MAX_ITEMS_X_limit_X                                    = "msg_err_schema_max_items"
+	ID_ERR_SCHEMA_UNIQUE_ITEMS                                           = "msg_err_schema_unique_items"
+	ID_ERR_SCHEMA_MIN_PROPERTIES_X_limit_X                               = "msg_err_schema_min_properties"
+	ID_ERR_SCHEMA_MAX_PROPERTIES_X_limit_X                               = "msg_err_schema_max_properties"
+	ID_ERR_SCHEMA_ANY_OF                                                 = "msg_err_schema_any_of"
+	ID_ERR_SCHEMA_ONE_OF_X_count_X                                       = "msg_err_schema_one_of"
+	ID_ERR_SCHEMA_NOT                                                    = "msg_err_schema_not"
+	ID_ERR_PARAMETER_SCHEMA_VIOLATIONS_X_name_X_key_X_entity_X_count_X   = "msg_err_parameter_schema_violations"
+	ID_ERR_PARAMETER_SCHEMA_INVALID_X_name_X_err_X                       = "msg_err_parameter_schema_invalid"
+	ID_ERR_VALIDATION_FAILED_X_count_X                                   = "msg_err_validation_failed"
+	ID_ERR_LIMIT_INVALID_X_limit_X_action_X                              = "msg_err_limit_invalid"
+	ID_ERR_LIMIT_UNCHANGEABLE_X_limit_X_action_X                         = "msg_err_limit_unchangeable"
+	ID_ERR_SEQUENCE_ACTION_NOT_FOUND_X_sequence_X_action_X               = "msg_err_sequence_action_not_found"
+	ID_ERR_RULE_TRIGGER_NOT_FOUND_X_rule_X_trigger_X                     = "msg_err_rule_trigger_not_found"
+	ID_ERR_RULE_ACTION_NOT_FOUND_X_rule_X_action_X                       = "msg_err_rule_action_not_found"
 	ID_ERR_ENTITY_CREATE_X_key_X_err_X_code_X                            = "msg_err_entity_create"
 	ID_ERR_ENTITY_DELETE_X_key_X_err_X_code_X                            = "msg_err_entity_delete"
 	ID_ERR_FEED_INVOKE_X_err_X_code_X                                    = "msg_err_feed_invoke"
+	ID_ERR_FEED_ACTION_NOT_FOUND_X_feed_X_action_X                       = "msg_err_feed_action_not_found"
+	ID_ERR_FEED_OPERATION_INVALID_X_feed_X_operation_X_operations_X      = "msg_err_feed_operation_invalid"
+	ID_ERR_STATUS_INVALID_X_key_X_name_X_status_X                        = "msg_err_status_invalid"
 	ID_ERR_KEY_MISSING_X_key_X                                           = "msg_err_key_missing_mandatory"
 	ID_ERR_MANIFEST_FILE_NOT_FOUND_X_path_X                              = "msg_err_manifest_not_found"
 	ID_ERR_NAME_MISMATCH_X_key_X_dname_X_dpath_X_mname_X_moath_X         = "msg_err_name_mismatch"
@@ -226,7 +379,7 @@ const (
 	ID_ERR_JSON_MISSING_KEY_CMD = "msg_err_json_missing_cmd_key"
 
 	// warnings
-	ID_WARN_COMMAND_RETRY                                     = "msg_warn_command_retry"
+	ID_WARN_RETRY_X_attempt_X_attempts_X_delay_X_err_X        = "msg_warn_command_retry"
 	ID_WARN_CONFIG_INVALID_X_path_X                           = "msg_warn_config_invalid"
 	ID_WARN_KEY_DEPRECATED_X_oldkey_X_filetype_X_newkey_X     = "msg_warn_key_deprecated_replaced"
 	ID_WARN_KEY_MISSING_X_key_X_value_X                       = "msg_warn_key_missing"
@@ -243,12 +396,16 @@ const (
 	ID_WARN_ENTITY_NAME_EXISTS_X_key_X_name_X                 = "msg_warn_entity_name_exists"
 	ID_WARN_PACKAGES_NOT_FOUND_X_path_X                       = "msg_warn_packages_not_found"
 	ID_WARN_DEPLOYMENT_NAME_NOT_FOUND_X_key_X_name_X          = "msg_warn_deployment_name_not_found"
+	ID_WARN_ROLLBACK_FEED_X_name_X_feed_X                     = "msg_warn_rollback_feed"
+	ID_WARN_TRIGGER_STATUS_IGNORED_X_trigger_X                = "msg_warn_trigger_status_ignored"
 	ID_WARN_PROJECT_NAME_OVERRIDDEN                           = "msg_warn_project_name_overridden"
 	ID_WARN_PACKAGE_IS_PUBLIC_X_package_X                     = "msg_warn_package_is_public"
 	ID_WARN_ACTION_WEB_X_action_X                             = "msg_warn_action_web_export_ignored"
 	ID_WARN_API_MISSING_WEB_ACTION_X_action_X_api_X           = "msg_warn_api_missing_web_action"
 	ID_WARN_API_MISSING_WEB_SEQUENCE_X_sequence_X_api_X       = "msg_warn_api_missing_web_sequence"
 	ID_WARN_API_INVALID_RESPONSE_TYPE                         = "msg_warn_api_invalid_response_type"
+	ID_WARN_DEPENDENCY_VENDORED_X_dependency_X_path_X         = "msg_warn_dependency_vendored"
+	ID_WARN_DEPENDENCY_CHECKSUM_X_dependency_X_commit_X       = "msg_warn_dependency_checksum"
 	/** Fixes #797
 	ID_WARN_MISSING_ENVIRONMENT_VARIABLE                      = "msg_warn_missing_environment_variable"
 	**/
@@ -272,10 +429,24 @@ const (
 // DO NOT TRANSLATE
 // Used to unit test that translations exist with these IDs and their keys != their values (string)
 var I18N_ID_SET = [](string){
+	ID_CMD_DESC_LONG_DEPS,
+	ID_CMD_DESC_LONG_DEPS_UPDATE,
+	ID_CMD_DESC_LONG_DEPS_TREE,
+	ID_CMD_DESC_LONG_DEPS_VENDOR,
+	ID_CMD_DESC_LONG_PLAN,
 	ID_CMD_DESC_LONG_REPORT,
 	ID_CMD_DESC_LONG_ROOT,
+	ID_CMD_DESC_LONG_STATUS,
+	ID_CMD_DESC_LONG_VALIDATE,
+	ID_CMD_DESC_SHORT_DEPS,
+	ID_CMD_DESC_SHORT_DEPS_UPDATE,
+	ID_CMD_DESC_SHORT_DEPS_TREE,
+	ID_CMD_DESC_SHORT_DEPS_VENDOR,
+	ID_CMD_DESC_SHORT_PLAN,
 	ID_CMD_DESC_SHORT_REPORT,
 	ID_CMD_DESC_SHORT_ROOT,
+	ID_CMD_DESC_SHORT_STATUS,
+	ID_CMD_DESC_SHORT_VALIDATE,
 	ID_CMD_DESC_SHORT_VERSION,
 	ID_CMD_FLAG_API_HOST,
 	ID_CMD_FLAG_API_VERSION,
@@ -284,13 +455,27 @@ var I18N_ID_SET = [](string){
 	ID_CMD_FLAG_CONFIG,
 	ID_CMD_FLAG_DEFAULTS,
 	ID_CMD_FLAG_DEPLOYMENT,
+	ID_CMD_FLAG_ENV,
+	ID_CMD_FLAG_EXCLUDE,
+	ID_CMD_FLAG_FROZEN_LOCKFILE,
+	ID_CMD_FLAG_CACHE_DIR,
 	ID_CMD_FLAG_KEY_FILE,
 	ID_CMD_FLAG_MANAGED,
 	ID_CMD_FLAG_MANIFEST,
 	ID_CMD_FLAG_NAMESPACE,
+	ID_CMD_FLAG_NO_ROLLBACK,
+	ID_CMD_FLAG_ONLY,
+	ID_CMD_FLAG_OUTPUT,
+	ID_CMD_FLAG_PARALLELISM,
 	ID_CMD_FLAG_PREVIEW,
 	ID_CMD_FLAG_PROJECT,
 	ID_CMD_FLAG_PROJECTNAME,
+	ID_CMD_FLAG_RETRY_ATTEMPTS,
+	ID_CMD_FLAG_RETRY_DELAY,
+	ID_CMD_FLAG_RETRY_MAX_DELAY,
+	ID_CMD_FLAG_SECRETS_DIR,
+	ID_CMD_FLAG_SECRETS_FILE,
+	ID_CMD_FLAG_SECRETS_IDENTITY,
 	ID_CMD_FLAG_STRICT,
 	ID_CMD_FLAG_TRACE,
 	ID_CMD_FLAG_VERBOSE,
@@ -348,10 +533,18 @@ var I18N_ID_SET = [](string){
 	ID_MSG_PREFIX_WARNING,
 	ID_MSG_UNDEPLOYMENT_CANCELLED,
 	ID_MSG_UNDEPLOYMENT_FAILED,
+	ID_MSG_PLAN_HEADER,
+	ID_MSG_PLAN_SUMMARY_X_create_X_update_X_unchanged_X_orphaned_X,
+	ID_MSG_ROLLBACK_FAILED,
+	ID_MSG_ROLLBACK_STARTED,
+	ID_MSG_ROLLBACK_SUCCEEDED,
+	ID_MSG_STATUS_HEADER_X_project_X_namespace_X_time_X,
+	ID_MSG_STATUS_SUMMARY_X_insync_X_modified_X_deleted_X,
 	ID_MSG_UNDEPLOYMENT_SUCCEEDED,
 	ID_MSG_UNMARSHAL_LOCAL,
 	ID_MSG_UNMARSHAL_NETWORK_X_url_X,
-	ID_WARN_COMMAND_RETRY,
+	ID_MSG_VALIDATION_SUCCEEDED_X_path_X,
+	ID_WARN_RETRY_X_attempt_X_attempts_X_delay_X_err_X,
 	ID_WARN_CONFIG_INVALID_X_path_X,
 	ID_WARN_DEPLOYMENT_NAME_NOT_FOUND_X_key_X_name_X,
 	ID_WARN_ENTITY_NAME_EXISTS_X_key_X_name_X,
@@ -25,7 +25,7 @@ func bindata_read(data []byte, name string) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-var _wski18n_resources_de_de_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x01\x00\x00\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00")
+var _wski18n_resources_de_de_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
 
 func wski18n_resources_de_de_all_json() ([]byte, error) {
 	return bindata_read(
@@ -34,7 +34,7 @@ func wski18n_resources_de_de_all_json() ([]byte, error) {
 	)
 }
 
-var _wski18n_resources_en_us_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x7c\x7b\x8f\x1b\x37\x92\xf8\xff\xf9\x14\x85\x60\x01\x27\x80\x46\xf6\x2e\x7e\xf8\x61\x31\x77\x3e\x60\xd6\x1e\x27\xb3\xb1\x33\xbe\x79\x24\xc8\xd9\x83\x36\xd5\x5d\x92\xb8\xd3\x4d\xf6\x92\x6c\xc9\xca\x40\xdf\xfd\x50\x45\xb2\xbb\xa5\x51\x3f\x34\x76\x70\xf1\x3f\xd6\x88\x64\xbd\x58\x2c\xd6\x8b\xfa\xf0\x0d\xc0\xc3\x37\x00\x00\xdf\xca\xec\xdb\x53\xf8\xb6\xb0\x8b\xa4\x34\x38\x97\x9f\x13\x34\x46\x9b\x6f\x27\x7e\xd4\x19\xa1\x6c\x2e\x9c\xd4\x8a\xa6\x9d\xf3\xd8\x37\x00\xdb\x49\x0f\x04\xa9\xe6\xba\x03\xc0\x05\x0d\x0d\xad\xb7\x55\x9a\xa2\xb5\x1d\x20\xae\xc3\xe8\x10\x94\xb5\x30\x4a\xaa\x45\x07\x94\x5f\xc3\x68\x27\x94\xb4\xc8\x92\x0c\x6d\x9a\xe4\x5a\x2d\x12\x83\xa5\x36\xae\x03\xd6\x15\x0f\x5a\xd0\x0a\x32\x2c\x73\xbd\xc1\x0c\x50\x39\xe9\x24\x5a\xf8\x4e\x4e\x71\x3a\x81\xf7\x22\xbd\x17\x0b\xb4\x13\x38\x4b\x69\x9d\x9d\xc0\x8d\x91\x8b\x05\x1a\x3b\x81\xab\x2a\xa7\x11\x74\xe9\xf4\x7b\x10\x16\xd6\x98\xe7\xf4\xbf\xc1\x14\x95\xe3\x15\x2b\xc6\x66\x41\x2a\x70\x4b\x04\x5b\x62\x2a\xe7\x12\x33\x50\xa2\x40\x5b\x8a\x14\xa7\xa3\x79\xd1\xba\x8b\x93\x9b\x25\xc2\x65\x89\xea\xd7\xa5\xb4\xf7\xf0\x9a\x99\x29\x88\x84\x1b\xad\xf3\x8f\xea\xa3\xba\xd1\x30\xc3\x85\x54\xb0\xd6\xe6\x5e\xaa\x05\xac\xa5\x5b\xc2\xda\xde\x7b\xc6\x27\x60\x2a\x4f\xe0\xb3\xfa\xbb\x67\x90\xea\xa2\x10\x2a\x3b\x25\x00\x1f\xdd\x5f\x9a\xe9\x0c\x71\x29\x2d\xac\x65\x9e\x07\xd9\xb5\xf0\x0b\x6b\xd1\xd9\x16\xaf\x52\x41\x21\x94\x9c\xa3\x75\xd3\x8d\x28\x72\xd0\xa6\xf5\x45\x91\x7f\x54\x17\x73\x48\x2b\x63\x88\xe4\x4c\x1a\x4c\x9d\x36\x1b\xc8\x34\x5a\xe5\x60\x29\x56\x08\x42\x6d\xea\x25\x30\x97\x39\x4e\x1a\x72\xa0\x34\x52\x39\x0b\x8e\x48\x5a\x62\x5e\x42\x81\xd6\x8a\x05\x4e\x3d\xa1\x08\x85\xb6\x8e\xd9\xd1\x0a\xd6\x62\x63\x41\xcf\xa1\xb2\x2c\x87\x1a\x88\xd3\x91\x13\xa1\xb2\xe7\xda\x40\xa5\xba\x38\x13\x06\x59\x28\x3b\x22\x69\xfd\x01\x27\x05\x94\xc2\x2d\x9f\x3b\xfd\x7c\x87\xf1\x71\xb3\xe0\x24\xab\x07\xb2\x7a\x2f\x0f\x00\x88\x14\x1e\xfe\x76\x24\x15\x83\xd3\x7b\xc9\xf9\xa8\xce\x2a\xb7\xa4\x53\x93\xb2\x36\x9e\x7e\x54\x0d\x68\x83\x22\xb3\x90\x1a\xcc\x68\x82\xc8\x2d\xcc\x8d\x2e\xe0\x2f\x3f\x5e\xbe\x3b\x7f\x3e\x5d\xdb\xfb\xd2\xe8\xd2\xc2\x6c\x03\x19\xce\x45\x95\xbb\x8f\xea\x72\x85\x66\x6d\xa4\xc3\xf8\x15\xa4\x5a\xcd\xe5\x82\xf7\x9c\x4e\xea\xab\xb7\x17\xa7\x1f\x15\xc0\x8e\x20\x4f\xc2\xa4\xff\x6c\x4d\xfe\xaf\x1e\xfe\x2f\x4d\xd0\xce\x0d\x88\x3c\x07\xb7\x34\xd8\x03\x5c\x94\x72\x49\x0a\xf4\xe3\xe5\xf5\x0d\xfd\x59\xb9\x25\xfc\x74\xfe\x1b\x9c\x9c\xd4\x87\x18\x7e\x3e\x7b\x77\x7e\xfd\xfe\xec\xd5\x79\x27\xd6\x11\xc7\xdc\x2e\xb5\x71\xfd\x36\xeb\xbd\xd1\x2b\x99\xa1\x05\x01\xb6\x2a\x0a\x61\x48\xca\x34\x9f\x54\xfa\x91\xa2\xce\x90\x74\x3c\x1a\xb7\xe7\x71\xab\x31\x83\x99\xb0\x98\x11\xcb\x91\xc6\xd6\xd6\xc2\x6f\x67\xef\xde\x8e\x31\x4b\x81\xde\x6e\xbb\x74\x06\x4e\xeb\x1c\x2c\x3a\x3a\x5e\x7c\x34\x83\x54\x37\xba\x32\xa0\x4b\x54\x6b\xa6\xb7\x0c\x66\x36\x9c\x4a\xb1\x7b\xd6\xc7\xd3\xb2\x42\x63\x09\x77\x97\xf0\xa4\x72\x6c\xe6\xc2\x3c\x50\x55\x31\x43\x43\xb2\xab\x37\x7c\x34\x2e\xbb\x51\x69\x3f\xdf\x4e\x03\x4d\xf2\xcc\x36\x9b\x53\x33\x3b\x43\xb7\x46\x54\x90\xe6\x92\xc4\x2e\x54\x06\x16\xcd\x0a\xcd\xe8\x3b\x61\x3c\x0d\xad\xed\x25\x3c\x51\x15\xf8\x8b\x1d\xd5\xe9\xde\x0a\x5a\xa7\x4b\x82\x2f\xf2\x36\x3c\xda\xa2\x38\x9d\x55\x87\xcc\xc2\x6b\x39\x9f\x23\x1b\xf4\x68\x70\x4d\xa5\xe8\xea\x66\x72\x4e\x77\x6d\x10\x7d\xf5\xf8\x9b\x91\x06\xac\x77\x6a\xdb\x78\x3d\x1d\xc6\x49\x69\xf4\xbf\x30\x75\x74\xde\xe1\xfd\xd5\xe5\x3f\xcf\x5f\xdd\x8c\xd6\x93\x28\xea\x8e\x7d\xba\xed\xbc\x66\xd8\x58\x7a\x85\x18\xab\x0f\x63\x71\x19\x2c\xf4\x0a\xed\x63\x9c\xeb\xa5\x4c\x97\xb0\x46\x83\x8d\x4f\xc4\x74\xd0\xa9\xd9\xd1\x84\x7d\x7b\xb1\xe3\x66\x64\x98\xa3\xa3\xcd\x3e\xcc\xd4\x0e\x30\x7f\x9b\x9b\x4a\x9d\xfe\xe9\x6e\xb7\xc3\x90\x0e\x69\x03\x7c\xa7\x55\xbe\x61\xf7\xca\xc2\x5c\x9b\x96\x78\xd8\xf9\x63\x05\x2b\x74\x86\xdf\x8f\xd6\x1b\xfc\xdc\x73\x0f\x9c\xf3\x20\x04\x4a\x76\x84\x5b\x8b\x7c\xac\xd2\x8c\x40\x64\x69\xbb\xc4\x02\xb3\x7e\x8c\x64\x6d\x76\x94\x64\x5e\x29\x76\x9b\xbd\x8d\xe8\x70\xc7\x68\x15\xf9\x9f\x9e\x8e\x3d\x2d\xf0\x5f\x76\x08\xbd\xb5\xa9\x7e\x1e\x66\x27\x47\x5c\xba\xf3\x5c\x2c\x12\x51\xca\x84\xae\xf7\x0e\xfe\xfd\xfd\x74\xf6\xfe\x02\x3e\xd1\xfd\xff\x69\x24\xc4\xfe\x8b\xa8\x05\xf4\x97\xf3\xab\xeb\x8b\xcb\x9f\x47\xc1\xad\xdc\x32\xb9\xc7\xae\xc3\x4d\xc3\xda\xc8\xdf\xf9\x0b\xf8\xf4\xd3\xf9\x6f\x63\x80\xa6\x68\x5c\x42\xbb\xd3\x01\x95\xe4\x4b\xd6\x9b\x8e\xec\x94\x26\xf3\x56\x8e\x01\xcc\xae\x58\x07\xd4\xb6\x53\xf7\x5d\xf4\xf4\xa4\xdd\x77\x0d\x07\x0e\x8b\x97\x4a\x9e\xeb\x75\x12\x60\x74\x05\x9f\x3c\x09\xea\x49\xc3\x50\x9b\xe3\xdb\x27\x97\x3a\x66\xa8\xef\xc1\x11\xa0\x4b\x83\x2b\x89\xeb\x0e\xb8\x76\xc9\x84\x46\xa0\xcf\x77\x2e\xea\x32\x17\x6a\x04\x86\x7b\xdc\x8c\xde\xd2\x7b\xdc\x8c\x25\xdc\x4b\x3a\x18\x82\x5e\x41\x47\x23\x51\x47\xd3\x8e\x2e\x06\x28\x84\xb9\xc7\x2c\x9a\x92\x51\xa2\x62\x38\x09\x1d\xfa\x2e\x66\x02\x2a\x9e\x32\x0c\x31\x5a\x87\x81\x5d\xdd\xb9\x9c\x46\x80\xad\x03\x81\x0e\xb8\xcd\xf8\x68\xa6\x07\x28\xf4\x7e\x41\x8e\xd6\x46\x69\x8f\x00\x6d\x9d\x91\x9d\x90\xfd\xd6\x55\x16\xe9\xf2\x9a\x4b\x85\x19\x59\x65\x27\x8b\xda\x5d\x1e\x81\xc1\x99\x6e\x21\xf0\x18\xe8\xca\x95\xd5\x18\x62\xbd\xba\xad\xd0\xcc\xb4\xed\x02\x19\x46\x8f\x05\x5a\x0a\x23\x8a\x4e\x01\x1b\x51\xa0\x43\x03\x2b\x91\x57\xc8\xb7\x37\x19\x53\xf8\xe5\xec\xed\xed\xf9\x27\xba\xdc\x0b\x71\x24\xaa\xbe\xd3\xf8\xe9\xcd\xc5\xdb\xf3\x4f\x14\xe6\x3a\x21\xd9\x41\x3e\x44\xc1\x3f\xaf\x2f\x7f\x1e\x46\xcd\x56\x35\x29\xa4\x25\x5f\x9c\xef\x8b\xee\xeb\x82\x2e\x62\xb1\x13\xbb\x03\xd9\x02\x69\x41\xe9\x18\x75\x57\x06\xb3\xe9\xc7\xbe\x7d\xdf\xc3\xe8\x23\xe5\x1e\x8c\x74\xe7\x71\x30\xfd\x45\x78\x86\x8e\x1b\x61\x6a\x62\xf3\x27\xa1\x0a\xac\xf4\x25\x45\xf7\xf9\xf9\xf0\xf0\x30\xa5\xcf\xdb\xed\xdd\xc4\x3b\x46\x0f\x0f\x53\xab\x2b\x93\xe2\x76\x3b\x0a\xa7\xdf\xb0\x21\x9c\x9c\x80\x08\x7b\x65\xd1\x3d\x0d\x57\x2d\x9e\x21\x6c\x3b\x72\x24\x16\xeb\x2f\x9e\xce\x67\x29\x17\xeb\xc4\xa1\x12\xca\x25\x32\x1b\x23\xe3\x1f\x84\x43\x72\x15\x6f\x78\x11\x5c\xbc\x8e\xd4\x54\x95\xcc\xbe\x90\x10\xc1\x89\xe9\xc4\xe9\x7b\x54\xc7\xd0\xe2\xd7\x01\xaf\x7b\xda\x5e\x54\xaa\x10\xc6\x2e\x45\x9e\xe4\x3a\x15\x79\x67\xd4\x16\x66\xb5\x1c\xed\x60\x99\x83\x03\xce\xab\x83\xb5\x18\x89\x50\xa1\xa3\x60\xe5\xc9\x28\xa5\x72\x68\x14\x3a\x10\x8e\xd8\xad\x4c\x3e\xc0\x6b\xe3\xc6\x24\xa9\x50\x29\xe6\x79\xa7\x13\x71\xf9\xd3\x14\x5e\xf9\x39\x4d\xfe\x8a\xc3\xb2\x91\x08\xe6\x42\x76\x43\x6f\xa5\xc7\x33\x99\x05\xd3\x50\x94\x39\x3a\x84\x50\xc2\x98\x57\x79\xbe\x99\xc2\x55\xa5\xe0\xd3\xe3\x08\xf0\x13\x07\x2c\x1c\x41\x93\xad\x76\x52\xe4\xf9\xa6\x09\x97\x7d\x64\x34\x96\x54\x9f\xbd\x4b\xac\x13\xae\xea\xf2\x5e\x4f\x4e\x4e\x4e\x5e\xbe\x7c\xf9\xf2\x70\x8e\xff\x9a\x97\x02\x4d\xa0\x89\xa3\xb0\x32\x9f\x98\x8d\x91\x51\x94\x4d\xb6\x2b\x9c\x7e\x2d\x7b\xfa\x66\xb7\xd7\x8e\x47\xd2\xbb\xe1\xb7\x6d\x17\xba\x7f\xcb\x47\x23\x1c\x12\xe0\x0e\xce\x27\x88\x30\xd4\x5e\x12\xce\xaa\xb1\xfb\x40\x66\x37\x11\x2e\x21\x07\xb0\x03\xe9\xc3\xc3\x34\x2d\xb2\xed\x36\xe4\xe2\x1e\x1e\xa6\xb4\xd0\x6d\x4a\xdc\x6e\xd9\x58\xd2\xda\xed\xf6\x6e\x3a\xed\xc5\xcd\x5e\xfb\x26\x89\x0a\x3d\x50\xd7\x7b\x78\xa0\x18\x22\x20\x20\x22\xb7\xdb\x3b\x58\x0a\x0b\x33\x44\xb5\xc3\x70\x7d\x44\xc6\x63\xef\x2e\x04\xbe\x8e\xe3\x70\x90\x80\xe9\xb4\x27\x87\x1a\x50\x34\xe9\xf0\xaf\xc7\x62\x03\x73\x0c\x93\x71\x76\x37\x9b\xb7\xcd\x8c\x83\x8c\xf6\xf2\x99\x61\x89\x2a\x43\x95\x1e\x23\xce\x66\xd1\xd3\xf1\x34\x47\xa4\x53\xa6\xaf\x0f\xa2\xf9\x12\xc5\x39\x4c\x05\x59\x86\xca\x74\x39\x8a\xaf\x77\x92\xe0\x87\x59\xff\xbf\xbc\x25\x22\x43\xc7\x29\xca\x97\x6d\xe1\x63\x3b\xf7\x75\x36\x71\xe4\xd1\xe8\xa2\xa4\x7f\x23\x6f\xf7\xea\x19\x4f\xda\xca\x3e\xb2\x42\xd2\xe2\xa9\xd7\x0e\x93\xe4\xef\x80\x3a\x29\xd2\x4b\x0c\x64\x95\xa1\xbd\x8c\x79\xd7\xd6\xad\xf8\xc7\x69\x5c\x64\x72\xae\x2b\x95\x25\x81\xe0\x60\xac\x3a\x55\x20\x64\xfa\x0f\x1a\xc9\x50\x4e\xe0\x9e\x08\xa2\xab\x55\x4c\x88\xf5\xfe\xfd\xc4\x32\xdf\x53\xfe\x33\x41\x10\x96\x79\xe1\x8a\xfd\x58\xd7\x20\xe4\xf9\x92\x50\xca\xea\xaa\x06\xfa\x51\x0e\x70\xa0\x95\x83\x34\xc8\xb9\x95\x6c\xc2\xb5\xe1\xc6\xe5\xaa\xf7\x8d\xe8\x30\xf5\x8a\x80\x04\x44\xab\x64\xd2\xae\xb4\xfa\x7e\x86\xa0\xff\xc6\xd7\x02\x87\x9a\x3f\xce\xaf\xae\x2e\xaf\xae\x3b\xe8\x7e\xb9\xff\x0f\xfc\x74\x78\x34\xf0\xf2\x65\xcf\x0d\x64\xcc\xee\x51\xbb\x57\x7a\xad\x12\x72\x16\x86\x0f\x3b\xcd\x22\x51\x85\x55\x53\x68\x25\xec\xb9\x0e\x62\xab\xd2\x97\x0d\x9e\x73\xaa\x7b\x6a\x37\xd6\x61\x01\x33\xa9\x32\xa9\x16\x16\xb4\x81\x85\x74\xcb\x6a\x36\x4d\x75\x51\x97\x1c\xfb\xaf\x4c\x63\xe2\xb5\x99\x1a\x14\xae\x8b\x4c\xee\x75\x02\x9e\xb2\xa3\x96\xdc\xf1\xc2\x4d\x52\xb1\x3d\xe4\x94\x06\xd1\x98\xed\x96\x6b\x15\x7e\x2c\xd5\x99\x1f\xa0\x0f\x03\x21\x4d\x8b\x24\x7f\x56\x7a\x49\xca\x1e\x9d\x94\x3f\x88\xa4\x39\x22\xc5\xd4\x2b\x7d\xdf\x45\xd0\x1b\xb6\x5b\x64\x2e\xfc\x34\x3e\x90\xb4\x0c\xd6\x4b\x6c\x55\xef\x9c\x6f\x75\x0a\x43\x7f\x0c\xb5\xf7\xb8\xa9\xf3\x3a\xe4\xf2\x0a\xa7\x4d\x5f\xce\xaa\x9e\xc3\x29\x90\x0f\x51\x98\x77\xa4\x8f\x01\xce\x20\xce\x98\xde\x4d\x94\x76\xde\xd8\x75\x20\x7c\xd7\xce\x03\xb3\xb1\xe6\xd9\x14\xf4\x72\x22\xb6\xed\x54\x0f\x21\x65\x07\xbe\x90\xb6\x10\x2e\xed\xf2\xe0\x89\xc1\x5a\x3d\x68\x41\xc6\x28\xb2\x68\x4f\xa5\xda\x2f\x38\xf8\xf1\x40\x03\xb7\x4c\x31\x99\x8c\x84\xb7\x95\xcd\x1b\x4d\x2a\x5a\x40\x76\xf2\xdb\x7e\x34\xb2\xd1\xcf\x44\x48\x02\x90\x7a\x89\x5c\x76\x89\xed\xc2\x8f\x72\x9f\x97\xdf\x92\x3a\x95\x4c\xb8\xc2\x67\xa2\xe5\x60\x93\x18\x17\x50\x89\x76\xe1\x8b\x87\xb4\xc6\x7f\x1c\x23\xe7\x48\xe2\x80\xa8\xaf\x8e\x21\x68\x4f\xae\x7c\x14\x3c\x45\xcf\x2c\xf8\x54\x8f\x17\x25\x7e\x76\xa8\x6c\x24\x1a\x3f\xf3\x1d\x46\xec\x7c\x09\x2b\x36\x59\x60\x57\x52\xb5\x39\xca\x0b\xf4\xbd\x2d\xc1\xf6\x36\xe9\xfb\x47\x55\x5a\xba\xdf\x64\xda\x3a\xbe\xa3\x65\xea\x49\x4f\x3c\xc7\x7c\x7a\x6a\x6c\x1d\xf4\xed\x30\xcc\x9e\x21\x89\xb1\x91\xb2\x50\x9b\x5a\x37\xc8\x88\xb4\xb6\x7d\x50\xae\x21\xb1\x5b\x93\x30\xc8\x46\x65\xf2\xe3\x35\xd7\x67\xb7\x42\x14\x7d\x7b\xf5\xd6\xa7\x1d\x4d\x1e\x8e\xd2\x87\x9d\x30\xfb\xce\x37\x2c\x8d\x21\xa4\x10\xf9\x5c\x9b\xa2\x53\x72\xef\xe2\x78\x1f\x05\x53\xb8\x31\x1b\x10\x0b\x21\xd5\x50\x54\x6f\x4c\xf2\x2f\xab\x55\x6d\x6c\xd3\x22\xeb\xa9\x26\x73\xc1\x41\xaa\xb2\x72\x90\x09\x27\xe0\x5d\x90\xc6\xb3\xb4\xc8\x9e\x91\xe9\xed\xc7\x24\x4a\xd9\x54\x05\xbc\xd2\x68\x93\x58\xfc\x77\x85\xaa\x33\x6d\xef\x5b\x6c\x9f\x5f\x87\x59\xbb\x87\xa5\x65\xdf\xbd\x3e\xef\x35\x90\x9c\xbd\xbf\xf0\x0b\x4a\x49\xb3\x53\xa1\xbc\x2b\x32\x43\xef\x0c\xb4\x9b\xde\x1a\x25\x7b\x1e\x49\x3a\x00\x73\x0a\xef\x73\x14\x16\xa1\x2a\x33\xe1\xf6\x3a\x56\xfc\xe5\x99\xe6\x55\xb6\x4f\xa7\xb0\x20\x60\x8d\xb3\x7d\x0c\x83\xbb\x13\xe4\xd4\xaf\xa0\x67\x07\xec\x08\x89\x26\xac\x9a\xc2\x85\xf3\xf1\x97\x76\x4b\xbe\x8b\x77\xfb\x30\xea\x83\x37\xf1\xd2\xd1\x0a\x43\x29\xb8\x20\x28\xf8\xb9\xc4\x74\xcc\x49\x0a\xb4\xc6\x2d\x8e\xf6\x81\x0c\x63\x42\x58\xbf\x90\x7a\x26\xbc\x31\x12\x04\x56\x57\xae\x6d\x2c\xa6\xf0\x6b\x63\x84\xa3\xa9\xa0\x65\x93\xda\x9c\x48\xdb\x38\x0b\x03\xd7\x5a\x60\x27\x8a\x29\xa1\x68\xc5\x61\x92\x49\x33\xca\xc8\x1d\x64\x8b\xf8\xa8\xe5\x5e\x6a\xa9\xbc\x4b\xe5\x43\x34\x87\xad\x46\xe7\xe6\x38\x4f\x28\x06\x8c\x5c\x71\xa3\xf1\x9e\x85\xeb\x67\x23\x15\x14\xb2\x8b\x15\x26\x99\x4e\xef\xb1\xeb\x39\xc0\x2b\xa1\x18\xaa\x58\x21\xbc\xe6\x89\x20\x0b\x76\xc0\x07\x1c\x4b\x99\x63\x22\x72\x83\x22\xdb\x24\xf8\x59\xda\xce\x7e\x8b\x37\x74\x42\xc2\x4c\xf0\x33\x07\x60\x67\xb1\x5f\xb0\x89\x4a\x24\x5a\xaf\x50\x96\x3c\xa7\x5c\xcc\xb0\xab\x42\x72\xa9\x10\x48\x0f\x73\xdc\x0f\xfc\x9b\x3f\xe3\x96\xb8\xb5\x86\x1a\x19\x57\x4e\xbc\xac\x69\x76\xfc\xcb\x1b\xd6\xa5\xb4\x70\x2f\x55\x46\x07\x24\xe8\x62\x28\x94\x3e\xba\x78\xf6\x2c\x05\xd9\x97\x16\x21\x4c\xfa\x01\x72\xc2\xa3\x80\x47\x76\x85\x95\x85\x8b\xfc\xe4\xbb\x45\xa2\x20\x86\x35\xc8\x3c\x58\x2c\x85\xa1\x3f\x18\xba\x6f\x3a\xeb\xe0\x6d\x9c\xf2\x87\x43\x96\x10\xcb\xc7\xea\xb9\xd2\x5e\x52\x16\xdd\x71\xc8\x8e\xb5\x15\x01\x59\xeb\xbc\x0f\xe0\x8b\xd6\x37\x59\x8a\x15\x59\x2a\xd6\x25\x9f\x4b\xb7\x81\x98\xae\x07\x2b\xed\x6b\x28\x82\x09\xf6\x2a\xaa\x76\x6c\x94\x20\x9b\xaf\xa2\x31\xf2\x81\x3e\xbb\x62\xb4\x7f\x21\xba\x9d\xc6\x17\x24\xa1\xcf\xd7\xc3\xb3\x7c\x51\x91\x32\xf1\x33\x07\x5e\xc0\x1e\xbb\x54\x20\xa2\x4e\x47\x08\x03\x87\x5f\xab\x79\x2e\x53\xb2\x32\x49\x08\xdc\x88\x43\xa3\xad\x8d\x99\x90\xae\xe3\xda\x3a\x3f\x31\xe4\x23\xa6\xc3\xe7\xc0\x73\xe4\x95\x9d\xdf\xa2\xca\x9d\x2c\x73\x1f\x35\xfa\xc3\x43\x9f\x82\x47\xe2\x91\xb3\xf9\x8a\x77\xef\x5e\x1a\xc4\xb5\x2b\xcb\x13\x90\xce\x9f\xa8\x52\x5b\x2b\x67\xfe\x14\xb0\x40\x22\x23\x1e\x6b\x23\x9e\x19\xf9\x25\xb5\xa6\x33\x11\x8f\x0e\x61\xe0\x84\xd1\x3c\x0a\x7a\x8e\x10\xa6\xa9\x72\x7c\x82\x24\x69\x59\x88\x2e\x72\x3c\x24\xc3\x86\xfe\x68\xef\xf7\x1c\x09\xff\x0e\xa5\x16\xc1\xee\x96\x4c\xfd\xf3\xa3\xaf\x21\x64\x66\xf0\x90\x84\x85\xb5\x3a\x95\x0c\xfa\x30\xc5\xcf\x23\x71\xfb\xc2\x67\xe6\x9f\x24\x79\x61\x9a\x3e\x0f\xae\x68\x77\xf6\xb7\x87\x1a\x19\xe4\x52\x21\x08\xb3\xa8\x38\x28\x26\x11\x9a\xc5\x76\xdb\xf6\x17\x19\xce\x04\x4a\x4f\x62\x7c\xfa\x41\xf2\xe0\x91\x23\x28\xba\xc7\xcd\x57\xa3\xea\x1e\x37\xcf\x19\x16\x94\x42\x9a\x47\xe4\xed\x0e\xb3\x7d\xc7\xcf\xa2\x28\xc9\xd9\xad\xc1\xdd\xe3\x66\x14\x0f\xc1\xc1\x1a\x6e\x47\xea\x62\xe0\xbb\x88\xf2\x7b\xb6\xc1\x01\x9e\xef\x55\xf2\x17\x57\x9d\x0a\x99\xf8\x84\x64\x2b\xbc\x8c\xca\x51\x3f\xba\x01\xbf\x9a\x83\x8c\x06\xc4\x50\xee\x01\xff\x5d\x49\xc3\xb9\xad\xb2\x72\x76\x94\x96\x5c\x85\x35\x3e\x94\xf1\xa7\x65\x47\x2b\x2c\xe0\x0a\x15\x88\xb9\x43\x03\xa2\x2c\x73\xae\xa0\x70\x77\x43\xa9\x3d\x9c\x50\x4e\x45\xb5\x9a\xc2\x4a\x18\x29\x66\x39\x36\x0a\x6f\xd1\xd5\x10\x77\xa7\xc4\x03\xec\xa3\xa8\xa6\x97\xeb\xd0\x93\x1b\xff\xbc\xc9\x84\x47\x48\xbc\xd9\x73\x9d\xe7\x7a\xed\xa9\x21\xda\x59\x9e\xfe\xe3\x76\x3b\x1c\x7d\x2d\x7c\x97\x4a\x42\x41\x0f\x17\x8d\x87\x02\x8b\x56\x67\x0b\xad\x69\x12\x5c\xa2\x94\xf4\x45\xcc\x31\x1d\x70\xd7\x79\x6a\xdd\xb6\x16\x5f\x11\xec\x7b\x49\x21\xe4\x30\x48\x48\x57\x01\x41\x9d\x29\xde\x83\x31\x74\x26\x5b\xf1\xe5\x1a\x67\xfd\x37\x79\x57\xd4\x41\xd4\xb5\x43\xb5\x51\x41\x64\x7c\x16\xd3\x2c\x1b\x0e\x96\xf6\x88\x1d\x08\x83\xfb\x1c\x8f\x86\xe4\x38\x70\x34\xd1\xa3\xe3\xd1\x18\xd4\x95\xc2\x58\x34\xbd\x0f\x8c\x9b\x2c\x94\x41\x67\x24\xf2\xa5\x12\x92\x4f\xb5\x15\xe8\xc7\xd6\xec\x62\x3c\xe8\xbe\xe1\x3d\xb6\x65\xf5\xe9\xee\xad\x12\xe1\x3e\xb3\x98\x56\xc6\x3b\xe0\xcd\x06\xfd\x07\x1c\xd4\x80\x33\x8a\x82\x44\x3d\x10\xd2\xc8\x6d\xeb\xe6\xcd\x2f\x0d\xf2\xa7\xee\xf4\xe8\xaf\x67\x57\x3f\x5f\xfc\xfc\xc3\xf8\x92\x4d\x5c\x70\x5c\xd1\x66\x2d\x8c\xaa\x5b\x43\x48\xd2\x5d\xd9\x9b\x2b\x1a\xa3\x2d\xff\x10\x7b\x42\xee\x82\x89\xe3\x5d\x3c\xf5\x59\x34\xda\x95\xbb\x3e\x2d\x08\xf8\xb8\x57\xee\xe8\xbc\x59\xbb\xc7\xbf\x95\x27\x87\x0c\xdd\x70\x8e\x81\x31\xd3\x65\x9b\x61\x69\x30\x25\x25\x4e\x0c\x96\xb9\x48\x3b\x83\xf0\x9b\xa5\xc7\xa3\xf3\x2c\x6c\x25\xf7\x48\xfa\x18\x6b\xb7\x17\x86\xdf\x2d\x5b\xad\x15\x9d\x91\x06\x43\x7d\x05\x57\xd6\xab\x10\x97\x32\x71\xbd\x03\xce\x3a\x14\x23\x69\x0f\x92\x78\x4a\x31\xc3\x2e\x75\x95\x67\x44\x1e\x85\x54\x70\x6b\x7d\x5d\xdf\x97\x1c\x0f\xa8\x65\x7f\xee\xaf\xa6\x88\xe7\x0f\x6c\x25\xd1\xe5\x31\xd0\x2d\xf4\xb8\xc8\x42\x26\xc8\x9b\xff\x23\x50\x72\x16\x45\xac\x7a\x37\x6f\x08\x29\xaf\x8f\x1b\x1a\xcb\xc7\xf1\x25\x67\xfb\x09\xe7\x30\x61\xb9\x2c\xa4\x4b\xe4\x42\x69\xd3\x49\x52\x54\xe9\x10\xd5\xf1\x12\x9f\x25\xa0\x4f\xfb\x85\x14\xba\x15\x3d\xb8\xb1\xd8\xd3\xa5\x50\x0b\x24\xc3\xd5\x7f\x6d\xbd\xad\x11\xd7\x05\x1c\x1b\xd9\xcf\x37\xbe\x83\xa0\x06\x35\x85\x0b\xa2\x42\xaa\xc5\x18\x95\x60\x42\x6c\x92\xeb\x45\x62\xe5\xef\x03\x74\xf0\xe4\x53\xc8\xf5\xe2\x5a\xfe\x4e\xaa\xcb\x37\x8c\xae\x9c\x95\x59\x4c\x79\x78\xfd\x34\x44\x0d\xed\xc8\x87\x17\x13\xf8\xeb\x8b\x3b\x78\xf7\x8f\xda\x5d\x5a\xa1\x21\x0f\x90\xcb\xe0\xa5\x7f\xcc\x6c\x1a\x27\x80\x9f\xf0\x7b\x7f\x76\x2c\xf1\x05\x16\xda\x6c\xc6\xd3\xef\xe7\x8f\x67\xe1\xaf\x7f\xfb\xfb\x04\xfe\xf6\xe2\xff\xfd\xfd\x8f\x65\x83\xee\x4a\x5d\x75\x3e\xa4\xde\x61\x21\xcc\x1d\x49\xff\x8b\x17\x13\xf8\xff\x2f\xe8\xdf\x1d\x14\x32\xcf\xa5\xc5\x54\xab\x56\xbc\xfc\xf5\x78\xe1\x62\x7f\x52\x1a\x5d\xa2\x71\xb2\x33\x48\x8e\x96\xba\x65\x57\x7d\x8b\x88\x77\x1d\x42\x93\x88\xef\x1c\x68\x80\xc5\x66\x92\xc3\xb6\x3b\x9a\xee\x4c\xf3\x89\x20\x0b\x2e\x5d\x2d\x1a\x3d\x87\x1b\x23\x56\xd2\xc2\xac\x92\x79\xd6\xdf\x69\xc0\xac\x78\xb3\xc5\x62\x1c\x65\xb2\xea\xe3\xb9\x63\xb8\xd4\xde\xc5\x13\xcc\x3a\xf7\x4f\x50\x34\xef\xbf\x8d\xef\xc0\x1f\x1e\xa6\x85\x54\xa1\x9a\x4e\x7f\x88\x74\xa0\x36\xc7\xa4\x46\x3f\xcd\x5b\x81\x2e\x73\x16\xeb\x9d\x61\x16\x39\x4b\x7b\xa5\xcf\x03\xe5\x91\xce\xea\xe6\x93\x4a\x9a\x4c\x6d\x68\x98\xe0\x14\x5c\x6f\x0e\xf9\x51\x2d\x7c\xc7\x06\xee\x25\x97\x9b\x68\x2c\xe7\xd7\xa9\x4a\xbb\x65\xc8\xfd\x0c\x93\x14\x73\x3a\x83\xed\x00\x37\x8f\xb2\xb5\x6d\xc7\x26\x3c\xe1\xc1\x0c\x94\x1e\xd7\xd3\xc2\xd8\x5b\xfd\x64\x2c\x94\x31\x44\x1c\x6c\xb6\x0a\x37\xe3\x7e\x54\xb9\x0e\x35\x57\xdf\xb9\x70\x28\xe7\x3c\x42\x42\xad\x87\x78\x89\x5e\xa1\x31\x32\xcb\xb0\x2b\xde\x22\x0a\xdb\xef\xf2\x9a\x86\xc0\x66\x69\xf4\x69\xda\xdd\x5e\x63\x37\x2a\x91\x36\x29\xab\x59\x2e\xbb\x7e\xfb\xc0\xef\x0a\xcf\x8d\x95\x43\xff\xf4\x90\x62\x55\x5e\xf8\x28\x2b\x35\x21\x73\xc1\xb6\x65\x86\xb0\x92\x3e\x41\x46\xe7\x90\xc2\xa9\x19\x86\xc7\x1e\x98\xc1\x6c\x03\x42\x6d\xb4\xea\x79\xca\xc7\xb4\xc6\x44\x37\xce\xc2\x03\xeb\x01\x77\xe3\x71\x9e\x9b\x4b\x78\x1c\xc5\xa8\x8c\xfe\x3f\x09\x6f\xa1\xf7\x6b\x78\x74\x10\xf8\xb7\x6c\x70\x36\xf1\x4e\x48\xf8\x2b\x2c\xe8\x09\xbc\x3c\xa5\x7f\xa6\x58\x1a\x5e\x69\xb5\x22\x83\x1f\x82\x97\x06\x89\xd3\xe3\xa3\xee\x83\x7c\xfd\x49\xc2\xee\x7d\x0e\xdb\xa8\x6a\x1e\x47\x05\xe9\x35\x97\x31\xbb\x67\xd0\x96\x5a\x59\xec\x6b\xe3\xdb\x23\x9b\xf3\xba\xfb\xf9\x9b\x30\x1e\x33\x35\xad\xcc\x4f\xcc\xc1\xd5\xb9\xe3\xa5\x73\xa5\xff\xcd\x2b\x8f\x9a\xef\xb6\x29\xbc\xa2\x5b\x86\xfb\x7e\xda\xdf\xfb\x8b\x9d\xaf\x9d\xf0\x75\x60\x9a\xa1\xd0\x9d\xd2\x50\x36\xa4\xb5\x71\x67\x51\xad\xa4\xd1\x8a\xed\x67\x4c\xbd\x75\x75\x54\x84\xc8\xf4\xbc\x59\x02\xbf\x84\x25\x63\xa2\xfc\xd7\xe7\xff\xb8\xfd\x61\x74\x88\xcf\xb3\x8f\x8b\xef\xb3\xd9\x22\xb1\x28\x4c\xba\x24\xce\xa2\xd1\xad\x0b\xc5\x9d\x8a\x1b\x56\xd4\x46\x77\xb7\xb4\x1c\xb7\x2f\xca\xd7\x3b\x27\x03\xf1\x01\x91\xb2\x7f\x33\x7d\xed\x5b\xe9\x89\x37\x12\x91\x56\x5f\xd9\xbe\x55\xb9\xe7\x37\x88\x5e\x1f\xe8\x97\x0b\x12\x39\x85\x37\x4c\x41\xf3\x93\x37\x5c\x36\x21\x60\xc7\x12\xd0\xff\x68\xfb\x78\x1a\xda\xdd\xd0\xb1\x7f\xff\xb8\x87\xb8\x7b\x0f\x1b\xfb\xde\x93\xd2\xe4\x47\xaf\x19\x8f\x7f\x32\x1b\x62\x87\xba\xfd\xfa\xab\x13\x31\x61\xb7\xfe\xd9\xc3\xc3\x34\xab\x8a\x62\xc3\xb3\xb6\xdb\x67\x64\x7e\xda\xb1\x8f\x56\xfd\xfa\x13\x1e\x8d\x27\xbf\xcb\x32\xc1\xcf\xdc\xc2\xe3\x5b\x1b\x7a\x9e\x56\x9d\xf3\x3c\x3a\x63\xef\x85\x5b\x9e\xb6\x77\x70\x2c\x2a\x91\x65\xf1\x2d\x57\x1f\xa6\x33\x9e\xb6\x73\x70\x9d\x86\xff\x91\x25\xbc\x19\x3a\x18\x6d\x6c\xa1\x37\x29\xb6\xea\xf5\x20\x7c\x13\x9a\x2d\xaf\xbd\xa3\xff\x64\xfe\x0e\x60\x4c\x32\xb4\x4e\x2a\x46\xf5\x25\x24\xb0\x07\xf4\xba\x81\xd5\x9a\xd1\xc2\x30\x92\xd6\x78\x59\x46\x7a\x51\x75\xe7\x51\x63\x32\x05\x2e\x42\xab\xd7\x39\x4d\x26\x85\x93\xae\x55\x08\x61\x4a\x02\x3c\x2e\xcd\xc6\xe9\x0c\x9b\x5d\x03\x94\x1c\x90\xf0\x9d\xf9\xc1\xf3\x79\x07\x64\x90\xfd\xe7\x49\x9b\xbd\xfe\xa3\x1e\xf9\x88\x2d\xee\x2c\xfc\x9e\x8a\xde\xab\xd8\x0a\x4f\x12\x8e\x7a\x74\xf4\x0e\xe7\xd2\xba\x44\xcf\x19\x91\x4d\xb8\x0d\x96\xef\x28\xe1\x1c\x9a\xae\x83\xed\x4d\x1b\x37\x97\xd7\xc5\x2c\xff\x6b\x61\xbe\x89\x20\x40\x89\xfb\xce\x5d\x43\xef\xbd\x2f\xc2\x60\x7b\xe5\x10\x1c\xec\xdd\xdf\x30\xe8\x3a\x54\xbb\x3f\x74\x40\x37\x61\x67\x7b\x09\x07\x2a\x6d\x6f\x20\xb8\x71\xc4\xc6\xd5\xf9\x7f\xdf\x5e\x5c\x9d\x27\xbf\xfe\x78\x71\xfd\x53\x72\x76\x7b\xf3\x63\xab\x8a\x10\xa9\xfd\xe6\xee\x9b\xff\x0d\x00\x00\xff\xff\xf8\x5d\x8c\xdc\xa9\x55\x00\x00")
+var _wski18n_resources_en_us_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x7d\x6b\x93\xdb\xb6\xb2\xe0\xf7\xfc\x0a\xd4\xa9\x53\x15\x7b\x4b\xa3\x71\xce\x3e\xea\xd6\x6c\x92\xad\x39\xf6\x24\x99\x1b\xbf\x76\x66\x9c\x6c\xd6\x76\xd1\x10\x09\x49\xb8\x43\x02\x0c\x00\x6a\xac\xb8\xb4\xbf\x7d\xab\x1b\x0d\xbe\x24\x92\x90\x6c\xef\x59\xe7\x43\x34\x24\x80\x7e\xa0\xd1\xe8\x17\xc0\xb7\xdf\x30\xf6\xe9\x1b\xc6\x18\xfb\x9b\xcc\xfe\x76\xc1\xfe\x56\xd8\x55\x52\x1a\xb1\x94\x1f\x13\x61\x8c\x36\x7f\x9b\xf9\xb7\xce\x70\x65\x73\xee\xa4\x56\xd0\xec\x0a\xdf\x7d\xc3\xd8\x6e\x36\x32\x82\x54\x4b\x3d\x30\xc0\x35\xbc\x9a\xea\x6f\xab\x34\x15\xd6\x0e\x0c\x71\x4b\x6f\xa7\x46\x79\xe0\x46\x49\xb5\x1a\x18\xe5\x77\x7a\x3b\x38\x4a\x5a\x64\x49\x26\x6c\x9a\xe4\x5a\xad\x12\x23\x4a\x6d\xdc\xc0\x58\x37\xf8\xd2\x32\xad\x58\x26\xca\x5c\x6f\x45\xc6\x84\x72\xd2\x49\x61\xd9\x23\x39\x17\xf3\x19\x7b\xcd\xd3\x7b\xbe\x12\x76\xc6\x2e\x53\xe0\xa6\x9d\xb1\x3b\x23\x57\x2b\x61\xec\x8c\xdd\x54\x39\xbc\x11\x2e\x9d\x3f\x66\xdc\xb2\x07\x91\xe7\xf0\x7f\x23\x52\xa1\x1c\xf6\xd8\x20\x34\xcb\xa4\x62\x6e\x2d\x98\x2d\x45\x2a\x97\x52\x64\x4c\xf1\x42\xd8\x92\xa7\x62\x1e\x4d\x8b\xd6\x43\x94\xdc\xad\x05\x7b\x55\x0a\xf5\xfb\x5a\xda\x7b\xf6\x0c\x89\x29\x00\x85\x3b\xad\xf3\x77\xea\x9d\xba\xd3\x6c\x21\x56\x52\xb1\x07\x6d\xee\xa5\x5a\xb1\x07\xe9\xd6\xec\xc1\xde\x7b\xc2\x67\xcc\x54\x1e\xc1\x6f\xeb\x67\xdf\xb2\x54\x17\x05\x57\xd9\x05\x0c\xf0\xce\xfd\xbd\x69\x0e\x0f\xee\xd6\xd2\xb2\x07\x99\xe7\xc4\xbb\x16\x7c\x6e\xad\x70\xb6\x45\xab\x54\xac\xe0\x4a\x2e\x85\x75\xf3\x2d\x2f\x72\xa6\x4d\xeb\x41\x91\xbf\x53\xd7\x4b\x96\x56\xc6\x00\xca\x99\x34\x22\x75\xda\x6c\x59\xa6\x85\x55\x8e\xad\xf9\x46\x30\xae\xb6\x75\x17\xb6\x94\xb9\x98\x35\xe8\xb0\xd2\x48\xe5\x2c\x73\x80\xd2\x5a\xe4\x25\x2b\x84\xb5\x7c\x25\xe6\x1e\x51\xc1\x0a\x6d\x1d\x92\xa3\x15\x7b\xe0\x5b\xcb\xf4\x92\x55\x16\xf9\x50\x0f\xe2\x74\xa0\x84\xab\xec\x5c\x1b\x56\xa9\x21\xca\xb8\x11\xc8\x94\x0e\x4b\x5a\x7f\xb0\xb3\x82\x95\xdc\xad\xcf\x9d\x3e\x6f\xe8\xe4\x45\x1e\xd7\x8a\x9d\x65\xf5\x8b\xac\x9e\xcb\x03\x03\x04\x0c\x0f\x3f\x8d\xc4\xa2\x52\x9f\x83\xce\x3b\x75\x59\xb9\x35\xac\x9a\x14\x25\xfd\xe2\x9d\x6a\x86\x36\x82\x67\x96\xa5\x46\x64\xd0\x80\xe7\x96\x2d\x8d\x2e\xd8\xdf\x7f\x79\xf5\xe2\xea\x7c\xfe\x60\xef\x4b\xa3\x4b\xcb\x16\x5b\x96\x89\x25\xaf\x72\xf7\x4e\xbd\xda\x08\xf3\x60\xa4\x13\xe1\x11\x4b\xb5\x5a\xca\x15\xce\x39\xac\xd4\xa7\xcf\xaf\x2f\xde\x29\xc6\xda\x24\x9c\x9d\x51\xa3\xef\x5b\x8d\x7f\x1c\xa1\xff\x95\x21\xe9\xdc\x32\x9e\xe7\xcc\xad\x8d\x18\x19\x9c\x97\x72\x0d\x02\xf4\xcb\xab\xdb\x3b\x76\x76\xc6\x2b\xb7\x66\xbf\x5e\xfd\xc1\xce\xce\xea\x45\xcc\x5e\x5e\xbe\xb8\xba\x7d\x7d\xf9\xf4\x6a\x10\x6a\xc4\x32\xb7\x6b\x6d\xdc\xb8\xce\x7a\x6d\xf4\x46\x66\xc2\x32\xce\x6c\x55\x14\xdc\x00\x97\x41\x8d\x81\x48\xef\x09\xea\x42\x80\x8c\x07\xe5\x76\x1e\xa6\x5a\x64\x6c\xc1\xad\xc8\x80\xe4\x80\x63\x6b\x6a\xd9\x1f\x97\x2f\x9e\xcf\xe3\xf1\x1d\xd6\x4b\x97\xcc\x69\x9d\x33\x2b\x1c\x73\xda\x2f\x4d\xe2\xea\x56\x57\x86\xe9\x52\xa8\x07\x5c\x58\x25\xa9\x59\x5a\x95\xbc\xbb\xd6\xe3\x71\xd9\x08\x63\x41\xbb\x0f\x31\x4f\x2a\x87\x6a\x8e\xda\x31\x55\x15\x0b\x61\x80\x77\xf5\x84\x47\xc3\xb2\x5b\x95\x8e\xd3\xed\x34\x83\x46\x9e\xd8\x66\x72\x6a\x62\x17\xc2\x3d\x08\xa1\x58\x9a\x4b\x60\x3b\x57\x19\xb3\xc2\x6c\x84\x89\x21\x18\xf7\xb7\x78\x1c\x5a\xd3\x0b\x70\x82\x28\xe0\x03\xbd\x3c\x84\xdd\xde\x54\x40\x3f\x5d\x02\x33\x79\xd0\xfa\xd8\x1d\xa6\x28\x34\x47\xd1\x01\xb5\xf0\x4c\x2e\x97\x02\x15\x7a\x50\xb8\xa6\x52\xb0\x75\x23\x3a\x17\x5d\x1d\x04\x8f\xf6\x9f\x8c\x2c\xe0\xe8\xa6\x6d\xe5\x75\xfa\x18\x67\xa5\xd1\xff\x21\x52\x07\xeb\x9d\xbd\xbe\x79\xf5\xef\x57\x4f\xef\xa2\xe5\x24\xb0\x7a\x60\x9e\xde\xd0\xeb\xfd\xd5\x8b\xca\xd2\x0b\x44\xac\x3c\xc4\xc2\x32\xa2\xd0\x1b\x61\xf7\x61\x3e\xac\x65\xba\x66\x0f\xc2\x08\x9a\x61\x91\x79\xa5\x0d\xab\x26\x70\x05\x25\xa1\x25\x00\xf5\xa4\xd7\x66\x46\x26\x72\xe1\x60\xb2\x0f\x13\xd5\x19\x0c\xc4\x07\x0d\x90\x9e\x50\x04\x5a\x0e\x3f\x1d\x9c\xad\x2f\xb9\xbb\x1d\x1e\xe9\x90\x34\xb0\x47\x5a\xe5\x5b\x34\xaf\x2c\x5b\x6a\xd3\x62\x0f\x1a\x7f\x28\xa4\x85\xce\xc4\xe3\x68\xb9\x11\x1f\x47\xf6\x81\x2b\x7c\xc9\x08\x93\x0e\x73\x6b\x96\xc7\x0a\x4d\x04\x20\x0b\x0a\x99\xaf\x44\x36\x0e\x11\xb4\x7c\xe0\x2e\x0a\xc9\xb2\x52\x68\x36\xe3\x8e\x6c\x07\xcc\x31\xe8\x05\xf6\xa7\xc7\xa3\x27\x05\xfe\xe1\x00\xd3\x5b\x93\xea\xdb\x89\xec\xac\x33\xbb\xe3\x2c\x58\xe6\x7c\x95\xf0\x52\x26\xb0\xbd\x0f\xd0\xef\xf7\xa7\xcb\xd7\xd7\xec\x03\xec\xff\x1f\x22\x47\x1c\xdf\x88\x5a\x83\xfe\x76\x75\x73\x7b\xfd\xea\x65\xd4\xb8\x95\x5b\x27\xf7\x62\x68\x71\x83\x5d\xa2\x8d\xfc\x0b\x51\x67\x1f\x7e\xbd\xfa\x23\x66\xd0\x54\x18\x97\xc0\xec\x0c\x8c\x0a\x8b\x06\xb4\x37\x2c\xd9\x39\x34\xc6\xa9\x8c\x19\x18\x4d\xb1\x81\x51\x5b\x76\x1a\x7b\x14\x2c\x3d\x69\xfb\xa6\xe1\xc4\x62\x41\x38\x3c\xcf\xf5\x43\x42\x63\x0c\x39\x9f\xd8\x28\x98\x94\x36\x62\xd4\x66\xf9\x0e\x8c\x88\x7c\x71\xba\xbf\x0f\xce\xc0\x1c\x13\x1c\xed\x9d\x42\x98\x95\x60\xcb\xca\xb8\xb5\x68\x2b\x04\x24\xdb\x32\xbd\x11\x86\x49\x07\xda\x41\x9b\x6c\x4a\xc7\x23\xad\xa5\x11\x1b\x29\x1e\x06\x50\xb2\x6b\xfd\xd0\x02\x53\x9b\x7b\x08\xb3\xcc\xb9\x8a\x80\x70\x2f\xb6\xd1\xd2\x70\x2f\xb6\xb1\xc2\x80\xfc\x4f\x48\x87\x0c\x8c\x8d\x6d\x6a\xfd\x52\x3b\xe2\x0e\xf6\x14\x56\x70\x73\x2f\xb2\xa0\x85\x22\x20\xd2\x38\x09\xe8\x8b\x21\x62\x08\x14\x36\x99\x1e\x31\x28\x96\x09\x81\x08\xcd\x62\x59\x53\xfb\x10\x03\xe3\x36\xef\xa3\x89\x9e\xc0\xd0\x9b\x14\xb9\xb0\x36\x70\x3b\x62\x68\xeb\x8c\x1c\x1c\xd9\x4f\x5d\x65\x51\xcc\x97\x52\x89\x0c\xf6\x73\x27\x8b\xda\xd2\x8e\x80\xe0\xcc\x30\x13\xf0\x1d\xd3\x95\x2b\xab\x18\x64\x11\x9f\x64\x23\xcc\x42\xdb\xa1\x21\xe9\xed\xb1\x83\x96\xdc\xf0\x62\x60\x48\x7c\x27\x9c\x30\x6c\xc3\xf3\x4a\xe0\xc6\x0f\x7a\x98\xfd\x76\xf9\xfc\xcd\xd5\x07\xb0\x0b\x0a\x7e\x24\xa8\xb1\xd5\xf8\xe1\xa7\xeb\xe7\x57\x1f\xc0\x43\x76\x5c\xa2\x6d\x7d\x08\x83\x7f\xbf\x7d\xf5\x72\x1a\x34\x2a\xe4\xa4\x90\x16\xac\xfe\x04\xf6\x92\xe1\x9d\xe6\x6e\x2d\x18\xef\xb8\xfd\x0c\x74\x81\xb4\x4c\xe9\xe0\xb0\x57\x46\x64\xf3\x77\x2a\x1e\xa2\x77\xb2\x47\x20\xc2\x76\x09\x4d\x3e\x0f\xce\xd4\x72\x03\xda\xea\x36\xa7\x81\xa2\x78\xc1\x58\x3c\xb5\x4f\xcf\xdb\x4f\x9f\xe6\xf0\x7b\xb7\x7b\x3f\xf3\x26\xf2\xa7\x4f\x73\xab\x2b\x93\x8a\xdd\x2e\x0a\xa6\x9f\xb0\x29\x98\x30\x6b\x61\xae\xac\x70\xa7\xc1\xaa\xd9\x33\x05\xad\xc3\x47\x20\xb1\x7e\x70\x3a\x9d\xa5\x5c\x3d\x24\x4e\x28\xae\x5c\x22\xb3\x29\x0c\x80\xc7\x3f\x73\x27\xc0\xca\xbc\xc3\x4e\xec\xfa\x59\xc0\xa6\xaa\x64\xf6\x99\x88\x70\x8c\x69\x27\x4e\xdf\x0b\x75\x0c\x2e\xbe\x1f\xc3\x7e\xa7\xcd\x45\xa5\x0a\x6e\xec\x9a\xe7\x49\xae\x53\x9e\x0f\xc0\x7d\x13\x5a\xb5\x6c\x74\xd2\xcc\x64\xbb\x63\x6f\xd2\x16\x91\x00\x95\x70\xe0\xe7\x9c\x0c\x52\x2a\x27\x8c\x12\x8e\x71\x07\xa2\x57\x99\x7c\x82\xd6\xc6\x8c\x49\x52\xae\x52\x91\xe7\x83\x46\xc4\xab\x5f\xe7\xec\xa9\x6f\xd3\x84\xbe\xa0\x67\x2c\x80\x25\x97\xc3\xa3\xb7\x22\xeb\x99\xcc\x48\x35\x14\x65\x2e\x9c\x60\x94\xfd\x58\x56\x79\xbe\x9d\xb3\x9b\x4a\xb1\x0f\xfb\xce\xe3\x07\xb0\x0b\xbd\xf3\xcd\x4a\x6e\x20\x28\x9a\x6f\x09\x4b\x91\x91\x53\x15\x8b\xaa\x0f\xfc\x25\xd6\x71\x57\x0d\x19\xbe\x67\x67\x67\x67\x3f\xfc\xf0\xc3\x0f\x87\xd3\x03\xb7\xd8\x95\x41\x03\x68\x18\x05\x15\xe9\x14\x59\x0c\x8f\x02\x6f\xb2\x2e\x73\xc6\xc8\xab\xd4\xe9\x93\xdd\xee\x1b\x0f\x64\x74\xc2\x43\xc0\x24\x62\xca\xa3\x01\x4e\x31\xb0\x03\xf3\x04\x16\x52\xda\x26\xc1\x80\x1c\x9a\x0f\xa0\x76\x13\xee\x12\xb0\xde\x07\x80\x7e\xfa\x34\x4f\x8b\x6c\xb7\xa3\x30\xde\xa7\x4f\x73\xe8\xe8\xb6\xa5\xd8\xed\x50\x59\x42\xdf\xdd\xee\xfd\x7c\x3e\x0a\x1b\x2c\x02\xb7\x25\x71\x11\xd9\x44\x4a\xf0\xd3\xa7\xf9\xbd\xd8\x12\x00\x40\x72\xb7\x7b\xcf\xd6\xdc\xb2\x05\x44\x45\xdb\x04\xd7\x4b\x24\x1e\xfa\x70\x0e\xf1\x59\x78\xcf\x0e\x22\x30\x9f\xcf\x27\x41\x54\xea\xcb\x93\x58\xa9\x63\x88\xac\xd4\x14\x99\x41\x8e\x86\x08\x1d\xa5\x33\x13\xa5\x50\x99\x50\xe9\x31\xec\x6c\x3a\x9d\x0e\xa7\x59\x22\x83\x3c\x7d\x76\x10\xcc\xe7\x08\xce\x61\x2c\x40\x33\x54\x46\x4c\xeb\x39\xbd\x1c\x20\xfd\x5f\xb9\x4b\x04\x82\x8e\x13\x94\xcf\x9b\xc2\x4a\x7d\x9d\x49\xac\xd4\xb1\xd3\x58\xa9\xe8\x89\x7c\xd3\x4b\x85\x64\x87\x31\x3b\x5d\xfb\x53\xd0\xe2\xd4\x6d\x07\xa5\x0b\x20\xb6\xaa\x13\x46\x91\x61\x59\x65\x60\x2e\x09\x2e\x09\x0e\x90\xf7\x15\x25\x2e\x10\xb9\xd4\x95\x82\xe0\x32\x60\x95\x91\xb2\x1a\xa0\xf2\x59\x48\x12\x1c\x54\x92\x94\x89\xc0\x72\x0a\xc0\xab\x95\x87\x08\xa5\x02\x81\x40\x8a\x62\x60\x77\xfa\x0d\xb2\xc4\x2d\xd2\x02\x73\xda\x66\xfd\x28\x19\x14\x22\x4c\x28\x0b\x36\x80\x39\x55\x85\x60\x11\x47\x9d\xa8\x96\x80\x29\xc6\x56\xb2\x19\xa6\x95\x1b\x93\xab\x9e\x37\xc0\xc3\xd4\x3d\x08\x08\xe3\x46\x1c\x4c\xd2\xfa\x52\x08\x92\x7f\xe3\xd3\x88\xb5\x0b\x35\xb0\x22\xaf\x6e\x6e\x5e\xdd\xdc\x0e\xe0\xfd\x43\xff\x1f\xf3\xcd\x59\xef\x31\xfc\x37\xcc\x23\x61\x4c\x77\xa9\xdd\x2b\xfd\xa0\x12\x30\x16\xa6\x17\x3b\xb4\x02\x8f\x87\x7a\xcd\x59\x2b\xd6\x8f\x29\x14\x5b\x95\x60\xd6\x5a\x76\xfe\x00\xe6\xea\xdc\x6e\xad\x13\x05\x5b\x48\x95\x49\xb5\xb2\x50\x3b\xb2\x92\x6e\x5d\x2d\xe6\xa9\x2e\x02\x0b\xc7\x65\x13\x10\xa6\x6d\x33\x35\x82\xbb\x21\x34\xb1\x4c\x0a\xea\x15\x78\x57\x2c\xb1\x58\x06\xeb\xab\x42\x65\xc9\x05\xbc\x14\xc6\xec\x76\x98\xe6\xf0\xef\x52\x9d\xf9\x17\xf0\x63\xb7\x8b\x45\xc9\xaf\x95\x51\x94\xb2\xbd\x95\xf2\x95\x50\x5a\x0a\x01\x3e\xf5\x46\xdf\x0f\x21\xf4\x13\x9a\xcb\xa0\x2e\x7c\x33\x5c\x90\xd0\x8d\x3d\xac\x45\x2b\xf1\xe7\x7c\x95\x14\xbd\xfa\x3a\xd8\x42\xb0\x3a\xc4\x75\xa0\x52\x89\x43\xd9\xd0\x00\xde\xe0\x81\xd7\x6d\x30\x04\xf2\x36\x30\xf3\x3d\xc8\x23\x8d\x33\x09\x33\x84\x77\x13\xa5\x9d\x57\x76\x03\x00\x5f\xb4\xe3\xc0\x68\x04\x60\x6b\x70\x7a\xc1\x96\xee\x18\xd5\x53\x40\x61\xd1\x43\x6c\xae\xe0\x2e\x1d\xb2\xe0\x81\xc0\x5a\x3c\xa0\x43\x86\x20\xb2\xa0\x4f\xa5\xea\xa7\x20\xfc\x7b\xc2\x01\xab\xad\x10\x4d\x04\x82\xd3\x0a\x5d\xb1\x51\xd1\x1a\xa4\x13\xdf\xf6\x6f\x03\x19\xe3\x44\x50\x10\x00\xc4\x8b\xe7\x72\x68\xeb\xbb\xf6\x6f\x61\x99\xd3\x94\xd4\xa1\x64\x80\x45\xbf\x01\x97\x83\xf5\x65\x10\xe8\x44\xdc\xb9\xcf\x3b\x42\x1f\xff\x33\x86\xcf\x34\xfa\x14\xab\x6f\x8e\x41\xa8\xc7\x57\x5c\xb8\x1e\xa3\x6f\x2d\xf3\x61\x37\xcf\x4a\xf1\xd1\x09\x65\x03\xd2\xe2\xa3\x83\x31\x81\x9c\xcf\x21\xc5\x26\x2b\xe1\x26\x97\xf2\x0a\x0a\x74\xa0\x3c\xd1\xeb\x5e\x91\xf5\x22\x36\xcd\x4e\x06\xfb\x9b\x4c\x5b\xcb\x37\x9a\xa7\x9e\x8a\xc4\x53\x8c\xab\xa7\x86\x36\x80\x5f\x87\x60\x34\xef\x41\x3c\x1b\x2e\x43\x4d\x20\x8d\x8e\x2a\xaf\x35\xed\x93\x7c\xa5\xc0\x6e\x8d\xc2\x24\x19\x95\xc9\x8f\x97\x5c\x1f\xdd\x82\x2d\x6f\xb7\x63\x6f\x6e\x9e\xe3\x1c\x62\xbc\x0b\x97\xd2\xdb\x8e\x9b\xfd\x1e\xd1\x8d\x42\xa4\xe0\x39\x04\xf4\x07\x39\xf7\x22\xbc\x1f\xc3\x60\xce\xee\xcc\x96\xf1\x15\x97\x6a\xca\xab\x37\x26\xf9\x0f\xab\x55\xad\x6c\xd3\x22\x1b\x49\x44\x63\xc2\x41\xaa\xb2\x72\x2c\xe3\x8e\xb3\x17\xc4\x8d\x6f\xd3\x22\xfb\x16\x54\xef\x38\x24\x48\xc8\x07\x40\x24\x34\xda\x24\x56\xfc\x59\x09\x35\x18\xb6\x87\x5a\x5b\xad\xce\x6f\xa9\x55\x77\xb1\xb4\xf4\xbb\x37\x22\x1b\x6d\x81\xb5\x27\x10\x99\xc5\x0e\xa5\x84\x69\x48\xb9\xf2\xa6\xc8\x42\x78\x63\xa0\x5d\x2f\xd7\x08\xd9\x79\x40\xe9\xc0\x98\x73\xf6\x3a\x17\xdc\x0a\x56\x95\x19\x77\xbd\x62\x17\x58\x71\x52\xa5\x79\x95\xf5\xf1\xe4\x50\xd7\xf7\x20\x16\x7d\x08\x93\xb3\x43\x7c\x1a\x17\xd0\xcb\x03\x7a\x04\x58\x43\xbd\xe6\xec\xda\xe1\x2a\x5b\x68\xb7\x46\xcb\xa1\x5b\xc2\x51\x2f\xbc\x99\xe7\x8e\x56\x82\x52\xc1\x05\x8c\x22\x3e\x96\x22\x8d\x59\x49\x84\x6b\x98\xe2\xa0\x1f\x40\x31\x26\x00\xf5\x33\xb1\x87\x21\x5a\x4a\x02\x86\xd5\x95\x6b\x2b\x8b\x39\xfb\xbd\x51\xc2\x41\x05\x43\xb7\x59\xad\x4e\xa4\x6d\x8c\x85\x79\x14\x39\x81\x4d\x09\x78\x51\x4e\x24\x99\x34\x51\x4a\xee\x20\x59\x30\x0b\x35\xdf\x4b\x2d\x95\x37\xa9\xbc\x8b\xe6\x44\xab\x46\xba\x59\xce\x33\xf0\x01\x03\x55\x58\xa3\xdc\xd3\x70\xe3\x64\xa4\x1c\x5c\x76\xbe\x11\x49\xa6\xd3\x7b\x31\x74\x92\xe0\x29\x57\x38\x2a\xd4\x64\x3f\xc3\x86\x4c\x16\x68\x80\x8f\x0f\x0f\xaa\x2d\xe1\x39\x54\x04\x6f\x13\xf1\x51\x5a\x37\x14\x18\xf8\x49\xe6\x82\x51\x4b\xe6\x5b\x4e\xcc\x40\x16\x4a\x0d\x1b\xaf\x44\x0a\x9b\xc0\xcc\x27\x16\x2c\xa7\x9c\x2f\xc4\x50\x86\xe4\x95\x12\x0c\xb4\x53\x2e\xfa\x8e\x7f\xf3\x67\x98\x12\xf7\xa0\x59\x0d\x0c\x33\x27\x30\x8a\x4f\x26\x85\xbf\xc0\xcc\x60\x58\x1c\x7f\x2f\x55\x06\x0b\x84\x64\x91\x12\xa5\x7b\x1b\x4f\x4f\x53\xb8\x75\x07\x11\x44\xfd\x00\x3a\x74\x9e\x60\x4f\xaf\xa0\xb0\x80\xa4\x00\xe1\x35\x8a\x2c\xb8\x35\x02\x69\xb0\x02\xf2\xc4\x4e\xf8\xd1\x7d\xbd\xda\x00\x6d\x71\xc2\x4f\x8b\x2c\x01\x92\x8f\x95\x73\xa5\x19\x74\x83\x22\xe1\xe3\x80\x1d\xab\x2b\x08\x58\x6b\xbd\x4f\xc0\x0b\xda\x37\x59\xf3\x0d\x68\x2a\x60\x29\xd6\x93\x24\xdc\x12\x32\x03\xf0\x3b\xdb\x50\x18\x86\xf4\x55\x10\xed\x50\x28\x01\x3a\x5f\x05\x65\x04\xce\xbf\xc1\x99\x05\x60\xc1\xbb\x9d\x87\xc3\x27\x54\x22\xec\xc7\xb3\xb8\x51\xc1\x6a\xc4\x13\x12\xd8\x01\xb0\x03\xcb\x82\x07\x99\x0e\x23\x8c\x53\x0a\x39\xcd\x5c\xa6\xa0\x65\x12\x72\xdc\x80\x42\xa3\xad\x0d\x91\x10\x3b\xbd\x7e\x82\xcb\x07\x6c\xa7\xdf\x44\x73\xa0\x15\xa6\x8e\x15\x55\xee\x64\x99\x0b\x74\x0d\xfd\xe2\x81\x5f\x64\x91\x60\x37\xaf\xbe\xc2\xde\xdb\x0b\x83\x04\xcf\x04\xa3\x20\x33\x26\x1d\x4c\xab\x63\xa5\xb6\x56\x2e\x00\x0d\xed\x8f\x8c\x10\x0a\x70\x4a\xc5\xad\x5b\xec\x59\x54\xae\x25\xe9\x00\xda\xf6\xb7\x6b\xea\x8a\xed\x6d\xd7\xbd\x90\xf9\x31\xcc\x34\x70\x42\xe8\x78\x4e\x42\x37\xf2\x2e\x72\x71\x88\x87\x0d\xfe\x41\xdf\x77\x65\x9d\x8e\xb0\xd4\x2c\xe8\x4e\x09\x84\x01\x73\xf1\x45\x98\x0c\x98\x1e\xe4\x30\xb7\x56\xa7\x92\xbb\x41\x8c\xcf\x03\x72\x7d\xe6\xc3\x90\xa7\x71\x9e\x9b\xa6\xce\x03\x33\xda\x03\x9c\xbe\x0c\x47\x9b\x58\x2e\x95\x60\xdc\xac\x2a\x74\x8a\x81\x85\x66\xb5\xdb\xb5\xed\x45\x1c\x67\xc6\x4a\xaf\xa4\xc3\xa9\x11\xe0\x07\xbe\x39\x02\x23\x88\x56\x7c\x29\xac\xee\xc5\xf6\x1c\xc7\x62\x25\x97\x66\x0f\xbd\xee\x6b\xd4\xef\xe2\x23\x87\x50\xf1\xac\x19\x0e\x62\x20\x31\x34\x90\x81\x35\x5d\x8e\x34\x44\xc0\xa3\x00\xf2\x31\x1a\x68\x34\x1e\xc3\xf1\x70\x5a\x59\x1d\x0a\x99\xf9\x80\x64\xcb\xbd\x64\xaf\xbb\xa4\x71\xa8\x55\x90\x19\x43\x27\xa3\x19\x62\x82\x06\x23\xfe\xac\xa4\xc1\xd8\x56\x59\x39\x1b\x25\x25\x37\xd4\xc7\xbb\x32\x7e\xb5\x04\xfe\x53\x75\x95\xd8\x08\xc5\xf8\x12\xea\xad\x78\x59\xe6\x5b\x78\x85\xd5\x0d\xa5\xf6\x6c\xa1\x74\xaa\x50\x9b\x39\xdb\x70\x23\xf9\x22\x17\x8d\xc0\xc3\xb9\x98\x30\x62\xb7\x49\x58\xc0\x08\x3a\x40\x93\x87\x4f\xeb\x00\xf9\xb0\xc1\xfb\xf3\x4b\x38\xd9\x4b\x0d\x05\x70\x30\x2c\x0e\x60\x91\x9f\xfe\xe7\x6e\x37\xce\x29\xf0\xbe\x56\xbe\x62\x26\x81\x43\x42\x98\x34\x9e\xf0\x7c\xdb\x95\x2d\xd0\xa7\x09\x70\xf1\x52\xc2\x83\x10\x63\x3a\x60\xae\xc3\xab\xa6\x6c\x2d\x1c\x40\xe8\x5b\x49\xe4\x72\x18\x01\x6c\xdd\x10\x00\x7a\xbb\x37\xc6\x3c\xde\xbf\x7c\x10\x8b\xf1\x9d\xfc\xa0\x25\x41\xd8\xb5\x5d\xb5\x28\x27\x32\x9c\xa8\x69\xba\x4d\x3b\x4b\x3d\x64\xc3\xe6\x7f\x82\xe1\xd1\xa0\x1c\x5e\x1c\x8d\x74\xe8\x38\x89\x36\xf9\x51\xa0\x33\xac\x30\xa3\x67\x93\x9b\x28\x94\x11\xce\x48\x81\x9b\x0a\xf6\xb6\x8d\x16\x18\x87\xd6\xcc\x62\x58\xe8\x58\xc0\x58\x97\x65\x8d\xc9\xee\x1b\xc5\x69\x3f\xb3\x22\xad\x8c\xc0\x9d\xaf\x99\xa0\xff\xce\x0e\x4a\xc0\x25\x78\x41\xbc\x7e\x41\x61\xe4\xb6\x76\xc3\x35\x8b\x72\x83\xbf\x86\xc3\xa3\xbf\x5f\xde\xbc\xbc\x7e\xf9\x73\x7c\xca\x26\x74\x38\x2e\x69\x03\xc7\xaa\x13\xd2\xcf\x09\x70\x7a\x28\x7a\x73\x03\xef\x40\x4e\xa5\x02\xfe\x67\x22\xe7\x90\x70\x78\xc4\x9d\x13\x45\xe9\xb7\x23\xff\x73\xb7\x03\xf7\xa6\xf9\xdb\x82\x86\xf7\xda\x10\x27\xfc\x02\xc9\xc7\x09\x7c\x3f\x89\x18\x16\xd5\x1d\x1d\x60\x6b\x9f\x23\x68\x05\xd4\x59\x26\xdc\x74\x30\x02\x21\xc3\xae\x9c\x89\xd2\x88\x14\xa4\x1d\x0e\x5f\xe6\x3c\x1d\xf4\xd6\x21\xc8\x0e\x70\x74\x9e\xd1\x9c\xc3\x2e\x4a\xce\x58\xb7\x68\x06\xcf\x46\x5b\xad\x15\x94\xaf\x37\x10\xea\xbd\xba\xb2\x5e\xd6\x60\x38\x25\x1e\x3a\xc3\x59\x27\x78\x24\xee\xc4\x89\x53\xb2\x1e\x76\xad\xab\x3c\x03\xf4\xc0\xf7\x62\x6f\x90\xa3\x21\x37\x79\x40\x7e\xe7\x71\x18\x61\xfb\x89\x55\x07\x7c\xc4\x76\xb8\x5d\xed\x67\x63\x40\x57\xe1\x64\x1f\x03\x12\xc3\x2d\x7c\x23\x3e\x07\x28\xf6\x0f\x13\x1a\xf2\xcc\x54\xc3\xde\x39\x26\x3a\x8d\x58\x2e\x0b\xe9\x12\xb9\x52\xda\x88\x29\x91\xf6\x9a\x85\x61\x17\xc4\x0a\x7f\x91\xa3\x5f\x9b\xc0\xb0\x7d\xfa\xe1\x62\xa1\xa7\x6b\xae\x56\x02\x34\xdc\xf8\xfe\xf6\xbc\x06\x5c\x67\x7a\x6c\x20\x3f\xdf\x22\x67\x9a\xa1\xe6\xec\x1a\xb0\x80\x6c\x59\x84\x48\x20\x22\x36\xc9\xf5\x2a\xb1\xf2\xaf\x09\x3c\xb0\xf1\x05\xcb\xf5\xea\x56\xfe\x05\x61\x53\xdc\x8a\x74\xe5\xac\xcc\x42\x6c\xc4\xcb\xa7\x01\x6c\x60\x46\xde\x3e\x99\xb1\xef\x9e\xbc\x67\x2f\xfe\x59\xdb\x55\x1b\x61\xc0\x54\xc4\x7c\x79\xe9\x0f\x4c\x9b\xc6\x5a\xc0\x6b\x02\x50\x62\xa2\x91\x2f\x44\xa1\xcd\x36\x1e\x7f\xdf\x3e\x9e\x84\xef\xfe\xf1\x6f\x33\xf6\x8f\x27\xff\xe5\xdf\xbe\x2e\x19\xb0\xa9\xea\xca\x45\x91\x40\x6d\x23\xf1\x7f\xf2\x64\xc6\xfe\xdb\x13\xf8\xf7\x9e\x15\x32\xcf\xa5\x15\xa9\x56\x99\xfd\x0a\xb4\x60\x55\x40\x02\x37\x07\x08\x03\x35\x15\x13\x9a\x9a\x96\x37\xa8\x18\x5f\x4b\xe2\x6d\x0c\xaa\x26\xc1\xc1\xe6\xcd\x60\xe1\xfc\xeb\x61\xdd\x1d\x54\x77\xa6\x71\x45\x80\x06\x97\xae\x66\x8d\x5e\xb2\x3b\xc3\x37\xd2\xb2\x45\x25\xf3\x6c\xbc\x24\x01\x49\x41\x8a\x13\x64\x63\x94\xca\xaa\x97\x67\x47\x71\xa9\xde\xc6\x43\x6a\x1d\x92\x4a\xf0\x86\x9e\x86\xb3\xe6\x90\xaf\x95\x8a\xd2\xee\xf0\x07\x4f\x27\x92\x78\x88\x6a\x30\xe8\xbc\x16\xc8\x26\x12\xa3\xd4\x0a\xac\xaa\x5e\x8e\xf4\x40\x1e\x65\x30\x0d\x7a\x52\xee\x13\xb1\xa5\xca\x0a\xd0\x65\xe3\xc1\xe6\xbd\xa4\x79\x47\x07\xf6\xa2\xd0\x41\x96\xad\xc8\xa1\xda\x88\x2b\x8d\x07\xfb\x00\xca\x34\x4a\x21\xf8\x33\x59\x37\x40\x5b\x76\x13\xf4\xe8\x18\x36\x74\xd6\x07\x2e\x90\xd1\x71\xc5\x2f\xc8\x90\xc6\x5d\xf4\x01\xcc\x18\x24\x6a\xbe\x74\xb6\x05\x45\x2a\xa0\xeb\x7e\x3e\x50\x72\x16\xc7\x0c\x8d\x3a\x54\x44\x70\xa8\x75\x62\x2f\x81\xc3\x91\x46\x66\x99\x18\x72\xcc\x00\xc3\x50\xf7\x05\xc8\x35\x95\x83\x4d\xd7\x60\xd3\xb4\xcb\xc2\xa6\xd1\xf0\x4c\x4d\xa4\x4d\xca\x6a\x91\xcb\xa1\xfb\x15\x80\x2b\xd4\x96\xf6\x4b\x3a\xa3\x08\x4e\x2d\x76\xec\xec\xdd\x30\x93\x10\x47\xf3\xba\x65\x21\xd8\x46\xfa\x70\x25\xc4\x4b\x20\x90\xbb\x10\x74\x2a\x04\xb2\x8d\x70\x09\xcd\x56\xab\x91\x33\x7f\x88\x6b\x88\x88\x8b\x05\x1d\xe2\x9e\x30\x37\xba\x4e\x4c\x9d\xeb\x43\x77\x47\x65\xe0\xe2\x9d\xd1\x79\xeb\x7e\xb2\x0f\x16\x02\xb0\xf2\x41\x2c\x66\xde\x08\xa1\xbf\xa8\xc3\x88\x87\xe6\x31\xfd\xff\xc9\xe9\x66\x4f\xb5\xda\x80\xc2\x57\xab\x1e\x10\xa7\xbb\x2d\xdf\xa9\x23\xe9\x0a\x1e\xf2\xbf\xd8\x3f\xef\x53\x18\x5e\x74\x68\xac\x5b\x47\x51\x49\x06\x7d\x62\x84\x2d\xb5\xb2\x62\xac\xde\xaf\x87\x36\x06\x80\xfb\x81\x1e\x7a\x1f\x42\x3a\x41\xc1\x61\x15\x25\x05\xde\x42\x90\x79\xed\x5c\xe9\xef\xd5\xf2\xa0\x19\x80\x9e\xb3\xa7\xb0\xcb\x00\x85\x9d\xe7\x7e\x63\x87\xd1\xc3\x63\x22\x1a\x47\x81\x3d\xa5\xc1\x6c\x4a\x6a\xc3\xcc\x0a\xb5\x91\x46\x2b\xd0\x77\x49\x88\xd1\x0d\x90\x1e\x8a\x1d\xae\x9a\x2e\xec\x37\xea\x12\x13\x0e\x78\x76\xf5\xcf\x37\x3f\x0f\x8c\x1d\xbc\xfc\xfa\x1f\xc3\xd6\xc7\x05\x02\xb2\xc5\x2a\xb1\x82\x9b\x74\x0d\x94\x91\x5e\x4c\xea\x8c\xf2\x00\xe8\xdb\xd0\xa3\x56\xba\xdd\x1c\x74\x98\xbe\xc0\x5f\x6f\x76\x4d\xf8\x07\x80\x4a\x7f\x67\xfa\xd2\xbb\xd2\x89\x3b\x12\xa0\x46\xda\xdd\xfa\xed\x7a\xec\x9e\xa3\xd6\x59\x80\xfe\x8e\x7d\xc1\x7e\x82\xde\xf5\x5e\x4d\xf9\x15\x18\xec\x58\x04\x88\xf3\x5f\x0c\x87\x30\x93\x2d\x4e\xc6\x9c\x7c\x0c\x8b\x62\xff\x04\xe4\x00\x66\x30\x6d\xd8\x78\xef\xd8\xe3\xf1\x67\x6b\xc9\x77\x08\xf7\x3d\x7c\x79\x24\x66\x68\xd6\x7f\x0b\x91\xaf\xaa\x28\xb6\x38\xe4\x6e\xf7\x2d\xa8\x9f\xb6\xef\xa3\xd5\xb8\xfc\xd0\xe9\xf2\xe4\x2f\x59\x26\xe2\x23\xd6\xfa\x60\xea\x64\xec\x0c\xd6\x15\xb6\x03\xe5\xf1\x9a\xbb\xf5\x45\x7b\x06\x63\x41\xf1\x2c\x0b\x87\xbe\xc6\x20\x5d\x62\xb3\x36\x00\x30\xd5\xff\xb7\x2c\xd9\x4f\x32\x8f\x27\x8c\x8a\x98\x42\x4d\xdf\x08\xc0\x9f\xa8\x2a\xf3\x16\x5b\x9e\x4e\xdf\x01\x88\x70\x1b\x96\x93\x0a\x41\x7d\x0e\x0a\xe8\x10\x3d\x6b\xc6\x6a\xb5\x68\x41\x88\xc4\x35\x6c\x96\x01\x5f\xa1\x86\x03\xae\x21\x98\xc2\xae\xa9\x26\xec\x0a\x1a\x83\xc0\x49\xd7\xca\x98\x20\x26\x34\x1e\xac\xd4\xba\x39\x8e\x8d\xa6\x8f\x90\xe8\x90\x60\x62\xf6\xad\xa7\xf3\x3d\x64\x86\xe8\xf7\xac\x4d\xde\xfb\x79\x0c\x1d\xa1\x16\x1e\xa7\x7b\x24\xf5\xf7\x34\xd4\xcc\x03\x87\x83\x1c\x1d\x3d\xc3\xb9\xb4\x2e\xd1\x4b\x14\x5f\x9b\x60\x05\x2e\x48\x73\x09\xa1\x67\x33\xb4\xb0\xbd\x6a\x03\xb8\x4d\xd6\x0b\x07\xa0\x6a\x03\x1a\x25\xcc\x3b\x20\x86\x53\xcb\x68\xd8\x51\x3e\x90\x81\xdd\xbd\xec\x60\x00\x91\xee\x45\x88\xe8\xb0\x1f\x34\x64\x6b\x47\xa5\x6d\x0d\x90\x19\x07\x64\xdc\x5c\xfd\xcf\x37\xd7\x37\x57\xc9\xef\xbf\x5c\xdf\xfe\x9a\x5c\xbe\xb9\xfb\xa5\x95\x6e\x18\xc5\xb6\x77\x81\x14\x5e\xf9\x72\x18\xd7\xa7\xba\x28\xb9\x81\xdb\x55\x3a\x37\x87\xd2\xa5\x4e\x7a\xd9\xd9\x2d\xdb\xc9\x46\xb8\xea\xcb\x33\x16\x50\xa5\xf6\xf5\x81\x15\xa9\xba\x85\x03\xf3\x08\x5c\xf1\x1e\xbb\x11\x54\xff\x89\xc1\x94\xfe\xfe\x0e\x1d\x70\xc5\xa6\x81\x12\xc1\xd3\x75\xb8\xae\x35\xdc\xd6\x3a\x63\xc1\xe0\xae\xaf\x6d\xf5\xb7\xb6\x62\x57\xb0\x52\x91\x94\x87\x35\xc7\x95\x36\x48\xc7\x8c\x2e\x59\x04\x39\x92\x0e\x96\x26\x2e\x0c\x31\x0b\x35\x0b\x8f\x6a\x96\x2c\xa5\xf0\xe8\xf2\x50\x65\xf2\x78\xc6\x2a\x15\x22\x22\x90\xa7\x35\xe5\x9a\x2b\xa8\xfc\x7a\xa9\x1d\x9a\x54\x2d\xd0\x23\x1c\x03\x92\x93\xb5\xe0\x99\x30\x27\x1d\xf6\x7e\x0d\x2c\x9b\x3e\xea\x8d\x60\xe8\x6e\xc9\x01\x38\x30\x12\xa6\x94\x3d\x17\x76\x3b\xd8\x3d\x02\x47\x3e\x7d\x9a\x7b\xa6\xf8\xc7\xfe\xb7\x7f\x1c\xb8\xb0\xdb\x35\x1c\xc1\x37\x81\x25\xbb\x5d\xc3\x9d\x88\x6b\x52\x20\x6d\x9c\xe7\x22\x97\x76\xe8\x46\x96\x82\x7f\x94\x45\x55\xb4\xee\x79\x6c\x8e\xd0\x85\xc9\x4e\xb5\xaa\x23\xdd\x93\x87\x9e\x88\x99\x49\xba\x4d\x07\x95\xe1\x5d\x47\x17\xb5\x01\x0a\xa8\x08\x54\x5e\x54\x7d\xf0\x88\xbc\x7f\xb0\x41\x16\x41\xc0\x45\x46\x99\x33\xea\x39\xee\xa8\xd4\xdc\x50\x3a\x31\x3a\xcf\x17\x3c\x1d\xba\x9a\x81\xe2\x96\xd0\x8a\x41\x33\x14\xd8\x1a\xbf\xa6\x30\x8d\x18\x83\x07\x7a\x38\xfd\xed\x8d\x5b\x2e\xf3\x91\xdb\xb3\x02\xf8\xc4\x3a\x3e\x52\xf2\x7a\xa3\xfd\x79\xfd\x7d\x14\x48\x26\x20\xfe\x01\xa8\xf9\x33\x92\x2d\x04\xe6\x31\xb0\x27\x8e\xd7\x03\x74\x91\x7d\x25\xe0\xa3\xa7\x3a\x5b\x99\xee\x7a\x06\xac\x2e\x42\x15\x75\x3c\x26\xb3\xae\x76\x0a\x31\xfa\x5c\x2c\x5d\xeb\xf4\xa6\x5f\x79\xd9\xfc\xdd\xd8\x96\x01\x62\x5d\x63\x3f\x7a\x5a\xf3\x10\xf6\x87\x9c\xb1\xa6\x76\x67\x14\x30\xc6\x15\x1a\xbe\x89\x41\xae\x91\xde\x6e\x83\xa8\xc3\x8d\x46\x58\x07\xe1\x2e\x08\xac\x59\xac\x27\x6c\x97\x13\xd6\xa7\xaf\xe9\x86\x5c\x68\x13\x0c\x7d\x1c\x1e\x77\x81\x7b\x21\x4a\xd0\xd7\xa2\x55\xd0\x7e\x60\xf2\xdf\x1d\xb1\xf1\x8e\xde\xc8\xe1\x6f\x1d\xdf\x9f\xec\x56\x1a\xa1\x39\x11\x69\x25\x04\x8b\x00\xb9\x9c\x5b\xd7\xc2\x27\x02\x17\xdc\x58\x27\x50\xe1\xb4\xb3\x42\x33\xc1\x8c\x48\xe1\x26\x3a\x74\x97\xe7\x35\x12\xe7\xf8\x72\x0e\x07\x40\x82\x44\x22\x32\xcd\x91\xe3\x16\x5e\x81\x81\xc1\xb7\xec\x6c\xd1\xd2\x35\xa6\xc3\xc1\xbd\x35\x72\x0f\xc7\x79\xf3\xdb\x38\x98\xc7\x67\x70\xb9\xe6\x8c\x15\x3a\xc3\x88\x25\x7b\x34\xc6\xd2\x19\x13\xf3\xd5\xbc\xc1\xe3\xc1\xde\xb3\xa7\xcf\xaf\x1f\xb3\x70\xcc\xf2\xf8\x8d\x19\xf8\x53\xd9\xc8\xad\xf9\x75\xcb\xe9\x26\x26\x41\xdc\xa4\x56\xba\x4e\xb7\x16\x76\xff\xce\x24\xba\x31\x07\x72\x73\xbb\x5d\xc4\x5e\x4e\x98\x8d\xef\xe6\xfe\x12\x18\x2a\x11\x03\x56\xee\x76\x0d\x53\x21\x43\x44\x7c\xdd\xed\x6a\x16\xcf\xa8\x32\x04\xce\x78\xef\x76\x35\xdf\x86\x11\x01\x35\x03\xc8\x08\xb4\xed\x27\xd3\x0f\x2f\x3b\xf7\x2a\x62\x47\x0a\xe4\x70\xd7\x39\x38\x49\xf6\x4d\x47\xe4\x96\xd2\x58\x17\x8f\x0b\xde\x36\x3e\xad\xf3\x70\x69\xf4\xad\x50\x1c\x26\x9c\xe4\x22\x9c\x1a\xfd\x17\x71\x87\x07\x49\xea\x00\xf8\xc3\xb1\x2e\xdb\x32\x27\xbd\x7e\x00\xc5\xd6\xd3\x0f\x33\x66\xef\x65\x59\x4e\x04\x55\x90\x15\xe9\x5a\x14\x3c\xd9\x48\x2a\x59\x1c\x52\x16\x77\x6b\x4a\xd0\xd5\x07\x1a\x41\x97\x6a\x53\xc0\x96\x00\x18\xf8\x81\x50\x34\x52\x5d\x29\xb7\xdb\xb1\x7a\xd0\x47\xf6\xb1\x9f\xc0\x8b\x28\x64\xc2\x91\x72\x4a\xcc\x0e\x49\xee\x1b\xdf\x8c\x85\x66\xed\xc8\x63\x14\x9c\x10\xca\x9a\x80\x13\x62\xba\x75\x60\xfa\x64\x80\x21\x2e\x30\x12\x3b\x0f\x25\x21\xe8\x19\xa2\x3a\x85\xa0\x36\xb9\x90\x15\xf7\x47\x7d\xc2\x61\x2b\x3a\xd2\xe8\xff\x88\xc6\xc2\x56\xab\x95\xb0\x23\xae\xec\x33\x99\xc1\x6d\x03\xac\x10\xdc\xcb\x76\xd3\x63\xb7\x7b\xff\x3f\x22\x36\x1f\xbf\x11\x22\x25\xc3\xe7\xed\x7f\xa3\xd7\xa3\x97\x4b\x37\xbe\x3c\x94\x23\x34\x77\x99\x4c\xe3\x80\x1b\xe0\x04\x0a\x4f\xd7\x22\xbd\xb7\x11\x08\x70\xdb\x35\x85\x1f\x20\xc9\x3e\xab\xf1\x6a\x7f\xe4\x40\x1b\x46\x57\xa4\x51\xac\xf1\xc2\xa7\xd9\x68\x20\x83\xa5\x4f\x48\x78\xe6\x8f\x5f\x5a\x07\x08\x48\x53\x2f\x21\xb1\x11\x66\x7b\xbc\x33\x2b\xc1\xbe\x29\x4a\x6d\xc1\x22\xa2\xa4\x3b\x55\xf9\x03\x99\x5d\x70\xbd\xf3\x6f\x88\xdc\xcc\x57\x7f\xd8\x90\x0d\xa4\x2b\x99\x7b\x48\xcf\x7a\xa7\x6d\x7d\xf3\x50\xce\xcf\x8c\x58\x0a\x43\xe9\x9b\xc5\xb6\xce\x49\xf9\x56\xa6\x3e\x78\x60\x84\xd5\xf9\x06\x76\xdb\x2b\xa4\xb6\x34\x7a\x91\x8b\x22\x04\xec\x2d\x99\x05\x22\xab\xa1\x85\xea\x72\x30\xce\x2c\x93\x68\x68\x18\x3c\xab\xc7\xd5\xd8\x29\x3d\x42\x1c\xe2\x83\x53\x77\x6f\x75\x0f\xed\xb7\xb4\x3a\x40\xc1\x71\x26\x56\x58\x0b\xd6\xa8\x2f\x40\xa2\x0f\x13\xe0\xdb\x75\xf4\x26\xf1\x22\x56\x6b\x52\xa5\xd9\x68\xc5\xdd\xf3\xfd\xd2\x32\xbd\x64\xfc\x50\x80\x4a\x5a\xa8\x69\x01\xd5\x83\xa5\x29\xc8\x7f\x14\x77\x70\x32\x42\x01\x5a\x0c\x46\x95\x9a\xac\x3f\x3b\x02\xad\x70\xda\x69\xd1\xd4\x97\x9c\x80\x59\x10\xc7\x50\x30\x3c\x65\x8a\x0c\x66\x6c\x51\xcc\x2d\x6c\x7e\x07\xb1\xed\xd4\xb1\x87\xb3\x3b\x52\x75\x35\x4d\xcc\xc9\x85\x2a\x17\xe1\x4c\xd6\x24\xb2\x37\xfd\x73\x43\x0d\x92\x03\x87\xb3\xbe\x28\x9a\x91\x2c\x1d\xc1\xf2\xab\xb1\xb2\x8e\x92\x08\xb5\x19\x40\xab\xa5\xdc\x5b\xf9\xde\x99\xbf\xc6\x3b\x38\x02\xf0\x7a\xfe\xbd\x50\x9b\x1f\xe9\x4b\x46\x70\x89\x77\xcf\x2a\x1c\xbf\xf8\xb9\x17\x48\x12\x6a\x13\x67\x13\xf7\xf3\x7b\x10\x5f\x6e\xe1\x49\x11\xa3\x0d\x2c\xe0\xce\x65\x23\x2d\x25\x36\x31\x87\xb2\x00\x85\x3b\x89\xc8\x35\x36\x43\x78\xbe\xc7\x81\xdb\x43\xb8\xda\x46\x4f\x4d\x0b\x74\x56\x95\xb9\x84\x42\xec\x90\xfa\x1c\x40\x81\x76\x46\xb6\x5f\x83\xd3\x04\xb1\xd2\x9c\x9b\xfe\xdd\x20\x3d\xa5\x1e\x23\x2f\x56\xa4\x46\x38\x0b\x09\xf2\x01\x64\x9a\x44\xf8\x5a\xe7\x98\x57\x83\x40\x02\xcc\x29\x2b\x85\x61\x7e\x00\x88\x20\x73\x8c\xe8\xf8\xbf\x2f\xce\xcf\x33\x69\xce\xbf\x07\xef\xee\xc7\x23\xd0\x18\xc9\xc1\x08\x95\x9a\x6d\x09\x25\x21\x18\xa4\x87\x96\xa0\x4b\xa9\xe7\x01\x04\xf8\x4a\x9c\x7f\x0f\xac\xf8\x91\xce\x95\xd2\xf3\x55\xb9\xa2\xe7\x47\x20\x26\xb3\xd1\xe8\x11\xcc\x56\x68\x42\x6e\x84\x40\x74\x43\xd6\x83\xc6\x99\xb8\x35\x1d\x64\xc5\xb7\x1c\x80\x73\x8b\x2f\x49\x59\xc3\xcf\xde\xce\x11\xac\x8e\x28\x2f\xad\x06\x16\xd2\xce\x26\x5c\x76\x35\xe1\x90\x78\x14\x9b\x42\x57\x72\xf6\xf1\x0f\x3a\xaa\x4f\x07\x9b\xea\x36\xde\x28\x6a\x37\xb4\xd3\x2b\xb6\x8f\x1d\x2c\xdd\x26\x95\x3d\xce\xa2\x21\xe4\x82\x97\xe3\x8d\xe2\xb7\x67\x67\x10\x50\xcb\xf9\x0a\x18\x09\x33\x1e\x87\x52\x70\x74\x46\x12\xb2\xc1\xd1\x09\xcc\xea\x5f\x8a\x14\x05\x67\x4a\x59\xbd\xd4\x61\xfc\x13\x14\x62\x0b\x06\x1f\x3d\x2a\x48\x2c\xed\x0e\x1e\x76\xac\xfa\x2c\x76\xcc\x61\x4b\x02\x49\x8b\x63\x32\x2c\x41\xed\x3a\x60\xc1\x25\xc1\x07\x78\xa1\x6b\x2b\x1e\x1b\xb1\x9a\xe9\x32\xfc\xc3\x60\xcb\xfa\xf3\x59\x46\x58\x2c\x82\x58\xd2\xb6\x37\xab\x2f\xd8\x9b\xe1\x97\x5a\xf0\x2b\x15\xa8\x54\x28\xf4\xca\x6d\xc3\x86\x4c\xa7\xfe\x5c\x28\x6d\xe1\x2b\x09\xa7\x28\xe1\xbe\x1a\xee\x2e\x18\x86\x19\xb5\x61\xe3\x5f\x6f\x01\x56\x79\x5c\x13\xdf\x31\x72\x61\xfa\x3e\x04\x0c\x99\xe4\x7f\xf6\x16\xa5\x7f\xd8\x2c\x49\xfa\x7b\x5c\x62\x1a\x26\xaa\x7c\x48\x0d\x86\x8b\xee\x5a\x5f\x17\xc4\x7a\x3e\x60\xaa\x2f\x2b\x6e\xdd\xf2\x38\x63\x1c\x5c\xd8\x26\x56\x59\xc7\x8c\xdd\x5a\x6c\x5b\xf9\xa4\x47\x7e\x28\x8c\x61\x7a\x83\xae\x79\x87\xd7\xb3\x3c\x0a\xd0\x1e\x53\xf8\xd3\xdb\x59\x17\xe5\xfd\xea\x1c\x76\xa0\x59\x28\x4f\x82\x27\xac\x39\x38\x7e\xf1\x9f\x22\xc8\xa5\xf2\x96\x01\x8a\x15\xf8\xb2\xec\x00\xdd\x03\x24\x23\x7a\x11\xe0\x5b\x17\xe8\xf9\x51\x74\x7d\x52\x79\x42\xdf\xd0\x26\x14\x7a\xd1\x5e\xe1\xff\xe8\x06\x57\xbe\x87\x8b\x29\x7e\xbc\xf0\x9b\x34\x7b\x58\x0b\x23\xfc\x5d\x15\xe0\x21\xf9\xdb\x6f\xa0\x33\x3c\xb2\xa1\x82\x04\xda\x62\x42\x86\x2a\xb5\xa1\xde\x37\x4b\xb9\xc9\xec\xfc\x38\x62\x94\x4e\xc6\xee\x20\xbb\x1a\xa7\xe2\x90\x41\x46\x84\x77\x23\xf4\x31\x02\x6d\xe0\x48\x60\x12\x4e\xf9\x0d\x20\xd4\xa4\x58\x43\x43\xfc\x4d\x5f\xb4\x63\x29\xdc\x88\x09\xae\x6e\xfd\xf5\x51\xce\x70\x04\xfc\xf4\x1d\x9e\x17\x9c\xd1\xad\x48\xc1\x24\xc0\x40\x2e\x32\xba\xfe\x44\xd0\x7f\x9e\xb1\x9b\xab\xbb\x9b\x3f\x92\xcb\xbb\xbb\xab\x17\xaf\xef\x6e\x43\xaa\x22\xfa\x73\x41\x9e\x16\x3c\xd5\x38\x40\x08\xbe\x63\x0b\xb1\xd4\x06\x34\x1d\x9d\x86\xec\x10\x32\x63\x99\xae\x16\xa0\x83\xb5\x62\x20\xe0\x50\x20\x0d\x55\x38\x35\xa2\xdf\xd9\x80\xe9\xb3\xab\xe7\x97\x7f\x9c\x88\x66\xc1\x3f\x8e\xa2\x1a\xd2\xdb\x01\x65\x7f\xc4\x03\xae\x72\x19\x9e\x83\x86\x97\x4f\x30\x54\x05\x9c\xf6\xc8\xfb\x51\xe4\x92\x81\xd6\x11\x26\x10\xf0\xe2\xf2\x7f\x1d\x47\x04\x48\x33\x8e\x98\x94\x3a\x97\xe9\x36\x72\x5d\xee\x9f\x38\xec\x55\x93\x52\xda\x73\x5f\xce\x8a\xca\xa2\x71\xc7\x1d\x83\x13\x1a\x8e\x7d\x57\xc7\x8c\x90\x26\x0b\x37\xe2\x22\x3c\x4b\x97\x24\x59\xf6\x5f\x9f\x3c\x29\x90\xfe\x7f\xd8\x19\x79\x92\x5d\x5e\x82\x14\x2a\xcd\xf0\x33\x39\x6e\xcd\x43\x85\x69\xce\xb7\xf3\x88\xd8\xa3\x8f\x7f\x66\xa2\x1c\x5a\x2e\x2f\xf0\xee\xda\xee\x75\x3f\x90\x0e\xec\xae\xce\x08\x48\x30\x59\x91\x80\x7e\x96\xee\x97\x6a\x31\x06\x2f\xf0\x4d\x1a\xb8\x3b\xe8\x1e\x8c\xf1\xd6\x91\x47\x78\x74\x1c\xf1\x49\x55\x8e\x04\x5f\x6f\xbc\x31\xbe\xcf\x04\x8c\x8a\x92\xfd\x80\xf9\x19\x6c\x12\x30\x3a\x86\x29\xf1\x08\xb4\x0e\x79\x86\x15\xed\xef\xda\x0a\x88\xd5\x9a\x33\xf8\x93\x3e\x0e\xda\x7b\x18\x38\x6a\xbb\x24\xcd\x20\x06\xc3\x21\x3c\x5b\x48\x07\xea\xe3\x41\xe5\x9a\x67\x70\x20\x04\x06\x69\xa5\x97\x7c\x93\x5a\x80\x31\x72\x6b\xab\x02\x86\xa5\x04\xb6\x0b\x1f\x15\x6c\x33\xa5\x37\x4d\xe0\xee\x95\x39\x4f\x83\x2e\xc5\x2f\x87\xe9\xca\xd6\xed\x27\xe6\x11\x95\xd0\xd2\xe8\xbf\x84\x4a\x42\x97\x01\x26\x82\x52\x0f\x67\x96\x01\x4b\xe4\x78\x80\x1b\xfa\xb6\x8a\x49\x02\x37\xc9\x3c\x86\x16\xa0\x48\x4d\x7f\xcb\x8a\x9b\xf2\x66\x40\xc4\x53\x64\xd3\x57\x10\xef\xdf\xa6\x45\x38\x70\x47\x13\x84\x4a\xc7\xff\x1c\xb7\xf9\x02\x7e\x24\x66\x43\xc0\x9f\x53\xb3\x8e\x9d\x1e\xea\x33\x26\x43\x45\x84\xe8\x29\x84\xc1\xdc\xc4\x3b\xbb\x4d\x5f\xf4\x79\x46\xf9\x29\x5d\x6f\x02\xbb\x59\x50\x98\x4f\x10\xd7\xf6\x0d\x64\x61\x85\x51\xf4\x16\x53\x14\xec\x6d\x2d\xb7\xb0\x5e\x2c\x55\xad\xbc\x8f\x46\x34\xac\x8f\x01\x34\x41\x88\xea\x25\xa3\x97\x07\xe7\x37\x9c\xe7\xdc\x17\xbb\x96\x2d\x58\xaf\xc3\x5e\xc6\x0d\xaf\x93\x5a\x69\x17\x62\x96\x3e\x43\x17\x8d\x3e\xc5\x23\x06\xb0\x0f\x1c\x83\xb1\xe9\xf7\x40\x3c\xa3\xad\x5e\x4e\x98\xee\xa0\x8f\x06\xf0\x68\x6e\x19\x6c\xc3\x0e\x9d\xe2\x05\xac\x5e\x2e\x71\x49\xf7\x7d\xd5\xdf\x16\xb1\x18\xa0\xe1\xf0\x63\xa4\xb4\xdc\xf5\xa4\x25\x1b\x5b\x5b\x03\xda\xa2\x4e\x4e\xd4\xb5\x3b\x5b\xff\x3d\x5d\xa0\x01\xf2\x3c\x1d\x7a\xa4\x3d\x5a\x0f\x04\x73\x2a\x09\x6b\x6b\x80\x98\x7a\xe9\xf5\xae\x27\x0c\xeb\x96\xc3\x16\xa1\xad\x84\x53\x3f\xe4\x77\x35\x17\xab\x9f\x7f\xaf\xcd\xea\xc7\xf3\xef\xa1\xc9\x8f\xd1\x98\x85\xab\xcf\x06\x30\x82\xc8\x92\xb0\xe0\x59\x71\xdb\xcf\x5c\x87\x2a\x8a\x70\x99\x34\x6e\x80\xdc\x76\x97\x55\xaf\xd4\x62\x46\xa9\x01\x70\xd3\xa5\x82\xd4\x27\x77\x70\xa6\x32\x1e\xdf\x91\x2a\xce\xba\x19\x18\x24\x10\x00\x60\x9c\x61\xd5\x67\x1b\x83\x08\x53\xa4\x65\x0c\x39\x23\x86\xa0\xbd\x6e\xc7\x56\xfc\x8a\xee\x20\xd0\x35\xd3\x8e\xb2\x80\x46\xa0\x06\xfb\xc7\x1b\x3c\x53\x96\xce\x80\xad\xba\x67\xe9\x70\xf0\x8e\xfb\x1f\x1c\xaf\x83\x47\x05\x14\x6d\x81\xef\x27\xc4\xac\x0e\x6d\x78\x7b\x13\xf1\x9a\xd1\xdf\x41\xf7\x05\xc8\xb4\xd6\x70\x3d\xf1\x7a\x39\x31\xa7\xe7\x0c\x34\x86\x37\x30\xf6\x50\xec\x4c\x9c\x36\x87\xcd\x90\x96\x5c\xba\x8e\x20\x05\x24\xec\x3c\x5e\xc5\x6c\x84\xca\x46\x8e\xe0\x82\x8a\x09\x4d\x58\xaa\xcb\xed\xa4\x9e\xe9\xca\xfc\x98\x95\x34\x0b\x27\x46\x26\x33\xa1\x0d\x84\x24\xe5\xe9\x5a\x64\xa7\x18\x17\x43\x0a\x10\x13\x0d\xcd\xc7\x3a\x60\xfc\x18\x54\xe0\xce\xd6\x09\xe6\xd5\xe8\xc0\x9a\xac\xb9\xd8\x65\xd0\x3c\x62\x71\xb4\xd6\xa4\x1f\x65\x00\xde\x53\x98\x9f\xe1\x45\x29\x95\xd3\x27\x2f\xcb\x51\xc0\xff\x0f\x17\x66\x1a\x68\xa4\xaf\x12\x2f\x89\x60\x74\x7e\xb4\x82\x68\xa3\x26\x07\x09\x10\x66\x4b\x9d\x43\x4a\x43\x2f\xdb\x94\xb7\xdd\x8d\xb6\x97\x32\x67\xcf\xb9\xeb\x7c\xfc\xd8\xd6\x17\x1f\xd5\xf3\x47\x15\x3e\xa8\xca\xf7\x85\xba\xed\x5a\x04\x8b\x83\xbc\x8b\x62\x62\xae\xd1\x89\x41\xf9\x8e\x4a\xdf\x41\xcb\xe0\xb7\x34\xb6\x4d\x8f\x87\x14\x3d\x41\xae\xfc\xfd\xf7\xdb\x5f\x9f\x5d\xbd\x7e\xfe\xea\x8f\xe4\xe9\xe5\xd3\x5f\xae\x92\x67\xd7\x37\x60\xe8\xfe\x9f\xf3\x56\xf5\x2c\x8c\x1a\xe7\xbf\x04\x21\x9b\x12\xfe\x43\x6b\x71\xc0\x52\xac\xe5\x16\x04\x95\xfb\xb3\xe0\x8e\xaf\xc6\x97\x49\x6f\x93\x54\x3a\x71\x7c\xe8\xdb\x51\xca\x8f\x4b\xd2\xd0\x18\x14\xcc\x72\x27\xed\x12\x56\xc9\x61\xd4\xc6\xc1\x53\x38\x3a\xa1\xf6\x13\x11\xa4\x81\x74\xf0\x61\xc0\x8d\xf9\x63\x45\xc1\x95\x93\x69\xc0\x30\xd6\x8a\x85\x3a\xf3\xd8\xf2\x91\x9f\xfa\x35\xe9\xd1\xb5\x0e\x74\x98\xaf\xb9\x1b\x58\xc1\x75\x28\x75\x39\x55\x88\x07\x10\xbd\x13\xd3\x09\xd0\x13\xa8\x59\xe4\x11\xf7\xc4\xef\xe1\x0c\x27\xd6\xb8\x0a\x9f\xec\x61\xf5\x38\xd8\xa8\xfe\x0b\x0d\xb2\xfa\xaf\x26\x79\xd2\x3c\x1a\x17\xbb\x1e\x8e\x95\xaa\xf3\x31\xb1\x78\xd6\x5b\x23\xf5\x1c\xc5\x14\x98\xd7\xbf\x2c\xdd\x7f\xd2\x66\x6c\xe3\x04\x50\x09\x6c\x70\xe3\x1f\xf0\xba\x0b\x1f\xc5\xd1\xcb\xa1\xca\x9b\x14\x2b\xaa\x6a\x37\x8e\x67\x61\xf7\xa6\x6a\x6a\xaf\x9c\xe9\x92\x07\x0c\x88\xc5\xca\x27\x55\x7d\x8f\x4f\xf2\x1d\x55\xfd\x57\xde\xc4\xf6\x3f\xa9\xfc\x6a\xa8\xee\x98\x46\x44\x44\xeb\x98\x6b\x0a\xb7\x37\x80\xd6\x93\x0a\xd8\xb9\x19\xdb\xeb\xd1\x56\x22\x1e\xd4\x68\x8e\xde\x58\x72\x77\x98\x79\x74\x97\x37\xb0\x18\xb0\xb1\x81\x94\x28\xe3\xa7\x55\x09\x6b\xc4\x72\x72\x09\xdf\xc0\x8a\xad\x4b\xc0\xe8\xd6\xa5\xba\xc4\x26\x0e\x8e\x50\x55\x11\x13\xfe\x6e\x05\x1c\x5a\x09\xa6\x96\x9b\x14\x07\x2e\xd5\xca\xba\x23\xe1\x9d\x04\xa8\x90\x4a\x16\x83\xa4\xfd\x06\x20\xea\xe8\xfc\x0a\x65\x19\xaa\xa5\xe0\x96\x16\xc3\xc4\x9f\x15\xcf\xc3\x15\x51\xa1\xfa\x2f\x12\x2c\xff\x18\x0d\xb6\x09\xe0\x7f\x1e\x4c\x4c\x73\x5a\xb9\x11\xa7\x13\xfd\x59\x40\x4f\x22\xf9\x78\x88\xe1\x76\xf6\x44\x2f\xa3\x60\xf1\xe6\x3e\x77\xbd\x3c\x05\x9e\x54\x49\x2e\xd4\x6a\xb0\x4c\xe4\xd6\xe1\xe1\xf8\xbd\x1c\x4f\x1b\x14\xc4\x5c\x0c\x4f\x9d\x30\x50\x32\x0b\x19\x91\x48\xe0\xfc\xe3\xb1\xc0\x0b\xfd\x85\x60\x8f\x9f\x6a\x27\xc0\x07\x9c\x3d\xea\x77\x9a\x62\x28\xa4\x4a\xa4\x13\xc5\x50\xb2\xe8\xd2\x18\xbe\xf5\xe4\xe2\x2d\x02\x87\xb9\x0d\x23\x3c\xb2\x8f\x23\x41\xf2\x8f\xc7\x82\x2c\xf4\x67\x41\xac\x94\xfc\xb3\x12\xa3\x40\x9f\x85\x42\x43\xc6\x11\x3c\xb4\x8d\x24\x47\xaa\xe9\xcb\x07\x5f\x2d\xc0\x29\x9a\xe4\x63\x38\x46\xf2\x48\x8a\x63\xb8\x79\x12\xf8\x42\x7f\x01\xe8\x5c\x6d\xa7\xf4\x42\x4f\x62\xa1\xee\xc0\x77\x06\x83\xf5\x2d\x57\xdb\x57\xcb\x48\x59\xd5\x6a\x5a\x09\x01\x0c\x61\xdb\xa5\xf3\xbe\x33\xfa\xb1\x6f\xb5\x12\xaf\x96\xed\x82\x0e\xf1\x91\xe3\x37\x0e\xe0\xf6\x96\x28\x1c\x94\x76\xe3\x08\xc0\x0c\x77\xd7\x67\x8b\x5a\xa5\xdd\x14\xad\xf5\x85\x58\xd1\xe7\xb0\x5e\x87\x1e\x1d\xeb\xac\x6f\xb5\xf9\x5a\x8f\x8e\x51\xfc\x15\x8e\x6b\xed\x61\x3f\x6e\x73\x1e\x46\x9d\xbc\x0b\xea\x4a\x78\xf5\x6c\xdd\x6f\xde\x7f\xf3\x7f\x07\x00\x77\x21\x99\xb7\xfc\x98\x00\x00")
 
 func wski18n_resources_en_us_all_json() ([]byte, error) {
 	return bindata_read(
@@ -43,7 +43,7 @@ func wski18n_resources_en_us_all_json() ([]byte, error) {
 	)
 }
 
-var _wski18n_resources_es_es_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x01\x00\x00\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00")
+var _wski18n_resources_es_es_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
 
 func wski18n_resources_es_es_all_json() ([]byte, error) {
 	return bindata_read(
@@ -52,7 +52,7 @@ func wski18n_resources_es_es_all_json() ([]byte, error) {
 	)
 }
 
-var _wski18n_resources_fr_fr_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8a\xe6\x52\x50\xa8\xe6\x52\x50\x50\x50\x50\xca\x4c\x51\xb2\x52\x50\x4a\xaa\x2c\x48\x2c\x2e\x56\x48\x4e\x2d\x2a\xc9\x4c\xcb\x4c\x4e\x2c\x49\x55\x48\xce\x48\x4d\xce\xce\xcc\x4b\x57\xd2\x81\x28\x2c\x29\x4a\xcc\x2b\xce\x49\x2c\xc9\xcc\xcf\x03\xe9\x08\xce\xcf\x4d\x55\x40\x12\x53\xc8\xcc\x53\x70\x2b\x4a\xcd\x4b\xce\x50\xe2\x52\x50\xa8\xe5\x8a\xe5\x02\x04\x00\x00\xff\xff\x45\xa4\xe9\x62\x65\x00\x00\x00")
+var _wski18n_resources_fr_fr_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x65\x00\x9a\xff\x5b\x0a\x20\x20\x7b\x0a\x20\x20\x20\x20\x22\x69\x64\x22\x3a\x20\x22\x62\x79\x70\x61\x73\x73\x20\x63\x65\x72\x74\x69\x66\x69\x63\x61\x74\x65\x20\x63\x68\x65\x63\x6b\x69\x6e\x67\x22\x2c\x0a\x20\x20\x20\x20\x22\x74\x72\x61\x6e\x73\x6c\x61\x74\x69\x6f\x6e\x22\x3a\x20\x22\x53\x6f\x6d\x65\x20\x74\x72\x61\x6e\x73\x6c\x61\x74\x69\x6f\x6e\x20\x69\x6e\x20\x46\x72\x65\x6e\x63\x68\x22\x0a\x20\x20\x7d\x0a\x5d\x0a\x03\x00\x45\xa4\xe9\x62\x65\x00\x00\x00")
 
 func wski18n_resources_fr_fr_all_json() ([]byte, error) {
 	return bindata_read(
@@ -61,7 +61,7 @@ func wski18n_resources_fr_fr_all_json() ([]byte, error) {
 	)
 }
 
-var _wski18n_resources_it_it_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x01\x00\x00\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00")
+var _wski18n_resources_it_it_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
 
 func wski18n_resources_it_it_all_json() ([]byte, error) {
 	return bindata_read(
@@ -70,7 +70,7 @@ func wski18n_resources_it_it_all_json() ([]byte, error) {
 	)
 }
 
-var _wski18n_resources_ja_ja_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x01\x00\x00\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00")
+var _wski18n_resources_ja_ja_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
 
 func wski18n_resources_ja_ja_all_json() ([]byte, error) {
 	return bindata_read(
@@ -79,7 +79,7 @@ func wski18n_resources_ja_ja_all_json() ([]byte, error) {
 	)
 }
 
-var _wski18n_resources_ko_kr_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x01\x00\x00\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00")
+var _wski18n_resources_ko_kr_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
 
 func wski18n_resources_ko_kr_all_json() ([]byte, error) {
 	return bindata_read(
@@ -88,7 +88,7 @@ func wski18n_resources_ko_kr_all_json() ([]byte, error) {
 	)
 }
 
-var _wski18n_resources_pt_br_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x01\x00\x00\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00")
+var _wski18n_resources_pt_br_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
 
 func wski18n_resources_pt_br_all_json() ([]byte, error) {
 	return bindata_read(
@@ -97,7 +97,7 @@ func wski18n_resources_pt_br_all_json() ([]byte, error) {
 	)
 }
 
-var _wski18n_resources_zh_hans_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x01\x00\x00\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00")
+var _wski18n_resources_zh_hans_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
 
 func wski18n_resources_zh_hans_all_json() ([]byte, error) {
 	return bindata_read(
@@ -106,7 +106,7 @@ func wski18n_resources_zh_hans_all_json() ([]byte, error) {
 	)
 }
 
-var _wski18n_resources_zh_hant_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x01\x00\x00\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00")
+var _wski18n_resources_zh_hant_all_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00")
 
 func wski18n_resources_zh_hant_all_json() ([]byte, error) {
 	return bindata_read(
@@ -42,6 +42,7 @@ var (
 )
 
 func PrintOpenWhiskError(message string) {
+	message = MaskSensitiveValues(message)
 	outputStream := colorable.NewColorableStderr()
 	fmt.Fprintf(outputStream, clrError.Sprintf(STR_PREFIXED_MESSAGE,
 		wski18n.T(wski18n.ID_MSG_PREFIX_ERROR), message))
@@ -53,9 +54,9 @@ func PrintOpenWhiskFromError(err error) {
 
 func PrintOpenWhiskWarning(message string) {
 	if DetectVerbose() {
-		outputStream := colorable.NewColorableStdout()
+		outputStream := messageStream()
 		fmt.Fprintf(outputStream, clrWarning.Sprintf(STR_PREFIXED_MESSAGE,
-			wski18n.T(wski18n.ID_MSG_PREFIX_WARNING), message))
+			wski18n.T(wski18n.ID_MSG_PREFIX_WARNING), MaskSensitiveValues(message)))
 	}
 }
 
@@ -66,9 +67,9 @@ func PrintlnOpenWhiskWarning(message string) {
 }
 
 func PrintOpenWhiskSuccess(message string) {
-	outputStream := colorable.NewColorableStdout()
+	outputStream := messageStream()
 	fmt.Fprintf(outputStream, clrSuccess.Sprintf(STR_PREFIXED_MESSAGE,
-		wski18n.T(wski18n.ID_MSG_PREFIX_SUCCESS), message))
+		wski18n.T(wski18n.ID_MSG_PREFIX_SUCCESS), MaskSensitiveValues(message)))
 }
 
 func PrintlnOpenWhiskSuccess(message string) {
@@ -76,9 +77,9 @@ func PrintlnOpenWhiskSuccess(message string) {
 }
 
 func PrintOpenWhiskInfo(message string) {
-	outputStream := colorable.NewColorableStdout()
+	outputStream := messageStream()
 	fmt.Fprintf(outputStream, clrInfo.Sprintf(STR_PREFIXED_MESSAGE,
-		wski18n.T(wski18n.ID_MSG_PREFIX_INFO), message))
+		wski18n.T(wski18n.ID_MSG_PREFIX_INFO), MaskSensitiveValues(message)))
 }
 
 func PrintlnOpenWhiskInfo(message string) {
@@ -86,13 +87,13 @@ func PrintlnOpenWhiskInfo(message string) {
 }
 
 func PrintlnOpenWhiskInfoTitle(message string) {
-	outputStream := colorable.NewColorableStdout()
+	outputStream := messageStream()
 	fmt.Fprintf(outputStream, clrTitleInfo.Sprintf(STR_PREFIXED_MESSAGE,
-		wski18n.T(wski18n.ID_MSG_PREFIX_INFO), message))
+		wski18n.T(wski18n.ID_MSG_PREFIX_INFO), MaskSensitiveValues(message)))
 }
 
 func PrintlnOpenWhiskOutput(message string) {
-	fmt.Println(message)
+	fmt.Fprintln(messageStream(), MaskSensitiveValues(message))
 }
 
 func PrintOpenWhiskVerboseTitle(verbose bool, message string) {
@@ -0,0 +1,63 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wskprint
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	MASKED_VALUE = "******"
+)
+
+var (
+	sensitiveValues []string
+	sensitiveLock   sync.RWMutex
+)
+
+// AddSensitiveValue registers a value, e.g. a resolved secret, which is replaced by
+// MASKED_VALUE in every message printed from then on
+func AddSensitiveValue(value string) {
+	if len(value) == 0 {
+		return
+	}
+	sensitiveLock.Lock()
+	defer sensitiveLock.Unlock()
+	for _, v := range sensitiveValues {
+		if v == value {
+			return
+		}
+	}
+	sensitiveValues = append(sensitiveValues, value)
+	// longer values first so that a value containing another one is masked as a whole
+	sort.SliceStable(sensitiveValues, func(i, j int) bool {
+		return len(sensitiveValues[i]) > len(sensitiveValues[j])
+	})
+}
+
+// MaskSensitiveValues replaces the registered sensitive values found in a message
+func MaskSensitiveValues(message string) string {
+	sensitiveLock.RLock()
+	defer sensitiveLock.RUnlock()
+	for _, v := range sensitiveValues {
+		message = strings.Replace(message, v, MASKED_VALUE, -1)
+	}
+	return message
+}
@@ -0,0 +1,85 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wskprint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/mattn/go-colorable"
+)
+
+const (
+	OUTPUT_FORMAT_JSON = "json"
+	OUTPUT_FORMAT_YAML = "yaml"
+)
+
+var OUTPUT_FORMATS = []string{OUTPUT_FORMAT_JSON, OUTPUT_FORMAT_YAML}
+
+// outputFormat is the format of the documents printed on stdout, empty for human readable text
+var outputFormat string
+
+// documentPrinted records that a document was printed so that a failing command does not
+// print a second one
+var documentPrinted bool
+
+// SetOutputFormat selects the format of the documents printed by PrintOpenWhiskDocument,
+// json or yaml. Once a format is selected every other message is printed on stderr so that
+// stdout holds a single document a program can parse.
+func SetOutputFormat(format string) {
+	outputFormat = format
+}
+
+// IsStructuredOutput tests if a json or yaml output format was selected
+func IsStructuredOutput() bool {
+	return len(outputFormat) != 0
+}
+
+// PrintOpenWhiskDocument prints a document, e.g. the result of a deployment, on stdout
+// in the selected output format
+func PrintOpenWhiskDocument(document interface{}) error {
+	content, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return err
+	}
+	if outputFormat == OUTPUT_FORMAT_YAML {
+		if content, err = yaml.JSONToYAML(content); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintln(os.Stdout, MaskSensitiveValues(strings.TrimRight(string(content), "\n")))
+	documentPrinted = true
+	return nil
+}
+
+// IsDocumentPrinted tests if a document was already printed on stdout
+func IsDocumentPrinted() bool {
+	return documentPrinted
+}
+
+// messageStream returns the stream of the human readable messages
+func messageStream() io.Writer {
+	if IsStructuredOutput() {
+		return colorable.NewColorableStderr()
+	}
+	return colorable.NewColorableStdout()
+}
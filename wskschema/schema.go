@@ -0,0 +1,395 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package wskschema validates manifest and deployment files against the JSON Schemas
+// published in wskschema/resources.
+package wskschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	MANIFEST_SCHEMA   = "wskschema/resources/manifest.schema.json"
+	DEPLOYMENT_SCHEMA = "wskschema/resources/deployment.schema.json"
+
+	DEFINITIONS_REF = "#/definitions/"
+	DEFS_REF        = "#/$defs/"
+	ROOT_REF        = "#"
+	ROOT_PATH       = "$"
+	MERGE_KEY       = "<<"
+
+	TYPE_OBJECT  = "object"
+	TYPE_ARRAY   = "array"
+	TYPE_STRING  = "string"
+	TYPE_NUMBER  = "number"
+	TYPE_INTEGER = "integer"
+	TYPE_BOOLEAN = "boolean"
+	TYPE_NULL    = "null"
+)
+
+/*
+ * Schema is the subset of JSON Schema (draft-07) used by the manifest and deployment
+ * schemas: $ref to local definitions, type, properties, additionalProperties, required
+ * and items. Validate ignores any other keyword, ValidateValue also checks the validation
+ * keywords of values (enum, const, numbers, strings, arrays, objects, allOf, anyOf, oneOf
+ * and not).
+ */
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 schemaTypes        `json:"type,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *additional        `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Definitions          map[string]*Schema `json:"definitions,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+
+	Enum             []interface{}   `json:"enum,omitempty"`
+	Const            json.RawMessage `json:"const,omitempty"`
+	Minimum          *float64        `json:"minimum,omitempty"`
+	Maximum          *float64        `json:"maximum,omitempty"`
+	ExclusiveMinimum *float64        `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum *float64        `json:"exclusiveMaximum,omitempty"`
+	MultipleOf       *float64        `json:"multipleOf,omitempty"`
+	MinLength        *int            `json:"minLength,omitempty"`
+	MaxLength        *int            `json:"maxLength,omitempty"`
+	Pattern          string          `json:"pattern,omitempty"`
+	MinItems         *int            `json:"minItems,omitempty"`
+	MaxItems         *int            `json:"maxItems,omitempty"`
+	UniqueItems      bool            `json:"uniqueItems,omitempty"`
+	MinProperties    *int            `json:"minProperties,omitempty"`
+	MaxProperties    *int            `json:"maxProperties,omitempty"`
+	AllOf            []*Schema       `json:"allOf,omitempty"`
+	AnyOf            []*Schema       `json:"anyOf,omitempty"`
+	OneOf            []*Schema       `json:"oneOf,omitempty"`
+	Not              *Schema         `json:"not,omitempty"`
+
+	pattern *regexp.Regexp // compiled Pattern
+}
+
+// schemaTypes holds the value of the type keyword, either a single type or a list of types
+type schemaTypes []string
+
+func (t *schemaTypes) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = schemaTypes{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*t = list
+	return nil
+}
+
+// additional holds the value of the additionalProperties keyword, either a boolean or a schema
+type additional struct {
+	allowed bool
+	schema  *Schema
+}
+
+func (a *additional) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &a.allowed); err == nil {
+		return nil
+	}
+	a.allowed = true
+	return json.Unmarshal(data, &a.schema)
+}
+
+// LoadSchema reads one of the schemas embedded in this package, e.g. MANIFEST_SCHEMA
+func LoadSchema(name string) (*Schema, error) {
+	data, err := Asset(name)
+	if err != nil {
+		return nil, err
+	}
+	schema := new(Schema)
+	if err := json.Unmarshal(data, schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// ValidateManifest validates the content of a manifest file against the manifest schema
+func ValidateManifest(fpath string, content []byte) error {
+	return validateFile(MANIFEST_SCHEMA, fpath, content)
+}
+
+// ValidateDeployment validates the content of a deployment file against the deployment schema
+func ValidateDeployment(fpath string, content []byte) error {
+	return validateFile(DEPLOYMENT_SCHEMA, fpath, content)
+}
+
+func validateFile(schemaName string, fpath string, content []byte) error {
+	schema, err := LoadSchema(schemaName)
+	if err != nil {
+		return wskderrors.NewFileReadError(schemaName, err)
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return wskderrors.NewYAMLFileFormatError(fpath, err.Error())
+	}
+
+	violations := schema.Validate(&document)
+	if len(violations) > 0 {
+		return wskderrors.NewSchemaValidationError(fpath, violations)
+	}
+	return nil
+}
+
+// Validate returns every violation of the schema found in a YAML document, in document order
+func (schema *Schema) Validate(document *yaml.Node) []wskderrors.SchemaViolation {
+	v := &validator{root: schema}
+	node := document
+	if node.Kind == yaml.DocumentNode {
+		if len(node.Content) == 0 {
+			return nil
+		}
+		node = node.Content[0]
+	}
+	v.validate(node, schema, ROOT_PATH)
+	return v.violations
+}
+
+type validator struct {
+	root       *Schema
+	violations []wskderrors.SchemaViolation
+}
+
+func (v *validator) report(node *yaml.Node, path string, message string, suggestion string) {
+	v.violations = append(v.violations, wskderrors.SchemaViolation{
+		Line:       node.Line,
+		Column:     node.Column,
+		Path:       path,
+		Message:    message,
+		Suggestion: suggestion,
+	})
+}
+
+func (v *validator) resolve(schema *Schema) *Schema {
+	return resolveRef(v.root, schema)
+}
+
+// resolveRef follows the $ref of a schema to the root schema or to one of its definitions,
+// it returns nil for a reference which can not be resolved
+func resolveRef(root *Schema, schema *Schema) *Schema {
+	for depth := 0; schema != nil && len(schema.Ref) > 0; depth++ {
+		if depth > len(root.Definitions)+len(root.Defs) {
+			// references which only refer to each other
+			return nil
+		}
+		switch {
+		case schema.Ref == ROOT_REF:
+			schema = root
+		case strings.HasPrefix(schema.Ref, DEFS_REF):
+			schema = root.Defs[strings.TrimPrefix(schema.Ref, DEFS_REF)]
+		default:
+			schema = root.Definitions[strings.TrimPrefix(schema.Ref, DEFINITIONS_REF)]
+		}
+	}
+	return schema
+}
+
+func (v *validator) validate(node *yaml.Node, schema *Schema, path string) {
+	schema = v.resolve(schema)
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	// empty values are accepted by the YAML parser for every field
+	if schema == nil || nodeType(node) == TYPE_NULL {
+		return
+	}
+
+	if len(schema.Type) > 0 && !hasType(schema.Type, nodeType(node)) {
+		v.report(node, path, wski18n.T(wski18n.ID_ERR_SCHEMA_INVALID_TYPE_X_actual_X_expected_X,
+			map[string]interface{}{
+				wski18n.KEY_ACTUAL:   nodeType(node),
+				wski18n.KEY_EXPECTED: strings.Join(schema.Type, ", ")}), "")
+		return
+	}
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		v.validateObject(node, schema, path)
+	case yaml.SequenceNode:
+		if schema.Items != nil {
+			for i, item := range node.Content {
+				v.validate(item, schema.Items, fmt.Sprintf("%s[%d]", path, i))
+			}
+		}
+	}
+}
+
+func (v *validator) validateObject(node *yaml.Node, schema *Schema, path string) {
+	present := make(map[string]bool)
+	for _, pair := range mappingPairs(node) {
+		key, value := pair[0], pair[1]
+		present[key.Value] = true
+		keyPath := path + "." + key.Value
+
+		if property, ok := schema.Properties[key.Value]; ok {
+			v.validate(value, property, keyPath)
+			continue
+		}
+		if schema.AdditionalProperties == nil || schema.AdditionalProperties.allowed {
+			if schema.AdditionalProperties != nil {
+				v.validate(value, schema.AdditionalProperties.schema, keyPath)
+			}
+			continue
+		}
+		v.report(key, path, wski18n.T(wski18n.ID_ERR_SCHEMA_UNKNOWN_PROPERTY_X_key_X,
+			map[string]interface{}{wski18n.KEY_KEY: key.Value}),
+			suggest(key.Value, propertyNames(schema)))
+	}
+
+	for _, name := range schema.Required {
+		if !present[name] {
+			v.report(node, path, wski18n.T(wski18n.ID_ERR_SCHEMA_MISSING_PROPERTY_X_key_X,
+				map[string]interface{}{wski18n.KEY_KEY: name}), "")
+		}
+	}
+}
+
+// mappingPairs returns the key and value nodes of a mapping, the content of merge keys (<<)
+// is expanded in place as done by the YAML parser
+func mappingPairs(node *yaml.Node) [][2]*yaml.Node {
+	var pairs [][2]*yaml.Node
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if key.Value == MERGE_KEY && key.Tag == "!!merge" {
+			if value.Kind == yaml.AliasNode {
+				value = value.Alias
+			}
+			merged := []*yaml.Node{value}
+			if value.Kind == yaml.SequenceNode {
+				merged = value.Content
+			}
+			for _, m := range merged {
+				if m.Kind == yaml.AliasNode {
+					m = m.Alias
+				}
+				if m.Kind == yaml.MappingNode {
+					pairs = append(pairs, mappingPairs(m)...)
+				}
+			}
+			continue
+		}
+		pairs = append(pairs, [2]*yaml.Node{key, value})
+	}
+	return pairs
+}
+
+// nodeType returns the JSON Schema type of a YAML node
+func nodeType(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return TYPE_OBJECT
+	case yaml.SequenceNode:
+		return TYPE_ARRAY
+	}
+	switch node.ShortTag() {
+	case "!!null":
+		return TYPE_NULL
+	case "!!bool":
+		return TYPE_BOOLEAN
+	case "!!int":
+		return TYPE_INTEGER
+	case "!!float":
+		return TYPE_NUMBER
+	}
+	return TYPE_STRING
+}
+
+func hasType(types schemaTypes, actual string) bool {
+	for _, t := range types {
+		if t == actual || (t == TYPE_NUMBER && actual == TYPE_INTEGER) {
+			return true
+		}
+	}
+	return false
+}
+
+func propertyNames(schema *Schema) []string {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// suggest returns the candidate closest to an unknown property name, or an empty string
+// if none is close enough to be a likely correction
+func suggest(name string, candidates []string) string {
+	best := ""
+	bestDistance := -1
+	lower := strings.ToLower(name)
+	for _, candidate := range candidates {
+		distance := levenshtein(lower, strings.ToLower(candidate))
+		if distance > 2 && distance*3 > len(name) && !strings.HasPrefix(strings.ToLower(candidate), lower) {
+			continue
+		}
+		if bestDistance < 0 || distance < bestDistance {
+			best = candidate
+			bestDistance = distance
+		}
+	}
+	return best
+}
+
+// levenshtein returns the edit distance between two strings
+func levenshtein(a string, b string) int {
+	s, t := []rune(a), []rune(b)
+	previous := make([]int, len(t)+1)
+	current := make([]int, len(t)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		current[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(t)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
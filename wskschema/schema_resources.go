@@ -0,0 +1,120 @@
+package wskschema
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+)
+
+func bindata_read(data []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _wskschema_resources_deployment_schema_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x4b\xab\x23\x37\x13\xdd\xfb\x57\x14\x3d\xb3\xf8\x3e\xe8\xeb\xce\x2e\xe0\xac\xee\x62\xf2\x80\xbc\x20\x81\x10\x42\x16\x65\x75\x75\xb7\xc6\x6a\x49\x51\xa9\x6d\x9a\xc1\xff\x3d\xe8\xd1\x7e\x4e\xfb\x8e\x0d\x77\x42\x20\x70\x17\xbe\x52\x9d\xaa\xa3\xaa\xa3\x52\xf5\x87\x05\x40\xf1\x96\x45\x47\x3d\x16\x2b\x28\x3a\xef\xed\xaa\xaa\xde\xb3\xd1\x4f\x69\x75\x69\x5c\x5b\xd5\x0e\x1b\xff\xf4\xc5\x97\x55\x5a\x7b\x53\x94\x11\x27\xeb\x09\xc3\xab\xaa\x6a\xa5\xef\x86\xf5\x52\x98\xbe\x42\x8b\xa2\xa3\xca\x58\xd2\xbb\x4e\xf2\xe6\x69\xc7\x9b\x9a\xac\x32\x63\xb5\xe3\x4d\x72\x52\x39\x62\x33\x38\x41\x5c\xa5\xad\x9e\xb4\x5f\xe6\xa0\x81\x40\x0a\xe2\xa5\x57\x14\xc2\xfc\x64\x49\xff\x16\x9c\xc1\xd1\x1c\x1a\xa9\x28\xd9\xd5\xc4\xc2\x49\xeb\xa5\xd1\xc1\xfa\x1b\x87\x7d\x8f\x0e\x4c\x03\xbe\x23\x38\xc4\xbf\x04\x03\x32\x24\xe8\x9a\x6a\x58\x8f\xd1\x9a\x2d\x09\xd9\x48\x81\xc1\x5b\x05\xb5\x11\x43\x88\xc6\x4b\x78\xbe\xc2\xaf\xa5\xae\x39\xa2\x2c\x8a\x0d\xb6\xc4\x25\xa0\x08\x40\x06\xd4\x35\x78\x27\xdb\x96\x1c\x07\x26\x08\x3d\x6a\xd9\x10\x67\xac\x37\x80\xe0\xd1\xb5\xe4\x41\x63\x4f\x6c\x51\x50\x44\x59\x67\xb6\xb2\x26\x86\x2d\xaa\x81\x18\x1a\xe3\x42\x0c\xe9\x40\x6a\x3b\xf8\xe4\x1a\xb5\x36\x3e\x72\xe4\x25\xbc\xeb\xad\x1f\xe1\x7f\x7a\x50\xea\xff\x13\x0a\x1d\x01\x0a\x41\xd6\x53\x1d\x5d\xd0\x96\xdc\x08\x8d\x24\x55\x97\xe1\xe4\xbe\xa3\x31\x5a\xe5\x83\xff\xfe\xfc\xc3\xf7\x60\xd1\x31\xb9\x65\x4e\xff\x68\x63\xf6\xcd\xfa\x3d\x09\x9f\xd6\xac\x33\x96\x9c\x97\xc4\xc5\x0a\x82\x82\xd2\x5a\x34\x58\xc1\x07\x28\xde\x3a\x6a\x02\xe8\x4d\x55\x53\x23\xb5\x8c\x14\xab\xc9\x04\xf6\x65\xc6\xe4\x84\xdd\x04\x4d\x36\xb0\x5f\x40\x42\x16\x58\xd7\xd1\x25\xaa\x9f\x4f\x99\x34\xa8\x98\xb2\x16\x0e\x0e\x8e\x0c\x59\xa0\x42\x77\xf8\xff\x5a\x32\xcf\xc0\xde\x49\xdd\xa6\xfc\x7c\x05\x7a\xe8\xd7\xa1\x70\x21\xd5\x6b\x63\x14\xa1\xbe\x48\x69\xd8\x11\x46\x6f\xc9\x85\x04\x7b\x93\x1d\x70\xca\x1d\xc0\x31\x7f\x7f\x14\x69\xab\x28\xa1\x48\x7e\xc3\xaf\xec\xb5\xf8\x33\x32\x9a\xd2\x72\x52\xd6\x1b\x6c\xbf\x76\x44\xa1\xa6\xfd\xa9\x0c\xca\xa9\xf2\x02\x35\xac\x29\x6a\x4e\x8f\xe0\x47\x4b\xd7\x9c\xa6\x9a\x9e\x05\xb7\xe8\xb0\x27\x4f\xb7\x12\xf5\x4e\xfa\x8e\x1c\x20\xb0\xd4\xad\xa2\x27\x25\x35\xa5\xc0\xa7\x01\x21\x49\x16\xfa\x41\x79\x99\x6c\x22\xdd\x9d\xf4\x5d\x64\x54\xe6\x8b\x17\x8f\x94\x99\x97\xe0\xe8\xaf\x41\x3a\xaa\xc3\x6e\x83\x83\xf2\x25\xb0\x47\x3f\xa4\x3a\xe4\xf6\x30\xc3\xf9\xa8\xc7\xeb\x53\x1e\x4e\x3f\xa3\x9e\x1b\x0a\x9c\x32\x02\xfb\xf3\xb0\x47\xc9\xbf\x14\xf3\x23\x37\x26\xfc\x15\xe1\xd2\xdf\x8a\x9d\x35\x3b\x85\x3c\x60\x62\xa3\xb8\x17\x28\x1c\xd5\xa4\xbd\x44\x75\x2f\x12\xad\xfc\xd6\xb0\x7f\x00\xd6\xee\x9e\x85\x20\xe6\x5f\xcd\x86\xf4\xbd\xf8\x2d\x39\x96\xe6\x6e\x58\xea\x90\x9f\x54\x52\x3e\x47\xde\xdf\x90\x4e\xf4\xf0\x42\x63\xba\x50\xec\x31\xd0\x4b\xda\xb9\x5f\xaf\xd1\xf7\x95\x5a\xf3\xf2\x67\x56\xeb\x83\x35\x54\x52\x90\xe6\xff\xee\xc6\xec\xdd\x78\x5c\xe4\x17\xcf\xcb\x1c\xfc\xd4\xec\x1c\x2f\x2e\x9f\xa6\x5b\x62\x7a\x48\xc2\xa1\x12\x7e\x3c\xde\xb0\x83\x8a\xc3\x5f\x31\x4d\x54\xaf\xc9\x20\xc7\x98\xa3\xe0\x06\x45\xaf\x1a\x3f\x04\x38\x0b\xbe\xb8\x20\xf1\xe9\xbd\x26\x27\x73\xfe\x39\xff\xee\xa3\x13\x65\x7a\xca\xf3\x28\x1b\xde\x62\xa9\xa9\x06\xa9\xd3\x9b\x7e\x3a\xc6\xce\x4f\x16\x2f\x76\x94\xcf\xac\xe2\x0c\x7f\x20\x89\x93\x1e\xe6\xb3\xf8\xe3\xd4\x7a\xca\x99\x11\x3d\x26\x74\xfa\x1c\x78\xad\x84\xfe\x03\x0d\xf0\x5f\x53\xc3\x78\xa7\xe6\x0b\xf8\x4b\x9a\x32\x63\x95\x82\xe9\x6b\x95\x28\x4d\xb3\x67\x6b\xd7\x64\x50\x78\xb9\x8d\x23\xb4\xd4\xe9\x77\x98\x8c\xad\x42\x41\xf1\xab\x6d\x9a\x89\x4d\x73\x8b\xdb\x19\xbf\xfc\x01\x72\xd8\x7a\x24\x93\x0b\x80\xfd\x62\xbf\xf8\x7b\x00\x25\x02\xcb\xf3\x36\x10\x00\x00")
+
+func wskschema_resources_deployment_schema_json() ([]byte, error) {
+	return bindata_read(
+		_wskschema_resources_deployment_schema_json,
+		"wskschema/resources/deployment.schema.json",
+	)
+}
+
+var _wskschema_resources_manifest_schema_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5a\xcb\x6e\xdc\x36\x17\xde\xcf\x53\x1c\x28\x59\xfc\x3f\x20\x7b\xba\x2b\xe0\xae\x0c\x34\x6d\x03\x24\x68\x90\xb6\x28\x8a\x22\x0b\x0e\x79\x34\x62\x4c\x91\x0c\x49\x79\x3a\x0d\xe6\xdd\x0b\x52\xd4\xd5\x96\x46\x92\x2f\x69\x50\xc0\x0b\x83\x3a\xdf\xb9\x7e\x3c\xbc\xcd\xe7\x0d\x40\xf2\xd2\xd2\x1c\x0b\x92\x5c\x41\x92\x3b\xa7\xaf\xb6\xdb\x8f\x56\xc9\x8b\x6a\xf4\x52\x99\xfd\x96\x19\x92\xb9\x8b\x6f\xbe\xdd\x56\x63\x2f\x92\x34\xe0\x38\xab\x31\xf6\x6a\xbb\xdd\x73\x97\x97\xbb\x4b\xaa\x8a\x2d\xd1\x84\xe6\xb8\x55\x1a\xe5\x21\xe7\xf6\xe6\xe2\x60\x6f\x18\x6a\xa1\x8e\xdb\x83\xbd\xa9\x94\x6c\x0d\x5a\x55\x1a\x8a\x76\x5b\x10\xc9\x33\xb4\xee\x32\x9a\xf4\xe6\x2b\x13\x8e\x3b\x81\xde\xc8\xcf\x1a\xe5\xef\x5e\x15\xd4\xc2\x90\x71\x81\x95\x14\x43\x4b\x0d\xd7\x8e\x2b\xe9\x65\x7f\x34\xa4\x28\x88\x01\x95\x81\xcb\x11\x1a\xdb\x7d\x28\x10\x0b\x15\x70\x87\x0c\x76\xc7\x20\x6b\x35\x52\x9e\x71\x4a\xbc\xae\x2d\x30\x45\xcb\x02\xa5\xb3\x97\xf0\xaa\xd0\xee\x08\xff\x93\xa5\x10\xff\x87\x5b\x22\x4a\xb4\x40\x0c\x02\xa1\x14\xb5\x43\x06\x99\x32\x80\xb7\x68\x8e\x90\x71\x14\x2c\xf5\xfa\x5d\x8e\xc7\x20\x15\xd5\xff\x71\xfd\xf6\x0d\x68\x62\x2c\x9a\xcb\x18\xe0\x51\x87\xf8\xd4\xee\x23\x52\x57\x8d\x69\xa3\x34\x1a\xc7\xd1\x26\x57\xe0\x2b\x04\x90\xf0\x42\x2b\xe3\xda\x81\xbb\x51\xbf\x23\x2e\xb7\xa0\x0c\xec\x85\xda\x59\x1f\x7c\x2f\x5c\x9b\x82\x41\x41\x1c\xbf\x45\x70\x0a\x5c\xce\x6d\x18\x4f\xe1\x90\x2b\x8b\xa0\x09\xbd\x21\xfb\x18\x54\x81\x66\x8f\x0c\xb8\x0c\x92\x08\xda\x28\xef\x5e\xe5\x33\x40\xc7\x6f\x62\x0c\x39\xb6\xc3\xdc\x61\x11\x9c\x6c\x25\xac\x33\x5c\xee\x13\x38\x05\x99\x53\x25\x9a\x44\x8d\x95\xe8\x4b\x83\x99\x17\x7d\xb1\x65\x98\x71\xc9\x7d\x48\x76\x5b\x8b\xb4\x98\xe8\xe2\x24\xa8\x96\x09\xf6\x02\x32\x21\x8c\x05\x95\x44\xbc\xeb\x66\x36\x23\xc2\x62\xe4\x4f\xa3\xa0\xcd\xb8\xa5\x44\x10\x33\x91\xf0\x6b\xa8\x42\xab\xea\xfd\x1d\xc8\xb2\xd8\xa1\xb1\x40\x24\x83\x9d\x52\x02\x89\x1c\x50\xc4\x7f\xa1\x4a\xde\xa2\xf1\x84\x71\x2a\x2a\xb0\x77\xf3\xfa\x67\x9d\xb6\x14\x92\x4a\x6f\x92\x42\x12\xb5\x26\x1f\x7a\xa9\x24\x52\x2a\x47\xfa\xde\xdf\xf5\xf6\x07\x83\xe8\x39\x5a\x40\x47\x3e\xad\x99\x4c\x89\x84\x1d\x7a\xd2\x10\x79\x04\x5f\xba\x7b\x6a\x1d\x39\xda\xaf\x23\x31\xa4\x40\x87\x53\x89\x7a\xc5\x5d\x8e\x06\x08\x58\x2e\xf7\x02\x2f\x04\x97\x58\x19\xee\x1a\xf4\xd4\xf5\x53\xb0\x28\x85\xe3\x95\x4c\x70\xf7\xc0\x5d\x1e\x3c\x4a\xe3\x74\x0d\x05\x88\x9e\x7b\x52\x7f\x2a\xb9\x41\xe6\xbf\x66\xa4\x14\x2e\x05\xeb\x88\x2b\xab\x3a\xc4\x86\x32\xe2\x73\x2f\x5f\x83\x28\x9b\xe8\x47\xd8\x33\xc1\xc0\x3a\x23\xe3\x94\x3f\x67\xf3\x9e\x0e\xe0\xff\x12\x49\x0a\x9c\xb2\x1d\x39\x5b\x9b\x6c\x30\x56\x13\xba\x18\x48\x0d\x32\x94\x8e\x13\xb1\x14\x49\x34\xff\x49\x59\xb7\x02\xb6\x3f\x5c\x53\x8a\xd6\xfe\xaa\x6e\x50\x2e\xc5\xdf\xa2\xb1\x5c\x2d\x86\x51\x25\x33\xbe\x5f\x8a\xe2\x52\x97\x6e\x1e\x11\x6c\x1f\x59\x77\xda\xf9\x6d\xac\xc3\xa2\x33\xed\x6c\xc0\xf3\xd6\xd0\x39\xc6\x2d\x67\x79\xd0\x7d\x87\xe3\x71\xf8\x99\x39\xbe\xb2\xf2\x82\x53\x94\x76\xb1\x35\x5d\xee\x04\xa7\xfd\x55\xae\x6e\xcd\x3d\x41\x83\x5a\x59\xee\x94\x19\x06\x39\xba\x80\x0e\x17\xd1\xfb\x9d\x6a\xf4\x1e\x5b\x7e\x34\x35\x88\x1d\x58\xa3\x64\x28\xe9\x84\xe5\x41\x41\x56\xd1\xa0\xb1\x33\xea\xc9\x7f\xa7\xfd\x10\x3a\x5c\x83\x1f\x3d\xdd\x95\x89\xb1\x54\x5b\xfc\x54\xa2\xa4\x4f\x5b\xf1\xda\xc8\x98\x13\xce\xf0\xfd\x1e\xcd\x93\xfa\x10\x6d\x8c\xb9\x90\x21\xb2\x27\xb5\xef\x0d\x8c\x19\x37\xa5\x78\xda\x02\x78\x03\x63\xc6\x89\xe6\x93\xd8\xf0\xfd\x91\x96\xb1\xfe\x26\x6f\xd9\x4c\xe9\xef\x58\xc7\xa0\x5d\xb1\x26\xe0\x46\xcf\xfc\x75\xb0\xd3\x2e\xdb\xba\x2c\x5e\x97\x4a\xb3\xb8\x11\x3d\x20\x45\x0b\xbb\x5f\x04\xae\x48\x4e\xa7\x83\x3f\x20\x39\x6b\x17\x60\x55\x9d\xb4\x9f\x6f\xf3\xf5\xcc\xd4\x13\xbc\xe0\xce\x3e\x24\xb3\x8e\x17\xa8\xca\xb8\x98\xd5\x60\x2e\x1d\x56\x0d\xb0\xc6\x03\x24\x05\x16\xca\x1c\x7f\xe1\x7f\xe3\x0c\x61\xa1\xf6\x33\x25\xa9\x92\xb4\x34\x06\xa5\xbb\xa6\x8e\xdf\x76\x73\x37\x89\x2b\x2d\x9a\xd7\xf2\x36\x56\xf8\x3d\x71\xf3\x8c\x31\x9c\xe9\x57\x53\xe1\x29\xf9\x28\xbe\xa2\x72\x71\xa9\x7d\xe6\x8d\xec\xda\x09\xb1\x72\xfe\x65\xa5\xa4\x6e\x05\xce\x97\x69\x29\xc6\x94\xd2\x73\xf9\x2b\x38\xbe\xe2\x5f\x5a\x59\x64\xbf\x2d\x6f\xf8\x07\xdc\x5d\x78\xb4\x59\xbc\xf9\x3c\xe0\x6e\x29\xa4\x20\x7c\x71\xe1\x98\xa2\x37\x68\x96\xa2\x64\xb8\x36\x9c\x71\xe0\xa1\x4a\xb2\x92\x3a\x65\x66\xc8\xb6\xad\x71\xcc\x95\x28\xf1\x48\x9d\x5f\x95\xee\x6b\xd9\xea\x74\xf0\x5c\x52\x51\xb2\xee\x81\x7a\xf6\xe9\xb1\x33\x38\x89\x98\x73\xe2\x6c\x42\xeb\xe0\xea\xe6\xda\x69\xaf\x61\xf2\xac\x76\x78\xbe\xf1\xd3\x66\x60\x78\x7e\x5f\x6f\xce\x2f\xad\x83\x8b\x3b\x7b\xe7\xa4\x77\xd6\xe7\x87\xcd\xf1\x55\xf4\x89\xf0\x46\x4f\x52\xdf\x91\x86\x8b\xe5\xda\xf9\x0f\xcb\x73\x57\x9f\xbb\x1e\x90\xba\x70\x74\xfa\xf7\x2f\x01\xeb\xdb\x4c\x75\xf9\xdc\x0b\xfa\x4e\x07\x49\x88\xdf\x49\x85\x1b\x6f\x2e\xab\xff\x53\xd0\xa4\xb4\x18\x9e\x8c\xc0\x27\xa9\x7e\xbd\x8a\x39\x87\x43\x8e\xb2\x91\x6e\x2f\xe7\x7b\x45\x88\x6f\x06\xcd\xa7\x53\xfa\xb0\xdd\xc8\x17\xec\x7b\xd5\xd3\xe0\x99\x34\x7e\x8f\xda\x20\x25\x0e\xd9\x20\x21\x93\x7e\x3e\x46\x17\xa9\x69\xbc\x19\x54\x60\xee\x34\xf8\xa2\x94\xbe\x8f\x3a\x03\xc7\xa7\xb2\x71\xde\x6a\xa3\xe4\x31\xb6\xb6\x84\xae\x41\xf9\xb4\xb7\xf4\x9b\xe0\xd0\x1b\x9e\x21\x3d\x52\x81\xd0\x42\xc0\x96\xda\x6f\xe2\xda\x77\x61\x5f\xef\x14\x90\xd0\x1c\x54\x80\x12\x21\x8e\x20\x49\xc1\xe5\x3e\x4c\xd9\xca\xcb\x7a\xd2\xc6\xdb\x71\xc8\x89\x64\xc2\x8b\xf0\xce\xdb\xe9\xd9\xbc\x8f\x90\xa6\xae\xff\xf0\x24\xd5\x7f\x2b\x14\x22\xf9\xd0\xcd\x44\x5c\x00\x08\x5b\x8a\x29\x35\x5b\x61\x89\xa1\xc0\xe5\xa8\xd0\xfb\x96\x82\x4a\x39\x0f\xd6\x41\x9d\xe6\xf0\xbb\x9d\xed\xab\x7b\xe8\x66\x80\x3d\x6f\x2a\x8a\x26\xe1\x76\xaf\xad\xfa\x18\x4f\x46\x1b\x4b\x67\x89\x7e\xfa\x49\x56\x3b\xbb\x04\xb3\x7a\x7d\x8c\x63\xbb\x63\xfd\xd0\x3b\x32\xa3\x22\x01\x9a\x4f\xab\x0a\x98\x6e\x46\x5c\x7b\xfa\x45\x70\x33\x70\xbb\xbf\x7b\xab\xeb\x9b\x36\x35\x5b\xb1\x8f\xab\xaf\x89\x37\xf7\x86\x98\x5c\xbf\x7b\xed\x5b\x1b\xa6\xb0\x23\xe1\x97\x21\x2e\xef\xfc\x78\x44\x13\x97\x87\xb7\xf5\xd8\xf2\x2a\xc9\x82\x68\x5d\xfd\xa6\xc1\xf7\x3f\xaf\xa1\x40\x97\x2b\x16\x24\x0d\x5a\xad\xa4\xc5\x33\x3f\x2c\x38\x17\xc8\xe7\xcd\x9d\x62\x0f\x90\x73\xb0\x53\xe8\x79\xf8\x69\x0d\x13\x3a\x46\x09\xa0\xf9\xdb\x90\xad\xf7\x31\x51\x2d\x0d\xba\xfb\x94\xce\x8e\x65\x58\xce\x01\xbc\xf5\x77\x71\x03\xa9\xca\xb6\x94\xe9\x75\x85\x67\xe1\x22\xac\xc1\xcf\x20\xee\x06\xe0\xb4\x39\x6d\xfe\x19\x00\x7a\xdf\x4a\x9b\xaf\x26\x00\x00")
+
+func wskschema_resources_manifest_schema_json() ([]byte, error) {
+	return bindata_read(
+		_wskschema_resources_manifest_schema_json,
+		"wskschema/resources/manifest.schema.json",
+	)
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		return f()
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() ([]byte, error){
+	"wskschema/resources/deployment.schema.json": wskschema_resources_deployment_schema_json,
+	"wskschema/resources/manifest.schema.json": wskschema_resources_manifest_schema_json,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for name := range node.Children {
+		rv = append(rv, name)
+	}
+	return rv, nil
+}
+
+type _bintree_t struct {
+	Func func() ([]byte, error)
+	Children map[string]*_bintree_t
+}
+var _bintree = &_bintree_t{nil, map[string]*_bintree_t{
+	"wskschema": &_bintree_t{nil, map[string]*_bintree_t{
+		"resources": &_bintree_t{nil, map[string]*_bintree_t{
+			"deployment.schema.json": &_bintree_t{wskschema_resources_deployment_schema_json, map[string]*_bintree_t{
+			}},
+			"manifest.schema.json": &_bintree_t{wskschema_resources_manifest_schema_json, map[string]*_bintree_t{
+			}},
+		}},
+	}},
+}}
@@ -0,0 +1,210 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wskschema
+
+import (
+	"testing"
+
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func validateManifest(t *testing.T, manifest string) []wskderrors.SchemaViolation {
+	err := ValidateManifest("manifest.yaml", []byte(manifest))
+	if err == nil {
+		return nil
+	}
+	schemaErr, ok := err.(*wskderrors.SchemaValidationError)
+	assert.True(t, ok, "Expected a schema validation error, got: "+err.Error())
+	return schemaErr.Violations
+}
+
+func TestValidateManifest_Valid(t *testing.T) {
+	manifest := `
+imports:
+  - teams/*/manifest.yaml
+project:
+  name: hello
+  version: 1.0
+  packages:
+    hello_world_package:
+      version: 1.0
+      license: Apache-2.0
+      public: true
+      inputs:
+        name: Amy
+        place:
+          type: string
+          default: Paris
+      actions:
+        hello: &hello
+          function: src/hello.js
+          runtime: nodejs:10
+          web-export: true
+          limits:
+            timeout: 60000
+            memorySize: 256
+          annotations:
+            final: true
+          include:
+            - ["src/lib", "lib"]
+        hello2:
+          <<: *hello
+          main: hello
+        empty:
+      sequences:
+        hello-sequence:
+          actions: hello, hello2
+      triggers:
+        everyMinute:
+          feed: /whisk.system/alarms/alarm
+      rules:
+        everyMinuteRule:
+          trigger: everyMinute
+          action: hello
+      apis:
+        hello-api:
+          hello:
+            world:
+              hello:
+                method: GET
+                response: json
+`
+	assert.Empty(t, validateManifest(t, manifest))
+}
+
+func TestValidateManifest_UnknownPropertiesWithSuggestions(t *testing.T) {
+	manifest := `packages:
+  hello_world_package:
+    actions:
+      hello:
+        fuction: src/hello.js
+        input:
+          name: Amy
+        limits:
+          memory: 256
+        colour: blue
+`
+	violations := validateManifest(t, manifest)
+	if assert.Equal(t, 4, len(violations)) {
+		assert.Equal(t, wskderrors.SchemaViolation{
+			Line:       5,
+			Column:     9,
+			Path:       "$.packages.hello_world_package.actions.hello",
+			Message:    "Unknown property [fuction].",
+			Suggestion: "function",
+		}, violations[0])
+		assert.Equal(t, "inputs", violations[1].Suggestion)
+		assert.Equal(t, 6, violations[1].Line)
+		assert.Equal(t, "memorySize", violations[2].Suggestion)
+		assert.Equal(t, "$.packages.hello_world_package.actions.hello.limits", violations[2].Path)
+		assert.Equal(t, "", violations[3].Suggestion)
+		assert.Equal(t, 10, violations[3].Line)
+	}
+}
+
+func TestValidateManifest_InvalidTypes(t *testing.T) {
+	manifest := `packages:
+  hello_world_package:
+    public: yes please
+    actions:
+      hello:
+        limits:
+          timeout: one minute
+        exclude: src/test
+      bye: src/bye.js
+`
+	violations := validateManifest(t, manifest)
+	if assert.Equal(t, 4, len(violations)) {
+		assert.Equal(t, "$.packages.hello_world_package.public", violations[0].Path)
+		assert.Equal(t, "Invalid value of type [string], expected [boolean].", violations[0].Message)
+		assert.Equal(t, 3, violations[0].Line)
+		assert.Equal(t, 13, violations[0].Column)
+		assert.Equal(t, "$.packages.hello_world_package.actions.hello.limits.timeout", violations[1].Path)
+		assert.Equal(t, "Invalid value of type [string], expected [integer].", violations[1].Message)
+		assert.Equal(t, "$.packages.hello_world_package.actions.hello.exclude", violations[2].Path)
+		assert.Equal(t, "$.packages.hello_world_package.actions.bye", violations[3].Path)
+		assert.Equal(t, "Invalid value of type [string], expected [object].", violations[3].Message)
+	}
+}
+
+func TestValidateManifest_MissingRequiredProperties(t *testing.T) {
+	manifest := `packages:
+  hello_world_package:
+    rules:
+      helloRule:
+        trigger: everyMinute
+`
+	violations := validateManifest(t, manifest)
+	if assert.Equal(t, 1, len(violations)) {
+		assert.Equal(t, "Missing required property [action].", violations[0].Message)
+		assert.Equal(t, "$.packages.hello_world_package.rules.helloRule", violations[0].Path)
+		assert.Equal(t, 5, violations[0].Line)
+	}
+}
+
+func TestValidateManifest_ErrorMessage(t *testing.T) {
+	err := ValidateManifest("manifest.yaml", []byte("packages:\n  p:\n    action:\n"))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "1 violation(s) found")
+	assert.Contains(t, err.Error(), "manifest.yaml:3:5: $.packages.p: Unknown property [action]. Did you mean [actions]?")
+}
+
+func TestValidateManifest_MalformedYAML(t *testing.T) {
+	err := ValidateManifest("manifest.yaml", []byte("packages:\n  p:\n    actions: [\n"))
+	assert.NotNil(t, err, "Malformed YAML should fail the schema validation.")
+	assert.Equal(t, wskderrors.ERROR_YAML_FILE_FORMAT_ERROR, err.(*wskderrors.YAMLFileFormatError).ErrorType)
+	assert.Nil(t, ValidateManifest("manifest.yaml", []byte("")))
+}
+
+func TestValidateDeployment(t *testing.T) {
+	deployment := `project:
+  name: hello
+  namespace: guest
+  packages:
+    hello_world_package:
+      inputs:
+        name: Amy
+      actions:
+        hello:
+          inputs:
+            place: Paris
+          function: src/hello.js
+      triggers:
+        everyMinute:
+          inputs:
+            cron: "* * * * *"
+`
+	err := ValidateDeployment("deployment.yaml", []byte(deployment))
+	schemaErr, ok := err.(*wskderrors.SchemaValidationError)
+	if assert.True(t, ok) && assert.Equal(t, 1, len(schemaErr.Violations)) {
+		assert.Equal(t, "Unknown property [function].", schemaErr.Violations[0].Message)
+		assert.Equal(t, 12, schemaErr.Violations[0].Line)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	candidates := []string{"actions", "inputs", "memorySize", "namespace", "triggers"}
+	assert.Equal(t, "actions", suggest("action", candidates))
+	assert.Equal(t, "triggers", suggest("triger", candidates))
+	assert.Equal(t, "namespace", suggest("NameSpace", candidates))
+	assert.Equal(t, "memorySize", suggest("memory", candidates))
+	assert.Equal(t, "", suggest("colour", candidates))
+}
@@ -0,0 +1,403 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wskschema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+	"gopkg.in/yaml.v3"
+)
+
+// JSON pointers escape "~" and "/" in the names of properties
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// NewSchema returns the schema held by a value decoded from YAML or JSON, e.g. a schema
+// declared inline in a manifest file
+func NewSchema(value interface{}) (*Schema, error) {
+	data, err := json.Marshal(toJSONValue(value))
+	if err != nil {
+		return nil, err
+	}
+	return ParseSchema(data)
+}
+
+// ReadSchemaFile reads a schema from a JSON or YAML file
+func ReadSchemaFile(path string) (*Schema, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := yaml.Unmarshal(content, &value); err != nil {
+		return nil, err
+	}
+	return NewSchema(value)
+}
+
+// ParseSchema parses a JSON schema, compiles its patterns and checks that its references
+// can be resolved
+func ParseSchema(data []byte) (*Schema, error) {
+	schema := new(Schema)
+	if err := json.Unmarshal(data, schema); err != nil {
+		return nil, err
+	}
+	if err := schema.compile(schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+func (schema *Schema) compile(root *Schema) error {
+	if schema == nil {
+		return nil
+	}
+	if len(schema.Ref) > 0 && resolveRef(root, schema) == nil {
+		return errors.New(wski18n.T(wski18n.ID_ERR_SCHEMA_REF_NOT_FOUND_X_key_X,
+			map[string]interface{}{wski18n.KEY_KEY: schema.Ref}))
+	}
+	if len(schema.Pattern) > 0 {
+		pattern, err := regexp.Compile(schema.Pattern)
+		if err != nil {
+			return err
+		}
+		schema.pattern = pattern
+	}
+
+	subschemas := []*Schema{schema.Items, schema.Not}
+	if schema.AdditionalProperties != nil {
+		subschemas = append(subschemas, schema.AdditionalProperties.schema)
+	}
+	subschemas = append(subschemas, schema.AllOf...)
+	subschemas = append(subschemas, schema.AnyOf...)
+	subschemas = append(subschemas, schema.OneOf...)
+	for _, properties := range []map[string]*Schema{schema.Properties, schema.Definitions, schema.Defs} {
+		for _, name := range sortedNames(properties) {
+			subschemas = append(subschemas, properties[name])
+		}
+	}
+	for _, subschema := range subschemas {
+		if err := subschema.compile(root); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateValue returns every violation of the schema found in a value decoded from YAML
+// or JSON, e.g. the value of a parameter. The path of a violation is the JSON pointer of
+// the offending value, the empty string being the value itself.
+func (schema *Schema) ValidateValue(value interface{}) []wskderrors.SchemaViolation {
+	v := &valueValidator{root: schema}
+	v.validate(toJSONValue(value), schema, "")
+	return v.violations
+}
+
+type valueValidator struct {
+	root       *Schema
+	violations []wskderrors.SchemaViolation
+}
+
+func (v *valueValidator) report(path string, message string, suggestion string) {
+	v.violations = append(v.violations, wskderrors.SchemaViolation{
+		Path:       path,
+		Message:    message,
+		Suggestion: suggestion,
+	})
+}
+
+func (v *valueValidator) reportf(path string, id string, args map[string]interface{}) {
+	v.report(path, wski18n.T(id, args), "")
+}
+
+// matches tells if a value conforms to a subschema, without reporting its violations
+func (v *valueValidator) matches(value interface{}, schema *Schema, path string) bool {
+	sub := &valueValidator{root: v.root}
+	sub.validate(value, schema, path)
+	return len(sub.violations) == 0
+}
+
+func (v *valueValidator) validate(value interface{}, schema *Schema, path string) {
+	schema = resolveRef(v.root, schema)
+	if schema == nil {
+		return
+	}
+
+	actual := valueType(value)
+	if len(schema.Type) > 0 && !hasType(schema.Type, actual) {
+		v.reportf(path, wski18n.ID_ERR_SCHEMA_INVALID_TYPE_X_actual_X_expected_X,
+			map[string]interface{}{
+				wski18n.KEY_ACTUAL:   actual,
+				wski18n.KEY_EXPECTED: strings.Join(schema.Type, ", ")})
+		return
+	}
+
+	if len(schema.Enum) > 0 && !contains(schema.Enum, value) {
+		expected := make([]string, 0, len(schema.Enum))
+		for _, e := range schema.Enum {
+			expected = append(expected, toJSONString(e))
+		}
+		v.reportf(path, wski18n.ID_ERR_SCHEMA_ENUM_X_expected_X,
+			map[string]interface{}{wski18n.KEY_EXPECTED: strings.Join(expected, ", ")})
+	}
+	if len(schema.Const) > 0 {
+		var expected interface{}
+		if err := json.Unmarshal(schema.Const, &expected); err == nil && !reflect.DeepEqual(expected, value) {
+			v.reportf(path, wski18n.ID_ERR_SCHEMA_CONST_X_expected_X,
+				map[string]interface{}{wski18n.KEY_EXPECTED: toJSONString(expected)})
+		}
+	}
+
+	switch typed := value.(type) {
+	case float64:
+		v.validateNumber(typed, schema, path)
+	case string:
+		v.validateString(typed, schema, path)
+	case []interface{}:
+		v.validateArray(typed, schema, path)
+	case map[string]interface{}:
+		v.validateObject(typed, schema, path)
+	}
+
+	for _, subschema := range schema.AllOf {
+		v.validate(value, subschema, path)
+	}
+	if len(schema.AnyOf) > 0 {
+		matched := false
+		for _, subschema := range schema.AnyOf {
+			if v.matches(value, subschema, path) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			v.reportf(path, wski18n.ID_ERR_SCHEMA_ANY_OF, nil)
+		}
+	}
+	if len(schema.OneOf) > 0 {
+		count := 0
+		for _, subschema := range schema.OneOf {
+			if v.matches(value, subschema, path) {
+				count++
+			}
+		}
+		if count != 1 {
+			v.reportf(path, wski18n.ID_ERR_SCHEMA_ONE_OF_X_count_X,
+				map[string]interface{}{wski18n.KEY_COUNT: count})
+		}
+	}
+	if schema.Not != nil && v.matches(value, schema.Not, path) {
+		v.reportf(path, wski18n.ID_ERR_SCHEMA_NOT, nil)
+	}
+}
+
+func (v *valueValidator) validateNumber(value float64, schema *Schema, path string) {
+	limits := []struct {
+		limit    *float64
+		violated func(limit float64) bool
+		id       string
+	}{
+		{schema.Minimum, func(limit float64) bool { return value < limit }, wski18n.ID_ERR_SCHEMA_MINIMUM_X_limit_X},
+		{schema.Maximum, func(limit float64) bool { return value > limit }, wski18n.ID_ERR_SCHEMA_MAXIMUM_X_limit_X},
+		{schema.ExclusiveMinimum, func(limit float64) bool { return value <= limit }, wski18n.ID_ERR_SCHEMA_EXCLUSIVE_MINIMUM_X_limit_X},
+		{schema.ExclusiveMaximum, func(limit float64) bool { return value >= limit }, wski18n.ID_ERR_SCHEMA_EXCLUSIVE_MAXIMUM_X_limit_X},
+		{schema.MultipleOf, func(limit float64) bool {
+			quotient := value / limit
+			return limit > 0 && math.Abs(quotient-math.Round(quotient)) > 1e-9
+		}, wski18n.ID_ERR_SCHEMA_MULTIPLE_OF_X_limit_X},
+	}
+	for _, l := range limits {
+		if l.limit != nil && l.violated(*l.limit) {
+			v.reportf(path, l.id, map[string]interface{}{wski18n.KEY_LIMIT: toJSONString(*l.limit)})
+		}
+	}
+}
+
+func (v *valueValidator) validateString(value string, schema *Schema, path string) {
+	length := utf8.RuneCountInString(value)
+	if schema.MinLength != nil && length < *schema.MinLength {
+		v.reportf(path, wski18n.ID_ERR_SCHEMA_MIN_LENGTH_X_limit_X,
+			map[string]interface{}{wski18n.KEY_LIMIT: *schema.MinLength})
+	}
+	if schema.MaxLength != nil && length > *schema.MaxLength {
+		v.reportf(path, wski18n.ID_ERR_SCHEMA_MAX_LENGTH_X_limit_X,
+			map[string]interface{}{wski18n.KEY_LIMIT: *schema.MaxLength})
+	}
+	if schema.pattern != nil && !schema.pattern.MatchString(value) {
+		v.reportf(path, wski18n.ID_ERR_SCHEMA_PATTERN_X_expected_X,
+			map[string]interface{}{wski18n.KEY_EXPECTED: schema.Pattern})
+	}
+}
+
+func (v *valueValidator) validateArray(value []interface{}, schema *Schema, path string) {
+	if schema.MinItems != nil && len(value) < *schema.MinItems {
+		v.reportf(path, wski18n.ID_ERR_SCHEMA_MIN_ITEMS_X_limit_X,
+			map[string]interface{}{wski18n.KEY_LIMIT: *schema.MinItems})
+	}
+	if schema.MaxItems != nil && len(value) > *schema.MaxItems {
+		v.reportf(path, wski18n.ID_ERR_SCHEMA_MAX_ITEMS_X_limit_X,
+			map[string]interface{}{wski18n.KEY_LIMIT: *schema.MaxItems})
+	}
+	if schema.UniqueItems {
+		for i := 1; i < len(value); i++ {
+			if contains(value[:i], value[i]) {
+				v.reportf(fmt.Sprintf("%s/%d", path, i), wski18n.ID_ERR_SCHEMA_UNIQUE_ITEMS, nil)
+			}
+		}
+	}
+	if schema.Items != nil {
+		for i, item := range value {
+			v.validate(item, schema.Items, fmt.Sprintf("%s/%d", path, i))
+		}
+	}
+}
+
+func (v *valueValidator) validateObject(value map[string]interface{}, schema *Schema, path string) {
+	names := sortedNames(value)
+	for _, name := range names {
+		propertyPath := path + "/" + pointerEscaper.Replace(name)
+
+		if property, ok := schema.Properties[name]; ok {
+			v.validate(value[name], property, propertyPath)
+			continue
+		}
+		if schema.AdditionalProperties == nil || schema.AdditionalProperties.allowed {
+			if schema.AdditionalProperties != nil {
+				v.validate(value[name], schema.AdditionalProperties.schema, propertyPath)
+			}
+			continue
+		}
+		v.report(propertyPath, wski18n.T(wski18n.ID_ERR_SCHEMA_UNKNOWN_PROPERTY_X_key_X,
+			map[string]interface{}{wski18n.KEY_KEY: name}),
+			suggest(name, propertyNames(schema)))
+	}
+
+	for _, name := range schema.Required {
+		if _, present := value[name]; !present {
+			v.reportf(path, wski18n.ID_ERR_SCHEMA_MISSING_PROPERTY_X_key_X,
+				map[string]interface{}{wski18n.KEY_KEY: name})
+		}
+	}
+	if schema.MinProperties != nil && len(value) < *schema.MinProperties {
+		v.reportf(path, wski18n.ID_ERR_SCHEMA_MIN_PROPERTIES_X_limit_X,
+			map[string]interface{}{wski18n.KEY_LIMIT: *schema.MinProperties})
+	}
+	if schema.MaxProperties != nil && len(value) > *schema.MaxProperties {
+		v.reportf(path, wski18n.ID_ERR_SCHEMA_MAX_PROPERTIES_X_limit_X,
+			map[string]interface{}{wski18n.KEY_LIMIT: *schema.MaxProperties})
+	}
+}
+
+// toJSONValue converts a value decoded from YAML to the value decoded from its JSON
+// encoding: objects are map[string]interface{} and numbers are float64
+func toJSONValue(value interface{}) interface{} {
+	value = stringKeys(value)
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return value
+	}
+	return decoded
+}
+
+// stringKeys converts the maps with keys of any type decoded by gopkg.in/yaml.v2 to maps
+// with string keys
+func stringKeys(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(typed))
+		for k, v := range typed {
+			converted[fmt.Sprint(k)] = stringKeys(v)
+		}
+		return converted
+	case map[string]interface{}:
+		converted := make(map[string]interface{}, len(typed))
+		for k, v := range typed {
+			converted[k] = stringKeys(v)
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, len(typed))
+		for i, v := range typed {
+			converted[i] = stringKeys(v)
+		}
+		return converted
+	}
+	return value
+}
+
+func toJSONString(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// valueType returns the JSON Schema type of a value decoded from JSON, numbers without
+// a fractional part are integers
+func valueType(value interface{}) string {
+	switch typed := value.(type) {
+	case nil:
+		return TYPE_NULL
+	case bool:
+		return TYPE_BOOLEAN
+	case float64:
+		if typed == math.Trunc(typed) {
+			return TYPE_INTEGER
+		}
+		return TYPE_NUMBER
+	case []interface{}:
+		return TYPE_ARRAY
+	case map[string]interface{}:
+		return TYPE_OBJECT
+	}
+	return TYPE_STRING
+}
+
+func contains(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedNames(m interface{}) []string {
+	keys := reflect.ValueOf(m).MapKeys()
+	names := make([]string, 0, len(keys))
+	for _, key := range keys {
+		names = append(names, key.String())
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,175 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wskschema
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/stretchr/testify/assert"
+)
+
+const configSchema = `{
+  "type": "object",
+  "properties": {
+    "host": { "type": "string", "pattern": "^[a-z.]+$" },
+    "port": { "type": "integer", "minimum": 1, "maximum": 65535 },
+    "mode": { "enum": ["dev", "prod"] },
+    "tags": {
+      "type": "array",
+      "items": { "type": "string", "minLength": 2 },
+      "uniqueItems": true,
+      "maxItems": 3
+    },
+    "a/b": { "$ref": "#/$defs/ratio" }
+  },
+  "required": ["host"],
+  "additionalProperties": false,
+  "$defs": {
+    "ratio": { "type": "number", "exclusiveMinimum": 0, "exclusiveMaximum": 1 }
+  }
+}`
+
+func parseSchema(t *testing.T, schema string) *Schema {
+	s, err := ParseSchema([]byte(schema))
+	assert.Nil(t, err, "Failed to parse the schema.")
+	return s
+}
+
+func decode(t *testing.T, value string) interface{} {
+	var decoded interface{}
+	assert.Nil(t, json.Unmarshal([]byte(value), &decoded), "Failed to decode the value.")
+	return decoded
+}
+
+func paths(violations []wskderrors.SchemaViolation) []string {
+	result := make([]string, 0, len(violations))
+	for _, v := range violations {
+		result = append(result, v.Path)
+	}
+	return result
+}
+
+func TestValidateValue_Valid(t *testing.T) {
+	schema := parseSchema(t, configSchema)
+	value := decode(t, `{"host": "example.com", "port": 443, "mode": "prod", "tags": ["eu", "web"], "a/b": 0.5}`)
+	assert.Empty(t, schema.ValidateValue(value))
+}
+
+func TestValidateValue_Violations(t *testing.T) {
+	schema := parseSchema(t, configSchema)
+	value := decode(t, `{"port": 0, "mode": "test", "tags": ["eu", "eu", "x", "web"], "a/b": 1, "hots": "x"}`)
+
+	violations := schema.ValidateValue(value)
+	assert.Equal(t, []string{"/a~1b", "/hots", "/mode", "/port", "/tags", "/tags/1", "/tags/2", ""}, paths(violations))
+	assert.Equal(t, "Value must be less than [1].", violations[0].Message)
+	assert.Equal(t, "Unknown property [hots].", violations[1].Message)
+	assert.Equal(t, "host", violations[1].Suggestion)
+	assert.Equal(t, "Invalid value, expected one of [\"dev\", \"prod\"].", violations[2].Message)
+	assert.Equal(t, "Value must be greater than or equal to [1].", violations[3].Message)
+	assert.Equal(t, "Array must have at most [3] item(s).", violations[4].Message)
+	assert.Equal(t, "Duplicate array item.", violations[5].Message)
+	assert.Equal(t, "String must be at least [2] character(s) long.", violations[6].Message)
+	assert.Equal(t, "Missing required property [host].", violations[7].Message)
+}
+
+func TestValidateValue_Types(t *testing.T) {
+	schema := parseSchema(t, configSchema)
+
+	violations := schema.ValidateValue([]interface{}{"example.com"})
+	assert.Equal(t, []string{""}, paths(violations))
+	assert.Equal(t, "Invalid value of type [array], expected [object].", violations[0].Message)
+
+	violations = schema.ValidateValue(decode(t, `{"host": "example.com", "port": 80.5}`))
+	assert.Equal(t, []string{"/port"}, paths(violations))
+
+	// values decoded from YAML have maps with keys of any type and integer numbers
+	yamlValue := map[interface{}]interface{}{"host": "example.com", "port": 8080}
+	assert.Empty(t, schema.ValidateValue(yamlValue))
+}
+
+func TestValidateValue_Combinations(t *testing.T) {
+	schema := parseSchema(t, `{
+  "anyOf": [{ "type": "string" }, { "type": "integer" }],
+  "oneOf": [{ "type": "integer" }, { "minimum": 10 }],
+  "not": { "const": 42 }
+}`)
+
+	assert.Empty(t, schema.ValidateValue(float64(3)))
+	assert.Empty(t, schema.ValidateValue("text"))
+	assert.Equal(t, "Value does not match any schema of [anyOf].", schema.ValidateValue(true)[0].Message)
+	assert.Equal(t, "Value matches 2 schemas of [oneOf], expected exactly one.", schema.ValidateValue(float64(12))[0].Message)
+	violations := schema.ValidateValue(float64(42))
+	assert.Equal(t, "Value must not match the schema of [not].", violations[len(violations)-1].Message)
+}
+
+func TestValidateValue_MultipleOf(t *testing.T) {
+	schema := parseSchema(t, `{ "multipleOf": 0.1 }`)
+	assert.Empty(t, schema.ValidateValue(0.3))
+	assert.Equal(t, "Value must be a multiple of [0.1].", schema.ValidateValue(0.35)[0].Message)
+}
+
+func TestParseSchema_Invalid(t *testing.T) {
+	_, err := ParseSchema([]byte(`{ "type": "string", "pattern": "[a-" }`))
+	assert.NotNil(t, err, "An invalid pattern should fail the schema.")
+
+	_, err = ParseSchema([]byte(`{ "properties": { "a": { "$ref": "#/$defs/missing" } } }`))
+	assert.NotNil(t, err, "An unresolved reference should fail the schema.")
+	assert.Equal(t, "Reference [#/$defs/missing] not found.", err.Error())
+
+	_, err = ParseSchema([]byte(`{ "type": `))
+	assert.NotNil(t, err, "Invalid JSON should fail the schema.")
+}
+
+func TestReadSchemaFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wskschema")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "config.schema.yaml")
+	content := "type: object\nproperties:\n  retries:\n    type: integer\n    maximum: 5\n"
+	assert.Nil(t, ioutil.WriteFile(path, []byte(content), 0644))
+
+	schema, err := ReadSchemaFile(path)
+	assert.Nil(t, err, "Failed to read the schema file.")
+	violations := schema.ValidateValue(map[string]interface{}{"retries": 10})
+	assert.Equal(t, []string{"/retries"}, paths(violations))
+
+	_, err = ReadSchemaFile(filepath.Join(dir, "missing.json"))
+	assert.NotNil(t, err, "A missing schema file should fail.")
+}
+
+func TestNewSchema_Recursive(t *testing.T) {
+	schema, err := NewSchema(map[interface{}]interface{}{
+		"type": "object",
+		"properties": map[interface{}]interface{}{
+			"name":     map[interface{}]interface{}{"type": "string"},
+			"children": map[interface{}]interface{}{"type": "array", "items": map[interface{}]interface{}{"$ref": "#"}},
+		},
+	})
+	assert.Nil(t, err, "Failed to compose the schema.")
+
+	value := decode(t, `{"name": "root", "children": [{"name": "leaf", "children": [{"name": 1}]}]}`)
+	assert.Equal(t, []string{"/children/0/children/0/name"}, paths(schema.ValidateValue(value)))
+}
@@ -0,0 +1,182 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wsksecret
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	PROVIDER_DIR = "dir"
+	PROVIDER_AGE = "age"
+	PROVIDER_GPG = "gpg"
+
+	// the command line flags configuring the providers
+	flagSecretsDir      = "secrets-dir"
+	flagSecretsFile     = "secrets-file"
+	flagSecretsIdentity = "secrets-identity"
+)
+
+// runCommand runs a decryption command and returns its standard output
+var runCommand = func(name string, args ...string) ([]byte, error) {
+	return exec.Command(name, args...).Output()
+}
+
+func init() {
+	RegisterProvider(PROVIDER_DIR, &dirProvider{})
+	RegisterProvider(PROVIDER_AGE, &encryptedFileProvider{name: PROVIDER_AGE})
+	RegisterProvider(PROVIDER_GPG, &encryptedFileProvider{name: PROVIDER_GPG})
+}
+
+/*
+ * dir provider: a directory holding one file per secret, e.g. Kubernetes secrets
+ * mounted as a volume, secret://dir/db-password reads <secrets-dir>/db-password
+ */
+type dirProvider struct{}
+
+func (p *dirProvider) Resolve(path string) (string, error) {
+	if len(utils.Flags.SecretsDir) == 0 {
+		return "", notConfiguredError(PROVIDER_DIR, flagSecretsDir)
+	}
+	if !isRelativePath(path) {
+		return "", newError(wski18n.ID_ERR_SECRET_INVALID_PATH_X_path_X, path)
+	}
+	content, err := ioutil.ReadFile(filepath.Join(utils.Flags.SecretsDir, filepath.FromSlash(path)))
+	if err != nil {
+		return "", newError(wski18n.ID_ERR_SECRET_NOT_FOUND_X_path_X, path)
+	}
+	// files written by editors and "echo" end with a newline which is not part of the secret
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
+
+/*
+ * age and gpg providers: an encrypted YAML file of secrets, secret://age/cloudant/apikey
+ * reads the key apikey of the map cloudant of the decrypted file
+ *
+ * cloudant:
+ *   apikey: ...
+ *
+ * The file is decrypted by the age or gpg command, with the identity file given by the
+ * secrets-identity flag for age and the keyring of the user for gpg.
+ */
+type encryptedFileProvider struct {
+	name    string
+	file    string
+	secrets interface{}
+}
+
+func (p *encryptedFileProvider) Resolve(path string) (string, error) {
+	if len(utils.Flags.SecretsFile) == 0 {
+		return "", notConfiguredError(p.name, flagSecretsFile)
+	}
+	if err := p.decrypt(utils.Flags.SecretsFile); err != nil {
+		return "", err
+	}
+
+	value := p.secrets
+	for _, segment := range strings.Split(path, "/") {
+		secrets, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return "", newError(wski18n.ID_ERR_SECRET_NOT_FOUND_X_path_X, path)
+		}
+		if value, ok = secrets[segment]; !ok {
+			return "", newError(wski18n.ID_ERR_SECRET_NOT_FOUND_X_path_X, path)
+		}
+	}
+
+	switch value.(type) {
+	case map[interface{}]interface{}, []interface{}, nil:
+		return "", newError(wski18n.ID_ERR_SECRET_NOT_A_VALUE_X_path_X, path)
+	}
+	return fmt.Sprint(value), nil
+}
+
+// decrypt reads the secrets of the encrypted file once
+func (p *encryptedFileProvider) decrypt(file string) error {
+	if p.file == file {
+		return nil
+	}
+
+	var args []string
+	if p.name == PROVIDER_AGE {
+		if len(utils.Flags.SecretsIdentity) == 0 {
+			return notConfiguredError(p.name, flagSecretsIdentity)
+		}
+		args = []string{"--decrypt", "-i", utils.Flags.SecretsIdentity, file}
+	} else {
+		args = []string{"--batch", "--quiet", "--decrypt", file}
+	}
+
+	output, err := runCommand(p.name, args...)
+	if err != nil {
+		// the command explains on stderr why it failed
+		if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) != 0 {
+			err = errors.New(strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return newDecryptError(file, p.name, err)
+	}
+
+	var secrets interface{}
+	if err := yaml.Unmarshal(output, &secrets); err != nil {
+		return newDecryptError(file, p.name, err)
+	}
+	p.file = file
+	p.secrets = secrets
+	return nil
+}
+
+// isRelativePath tests if a secret path stays within the directory it is read from
+func isRelativePath(path string) bool {
+	if filepath.IsAbs(path) || strings.HasPrefix(path, "/") {
+		return false
+	}
+	for _, segment := range strings.Split(filepath.ToSlash(path), "/") {
+		if segment == ".." {
+			return false
+		}
+	}
+	return true
+}
+
+func newError(id string, path string) error {
+	return errors.New(wski18n.T(id, map[string]interface{}{wski18n.KEY_PATH: path}))
+}
+
+func notConfiguredError(provider string, flag string) error {
+	return errors.New(wski18n.T(wski18n.ID_ERR_SECRET_PROVIDER_NOT_CONFIGURED_X_provider_X_flag_X,
+		map[string]interface{}{
+			wski18n.KEY_PROVIDER: provider,
+			wski18n.KEY_FLAG:     flag}))
+}
+
+func newDecryptError(file string, cmd string, err error) error {
+	return errors.New(wski18n.T(wski18n.ID_ERR_SECRET_DECRYPT_X_path_X_cmd_X_err_X,
+		map[string]interface{}{
+			wski18n.KEY_PATH: file,
+			wski18n.KEY_CMD:  cmd,
+			wski18n.KEY_ERR:  err.Error()}))
+}
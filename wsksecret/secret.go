@@ -0,0 +1,194 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wsksecret
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/apache/openwhisk-wskdeploy/wski18n"
+	"github.com/apache/openwhisk-wskdeploy/wskprint"
+)
+
+/*
+ * A parameter or annotation value can refer to a secret instead of holding it:
+ *
+ * inputs:
+ *   password: secret://dir/db-password
+ *   apikey: secret://age/cloudant/apikey
+ *
+ * The first segment after secret:// names the provider, the rest is the path of the
+ * secret within that provider. A resolved secret is masked in everything wskdeploy
+ * prints from then on, including the verbose HTTP requests and responses.
+ */
+
+const (
+	SECRET_REFERENCE_PREFIX = "secret://"
+)
+
+// Provider looks up the value of a secret by its path
+type Provider interface {
+	Resolve(path string) (string, error)
+}
+
+var (
+	providers     = make(map[string]Provider)
+	resolved      = make(map[string]string)
+	providersLock sync.Mutex
+)
+
+// RegisterProvider makes a provider available to secret://<name>/ references,
+// replacing the provider registered under the same name
+func RegisterProvider(name string, provider Provider) {
+	providersLock.Lock()
+	defer providersLock.Unlock()
+	providers[name] = provider
+	// values cached from a replaced provider are stale
+	for reference := range resolved {
+		if p, _, _ := ParseReference(reference); p == name {
+			delete(resolved, reference)
+		}
+	}
+}
+
+// IsReference tests if a value is a secret reference
+func IsReference(value interface{}) bool {
+	str, ok := value.(string)
+	return ok && strings.HasPrefix(str, SECRET_REFERENCE_PREFIX)
+}
+
+// ParseReference splits a secret reference into its provider and path
+func ParseReference(reference string) (string, string, bool) {
+	if !strings.HasPrefix(reference, SECRET_REFERENCE_PREFIX) {
+		return "", "", false
+	}
+	parts := strings.SplitN(strings.TrimPrefix(reference, SECRET_REFERENCE_PREFIX), "/", 2)
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// Resolve replaces the secret references found in a value, walking maps and arrays,
+// by the values of the secrets. Only a value consisting of a reference as a whole is
+// resolved, other values are returned as they are
+func Resolve(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		if !IsReference(v) {
+			return v, nil
+		}
+		return resolveReference(v)
+	case map[string]interface{}:
+		for key, item := range v {
+			resolvedItem, err := Resolve(item)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = resolvedItem
+		}
+		return v, nil
+	case map[interface{}]interface{}:
+		for key, item := range v {
+			resolvedItem, err := Resolve(item)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = resolvedItem
+		}
+		return v, nil
+	case []interface{}:
+		for i, item := range v {
+			resolvedItem, err := Resolve(item)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = resolvedItem
+		}
+		return v, nil
+	}
+	return value, nil
+}
+
+func resolveReference(reference string) (string, error) {
+	providersLock.Lock()
+	defer providersLock.Unlock()
+
+	if secret, ok := resolved[reference]; ok {
+		return secret, nil
+	}
+
+	name, path, ok := ParseReference(reference)
+	if !ok {
+		errMessage := wski18n.T(wski18n.ID_ERR_SECRET_INVALID_PATH_X_path_X,
+			map[string]interface{}{wski18n.KEY_PATH: reference})
+		return "", wskderrors.NewSecretError(reference, errMessage)
+	}
+	provider, ok := providers[name]
+	if !ok {
+		errMessage := wski18n.T(wski18n.ID_ERR_SECRET_PROVIDER_UNKNOWN_X_provider_X_providers_X,
+			map[string]interface{}{
+				wski18n.KEY_PROVIDER:  name,
+				wski18n.KEY_PROVIDERS: strings.Join(providerNames(), ", ")})
+		return "", wskderrors.NewSecretError(reference, errMessage)
+	}
+
+	secret, err := provider.Resolve(path)
+	if err != nil {
+		return "", wskderrors.NewSecretError(reference, err.Error())
+	}
+	resolved[reference] = secret
+	mask(secret)
+	return secret, nil
+}
+
+// mask hides a secret from the console output and from the HTTP requests and
+// responses printed by the whisk client in verbose mode
+func mask(secret string) {
+	if len(secret) == 0 {
+		return
+	}
+	forms := []string{secret}
+	// parameters are sent to OpenWhisk as JSON, where the secret may be escaped
+	if encoded, err := json.Marshal(secret); err == nil {
+		if escaped := strings.Trim(string(encoded), "\""); escaped != secret {
+			forms = append(forms, escaped)
+		}
+	}
+	for _, form := range forms {
+		wskprint.AddSensitiveValue(form)
+		whisk.DefaultObfuscateArr = append(whisk.DefaultObfuscateArr, whisk.ObfuscateSet{
+			Regex:       regexp.QuoteMeta(form),
+			Replacement: wskprint.MASKED_VALUE,
+		})
+	}
+}
+
+func providerNames() []string {
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
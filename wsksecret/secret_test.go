@@ -0,0 +1,155 @@
+// +build unit
+
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package wsksecret
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/openwhisk-wskdeploy/utils"
+	"github.com/apache/openwhisk-wskdeploy/wskderrors"
+	"github.com/apache/openwhisk-wskdeploy/wskprint"
+	"github.com/stretchr/testify/assert"
+)
+
+type mapProvider map[string]string
+
+func (p mapProvider) Resolve(path string) (string, error) {
+	if value, ok := p[path]; ok {
+		return value, nil
+	}
+	return "", errors.New("not found")
+}
+
+func TestParseReference(t *testing.T) {
+	provider, path, ok := ParseReference("secret://age/cloudant/apikey")
+	assert.True(t, ok)
+	assert.Equal(t, "age", provider)
+	assert.Equal(t, "cloudant/apikey", path)
+
+	for _, reference := range []string{"secret://", "secret://dir", "secret://dir/", "secret:///path", "password"} {
+		_, _, ok := ParseReference(reference)
+		assert.False(t, ok, "Reference "+reference+" should be invalid.")
+	}
+}
+
+func TestResolve_WalksValues(t *testing.T) {
+	RegisterProvider("test-walk", mapProvider{"db/password": "s3cr3t-walk"})
+
+	value, err := Resolve(map[string]interface{}{
+		"password": "secret://test-walk/db/password",
+		"hosts":    []interface{}{"db1", map[interface{}]interface{}{"password": "secret://test-walk/db/password"}},
+		"port":     5984,
+	})
+	assert.Nil(t, err)
+	params := value.(map[string]interface{})
+	assert.Equal(t, "s3cr3t-walk", params["password"])
+	assert.Equal(t, "db1", params["hosts"].([]interface{})[0])
+	assert.Equal(t, "s3cr3t-walk", params["hosts"].([]interface{})[1].(map[interface{}]interface{})["password"])
+	assert.Equal(t, 5984, params["port"])
+
+	// only a reference as a whole is resolved
+	value, err = Resolve("password is secret://test-walk/db/password")
+	assert.Nil(t, err)
+	assert.Equal(t, "password is secret://test-walk/db/password", value)
+}
+
+func TestResolve_MasksSecrets(t *testing.T) {
+	RegisterProvider("test-mask", mapProvider{"token": "tok\"en-mask"})
+
+	_, err := Resolve("secret://test-mask/token")
+	assert.Nil(t, err)
+	assert.Equal(t, "token: "+wskprint.MASKED_VALUE, wskprint.MaskSensitiveValues("token: tok\"en-mask"))
+	// the verbose HTTP output is masked in its JSON form
+	assert.Equal(t, `{"token":"`+wskprint.MASKED_VALUE+`"}`,
+		whisk.ObfuscateText(`{"token":"tok\"en-mask"}`, whisk.DefaultObfuscateArr))
+}
+
+func TestResolve_Errors(t *testing.T) {
+	RegisterProvider("test-errors", mapProvider{})
+
+	for _, reference := range []string{"secret://unknown/name", "secret://test-errors/missing", "secret://test-errors"} {
+		_, err := Resolve(map[string]interface{}{"name": reference})
+		if assert.NotNil(t, err, "Reference "+reference+" should fail.") {
+			secretErr, ok := err.(*wskderrors.SecretError)
+			assert.True(t, ok)
+			assert.Equal(t, reference, secretErr.Reference)
+		}
+	}
+}
+
+func TestDirProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wskdeploy-secrets")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "db-password"), []byte("s3cr3t-dir\n"), 0600))
+
+	provider := &dirProvider{}
+	_, err = provider.Resolve("db-password")
+	assert.NotNil(t, err, "The dir provider requires the secrets-dir flag.")
+
+	utils.Flags.SecretsDir = dir
+	defer func() { utils.Flags.SecretsDir = "" }()
+
+	value, err := provider.Resolve("db-password")
+	assert.Nil(t, err)
+	assert.Equal(t, "s3cr3t-dir", value)
+
+	_, err = provider.Resolve("missing")
+	assert.NotNil(t, err)
+	_, err = provider.Resolve("../db-password")
+	assert.NotNil(t, err, "A secret path can not leave the secrets directory.")
+}
+
+func TestEncryptedFileProvider(t *testing.T) {
+	var commands [][]string
+	defer func(run func(string, ...string) ([]byte, error)) { runCommand = run }(runCommand)
+	runCommand = func(name string, args ...string) ([]byte, error) {
+		commands = append(commands, append([]string{name}, args...))
+		return []byte("cloudant:\n  apikey: s3cr3t-age\n  port: 443\n"), nil
+	}
+
+	provider := &encryptedFileProvider{name: PROVIDER_AGE}
+	utils.Flags.SecretsFile = "secrets.yaml.age"
+	defer func() { utils.Flags.SecretsFile = ""; utils.Flags.SecretsIdentity = "" }()
+
+	_, err := provider.Resolve("cloudant/apikey")
+	assert.NotNil(t, err, "The age provider requires the secrets-identity flag.")
+
+	utils.Flags.SecretsIdentity = "key.txt"
+	value, err := provider.Resolve("cloudant/apikey")
+	assert.Nil(t, err)
+	assert.Equal(t, "s3cr3t-age", value)
+	value, err = provider.Resolve("cloudant/port")
+	assert.Nil(t, err)
+	assert.Equal(t, "443", value)
+
+	_, err = provider.Resolve("cloudant")
+	assert.NotNil(t, err, "A map is not a secret value.")
+	_, err = provider.Resolve("cloudant/apikey/name")
+	assert.NotNil(t, err)
+
+	// the file is decrypted once
+	assert.Equal(t, [][]string{{"age", "--decrypt", "-i", "key.txt", "secrets.yaml.age"}}, commands)
+}